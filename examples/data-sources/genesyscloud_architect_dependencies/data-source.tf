@@ -0,0 +1,11 @@
+data "genesyscloud_architect_dependencies" "queue_dependencies" {
+  object_id     = genesyscloud_routing_queue.example_queue.id
+  resource_type = "genesyscloud_routing_queue"
+}
+
+check "queue_is_used" {
+  assert {
+    condition     = length(data.genesyscloud_architect_dependencies.queue_dependencies.consumers) > 0
+    error_message = "No flow uses the queue"
+  }
+}
@@ -28,4 +28,8 @@ resource "genesyscloud_flow" "flow" {
     greeting             = "Hello World"
     menu_disconnect_name = "Disconnect"
   }
+  // To roll back, set pinned_version to an earlier published_version of the flow
+  // pinned_version = "3.0"
+  // Publishes the previous version again if the check fails
+  // post_publish_check_command = "./check_flow.sh"
 }
\ No newline at end of file
@@ -4,6 +4,7 @@ import (
 	"context"
 	"fmt"
 	"log"
+	dependentConsumers "terraform-provider-genesyscloud/genesyscloud/dependent_consumers"
 	"terraform-provider-genesyscloud/genesyscloud/provider"
 	"terraform-provider-genesyscloud/genesyscloud/util"
 	"terraform-provider-genesyscloud/genesyscloud/util/constants"
@@ -182,6 +183,10 @@ func deleteArchitectDatatable(ctx context.Context, d *schema.ResourceData, meta
 	sdkConfig := meta.(*provider.ProviderMeta).ClientConfig
 	archProxy := getArchitectDatatableProxy(sdkConfig)
 
+	if diagErr := dependentConsumers.CheckDeleteProtection(ctx, meta, ResourceType, d.Id()); diagErr != nil {
+		return diagErr
+	}
+
 	log.Printf("Deleting architect_datatable %s", name)
 	resp, err := archProxy.deleteArchitectDatatable(ctx, d.Id())
 	if err != nil {
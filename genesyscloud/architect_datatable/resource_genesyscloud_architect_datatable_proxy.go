@@ -9,7 +9,7 @@ import (
 	"github.com/mypurecloud/platform-client-sdk-go/v152/platformclientv2"
 )
 
-// internalProxy holds a proxy instance that can be used throughout the package
+// internalProxy holds a proxy instance that unit tests can set to replace the proxy of the package
 var internalProxy *architectDatatableProxy
 
 // Type definitions for each func on our proxy so we can easily mock them out later
@@ -40,11 +40,10 @@ func newArchitectDatatableProxy(clientConfig *platformclientv2.Configuration) *a
 }
 
 func getArchitectDatatableProxy(clientConfig *platformclientv2.Configuration) *architectDatatableProxy {
-	if internalProxy == nil {
-		internalProxy = newArchitectDatatableProxy(clientConfig)
+	if internalProxy != nil {
+		return internalProxy
 	}
-
-	return internalProxy
+	return newArchitectDatatableProxy(clientConfig)
 }
 
 func (p *architectDatatableProxy) createArchitectDatatable(ctx context.Context, datatable *Datatable) (*Datatable, *platformclientv2.APIResponse, error) {
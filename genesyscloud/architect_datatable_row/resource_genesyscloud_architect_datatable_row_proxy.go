@@ -135,9 +135,9 @@ func ConvertDatatable(master platformclientv2.Datatable) *Datatable {
 	return &datatable
 }
 
-func getArchitectDatatableFn(_ context.Context, p *architectDatatableRowProxy, datatableId string, expanded string) (*Datatable, *platformclientv2.APIResponse, error) {
+func getArchitectDatatableFn(ctx context.Context, p *architectDatatableRowProxy, datatableId string, expanded string) (*Datatable, *platformclientv2.APIResponse, error) {
 
-	eg := rc.GetCacheItem(p.dataTableCache, datatableId)
+	eg := rc.GetCacheItem(ctx, p.dataTableCache, datatableId)
 	if eg != nil {
 		return eg, nil, nil
 	}
@@ -217,8 +217,8 @@ func getAllArchitectDatatableRowsFn(_ context.Context, p *architectDatatableRowP
 	return &resources, apiResponse, nil
 }
 
-func getArchitectDataTableRowFn(_ context.Context, p *architectDatatableRowProxy, tableId string, key string) (*map[string]interface{}, *platformclientv2.APIResponse, error) {
-	eg := rc.GetCacheItem(p.dataTableRowCache, tableId+"_"+key)
+func getArchitectDataTableRowFn(ctx context.Context, p *architectDatatableRowProxy, tableId string, key string) (*map[string]interface{}, *platformclientv2.APIResponse, error) {
+	eg := rc.GetCacheItem(ctx, p.dataTableRowCache, tableId+"_"+key)
 	if eg != nil {
 		return eg, nil, nil
 	}
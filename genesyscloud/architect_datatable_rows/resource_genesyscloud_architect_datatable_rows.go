@@ -0,0 +1,139 @@
+package architect_datatable_rows
+
+import (
+	"context"
+	"fmt"
+	"log"
+	"terraform-provider-genesyscloud/genesyscloud/consistency_checker"
+	"terraform-provider-genesyscloud/genesyscloud/provider"
+	resourceExporter "terraform-provider-genesyscloud/genesyscloud/resource_exporter"
+	"terraform-provider-genesyscloud/genesyscloud/util"
+	"terraform-provider-genesyscloud/genesyscloud/util/constants"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/retry"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/mypurecloud/platform-client-sdk-go/v152/platformclientv2"
+)
+
+func getAllArchitectDatatableRows(ctx context.Context, clientConfig *platformclientv2.Configuration) (resourceExporter.ResourceIDMetaMap, diag.Diagnostics) {
+	resources := make(resourceExporter.ResourceIDMetaMap)
+	proxy := getArchitectDatatableRowsProxy(clientConfig)
+
+	tables, resp, err := proxy.getAllArchitectDatatable(ctx)
+	if err != nil {
+		return nil, util.BuildAPIDiagnosticError(ResourceType, fmt.Sprintf("Failed to get architect datatables error: %s", err), resp)
+	}
+
+	for _, table := range *tables {
+		resources[*table.Id] = &resourceExporter.ResourceMeta{BlockLabel: *table.Name}
+	}
+	return resources, nil
+}
+
+func createArchitectDatatableRows(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	tableId := d.Get("datatable_id").(string)
+
+	log.Printf("Creating rows of datatable %s", tableId)
+	if diagErr := syncArchitectDatatableRows(ctx, d, meta, tableId); diagErr != nil {
+		return diagErr
+	}
+
+	d.SetId(tableId)
+	log.Printf("Created rows of datatable %s", tableId)
+	return readArchitectDatatableRows(ctx, d, meta)
+}
+
+func readArchitectDatatableRows(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	sdkConfig := meta.(*provider.ProviderMeta).ClientConfig
+	proxy := getArchitectDatatableRowsProxy(sdkConfig)
+	cc := consistency_checker.NewConsistencyCheck(ctx, d, meta, ResourceArchitectDatatableRows(), constants.ConsistencyChecks(), ResourceType)
+
+	log.Printf("Reading rows of datatable %s", d.Id())
+
+	return util.WithRetriesForRead(ctx, d, func() *retry.RetryError {
+		rows, resp, getErr := proxy.getAllArchitectDatatableRows(ctx, d.Id())
+		if getErr != nil {
+			if util.IsStatus404(resp) {
+				return retry.RetryableError(util.BuildWithRetriesApiDiagnosticError(ResourceType, fmt.Sprintf("Failed to read rows of datatable %s | error: %s", d.Id(), getErr), resp))
+			}
+			return retry.NonRetryableError(util.BuildWithRetriesApiDiagnosticError(ResourceType, fmt.Sprintf("Failed to read rows of datatable %s | error: %s", d.Id(), getErr), resp))
+		}
+
+		_ = d.Set("datatable_id", d.Id())
+		_ = d.Set("row_count", len(*rows))
+
+		// Rows changed outside of Terraform are applied again on the next apply
+		if filePath, _ := d.Get("filepath").(string); filePath != "" {
+			if drifted, err := hasDatatableRowsDrifted(ctx, proxy, d.Id(), filePath, *rows); err != nil {
+				log.Printf("Failed to compare rows of datatable %s with %s: %v", d.Id(), filePath, err)
+			} else if drifted {
+				log.Printf("Rows of datatable %s differ from %s", d.Id(), filePath)
+				_ = d.Set("file_content_hash", nil)
+			}
+		}
+
+		log.Printf("Read rows of datatable %s", d.Id())
+		return cc.CheckState(d)
+	})
+}
+
+func updateArchitectDatatableRows(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	log.Printf("Updating rows of datatable %s", d.Id())
+	if diagErr := syncArchitectDatatableRows(ctx, d, meta, d.Id()); diagErr != nil {
+		return diagErr
+	}
+
+	log.Printf("Updated rows of datatable %s", d.Id())
+	return readArchitectDatatableRows(ctx, d, meta)
+}
+
+func deleteArchitectDatatableRows(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	sdkConfig := meta.(*provider.ProviderMeta).ClientConfig
+	proxy := getArchitectDatatableRowsProxy(sdkConfig)
+
+	log.Printf("Deleting rows of datatable %s", d.Id())
+	rows, resp, err := proxy.getAllArchitectDatatableRows(ctx, d.Id())
+	if err != nil {
+		if util.IsStatus404(resp) {
+			// Parent architect_datatable was probably deleted which caused the rows to be deleted
+			log.Printf("Datatable %s already deleted", d.Id())
+			return nil
+		}
+		return util.BuildAPIDiagnosticError(ResourceType, fmt.Sprintf("Failed to read rows of datatable %s error: %s", d.Id(), err), resp)
+	}
+
+	changes := diffDatatableRows(nil, *rows)
+	if diagErr := applyDatatableRowChanges(ctx, proxy, d.Id(), changes); diagErr != nil {
+		return diagErr
+	}
+
+	log.Printf("Deleted %d rows of datatable %s", len(changes.deletes), d.Id())
+	return nil
+}
+
+// syncArchitectDatatableRows makes the rows of a datatable match the rows file
+func syncArchitectDatatableRows(ctx context.Context, d *schema.ResourceData, meta interface{}, tableId string) diag.Diagnostics {
+	sdkConfig := meta.(*provider.ProviderMeta).ClientConfig
+	proxy := getArchitectDatatableRowsProxy(sdkConfig)
+	filePath := d.Get("filepath").(string)
+
+	datatable, resp, err := proxy.getArchitectDatatable(ctx, tableId)
+	if err != nil {
+		return util.BuildAPIDiagnosticError(ResourceType, fmt.Sprintf("Failed to read datatable %s error: %s", tableId, err), resp)
+	}
+
+	desiredRows, err := readDatatableRowsFile(filePath, datatable)
+	if err != nil {
+		return util.BuildDiagnosticError(ResourceType, fmt.Sprintf("Failed to read rows file %s", filePath), err)
+	}
+
+	currentRows, resp, err := proxy.getAllArchitectDatatableRows(ctx, tableId)
+	if err != nil {
+		return util.BuildAPIDiagnosticError(ResourceType, fmt.Sprintf("Failed to read rows of datatable %s error: %s", tableId, err), resp)
+	}
+
+	changes := diffDatatableRows(desiredRows, *currentRows)
+	log.Printf("Applying changes to datatable %s: %d rows to create, %d rows to update and %d rows to delete", tableId, len(changes.creates), len(changes.updates), len(changes.deletes))
+	return applyDatatableRowChanges(ctx, proxy, tableId, changes)
+}
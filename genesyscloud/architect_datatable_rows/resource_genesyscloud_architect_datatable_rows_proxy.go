@@ -0,0 +1,184 @@
+package architect_datatable_rows
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"net/http"
+	dt "terraform-provider-genesyscloud/genesyscloud/architect_datatable"
+
+	"github.com/mypurecloud/platform-client-sdk-go/v152/platformclientv2"
+)
+
+// Type definitions for each func on our proxy so we can easily mock them out later
+type getArchitectDatatableFunc func(ctx context.Context, p *architectDatatableRowsProxy, datatableId string) (*dt.Datatable, *platformclientv2.APIResponse, error)
+type getAllArchitectDatatableFunc func(ctx context.Context, p *architectDatatableRowsProxy) (*[]platformclientv2.Datatable, *platformclientv2.APIResponse, error)
+type getAllArchitectDatatableRowsFunc func(ctx context.Context, p *architectDatatableRowsProxy, tableId string) (*[]map[string]interface{}, *platformclientv2.APIResponse, error)
+type createArchitectDatatableRowFunc func(ctx context.Context, p *architectDatatableRowsProxy, tableId string, row *map[string]interface{}) (*platformclientv2.APIResponse, error)
+type updateArchitectDatatableRowFunc func(ctx context.Context, p *architectDatatableRowsProxy, tableId string, key string, row *map[string]interface{}) (*platformclientv2.APIResponse, error)
+type deleteArchitectDatatableRowFunc func(ctx context.Context, p *architectDatatableRowsProxy, tableId string, key string) (*platformclientv2.APIResponse, error)
+
+type architectDatatableRowsProxy struct {
+	clientConfig                     *platformclientv2.Configuration
+	architectApi                     *platformclientv2.ArchitectApi
+	getArchitectDatatableAttr        getArchitectDatatableFunc
+	getAllArchitectDatatableAttr     getAllArchitectDatatableFunc
+	getAllArchitectDatatableRowsAttr getAllArchitectDatatableRowsFunc
+	createArchitectDatatableRowAttr  createArchitectDatatableRowFunc
+	updateArchitectDatatableRowAttr  updateArchitectDatatableRowFunc
+	deleteArchitectDatatableRowAttr  deleteArchitectDatatableRowFunc
+}
+
+// internalProxy holds a proxy instance that unit tests can set to replace the proxy of the package
+var internalProxy *architectDatatableRowsProxy
+
+func newArchitectDatatableRowsProxy(clientConfig *platformclientv2.Configuration) *architectDatatableRowsProxy {
+	api := platformclientv2.NewArchitectApiWithConfig(clientConfig)
+	return &architectDatatableRowsProxy{
+		clientConfig:                     clientConfig,
+		architectApi:                     api,
+		getArchitectDatatableAttr:        getArchitectDatatableFn,
+		getAllArchitectDatatableAttr:     getAllArchitectDatatableFn,
+		getAllArchitectDatatableRowsAttr: getAllArchitectDatatableRowsFn,
+		createArchitectDatatableRowAttr:  createArchitectDatatableRowFn,
+		updateArchitectDatatableRowAttr:  updateArchitectDatatableRowFn,
+		deleteArchitectDatatableRowAttr:  deleteArchitectDatatableRowFn,
+	}
+}
+
+func getArchitectDatatableRowsProxy(clientConfig *platformclientv2.Configuration) *architectDatatableRowsProxy {
+	if internalProxy != nil {
+		return internalProxy
+	}
+	return newArchitectDatatableRowsProxy(clientConfig)
+}
+
+func (p *architectDatatableRowsProxy) getArchitectDatatable(ctx context.Context, id string) (*dt.Datatable, *platformclientv2.APIResponse, error) {
+	return p.getArchitectDatatableAttr(ctx, p, id)
+}
+
+func (p *architectDatatableRowsProxy) getAllArchitectDatatable(ctx context.Context) (*[]platformclientv2.Datatable, *platformclientv2.APIResponse, error) {
+	return p.getAllArchitectDatatableAttr(ctx, p)
+}
+
+func (p *architectDatatableRowsProxy) getAllArchitectDatatableRows(ctx context.Context, tableId string) (*[]map[string]interface{}, *platformclientv2.APIResponse, error) {
+	return p.getAllArchitectDatatableRowsAttr(ctx, p, tableId)
+}
+
+func (p *architectDatatableRowsProxy) createArchitectDatatableRow(ctx context.Context, tableId string, row *map[string]interface{}) (*platformclientv2.APIResponse, error) {
+	return p.createArchitectDatatableRowAttr(ctx, p, tableId, row)
+}
+
+func (p *architectDatatableRowsProxy) updateArchitectDatatableRow(ctx context.Context, tableId string, key string, row *map[string]interface{}) (*platformclientv2.APIResponse, error) {
+	return p.updateArchitectDatatableRowAttr(ctx, p, tableId, key, row)
+}
+
+func (p *architectDatatableRowsProxy) deleteArchitectDatatableRow(ctx context.Context, tableId string, key string) (*platformclientv2.APIResponse, error) {
+	return p.deleteArchitectDatatableRowAttr(ctx, p, tableId, key)
+}
+
+// getArchitectDatatableFn gets a datatable with its schema. The SDK Datatable does not contain the defaults of properties.
+func getArchitectDatatableFn(_ context.Context, p *architectDatatableRowsProxy, datatableId string) (*dt.Datatable, *platformclientv2.APIResponse, error) {
+	apiClient := &p.architectApi.Configuration.APIClient
+
+	// create path and map variables
+	path := p.architectApi.Configuration.BasePath + "/api/v2/flows/datatables/" + datatableId
+
+	headerParams := make(map[string]string)
+	queryParams := make(map[string]string)
+
+	// oauth required
+	if p.architectApi.Configuration.AccessToken != "" {
+		headerParams["Authorization"] = "Bearer " + p.architectApi.Configuration.AccessToken
+	}
+	// add default headers if any
+	for key := range p.architectApi.Configuration.DefaultHeader {
+		headerParams[key] = p.architectApi.Configuration.DefaultHeader[key]
+	}
+
+	queryParams["expand"] = apiClient.ParameterToString("schema", "")
+
+	headerParams["Content-Type"] = "application/json"
+	headerParams["Accept"] = "application/json"
+
+	var successPayload *dt.Datatable
+	response, err := apiClient.CallAPI(path, http.MethodGet, nil, headerParams, queryParams, nil, "", nil, "")
+	if err != nil {
+		// Nothing special to do here, but do avoid processing the response
+	} else if response.Error != nil {
+		err = errors.New(response.ErrorMessage)
+	} else {
+		err = json.Unmarshal(response.RawBody, &successPayload)
+	}
+	return successPayload, response, err
+}
+
+func getAllArchitectDatatableFn(_ context.Context, p *architectDatatableRowsProxy) (*[]platformclientv2.Datatable, *platformclientv2.APIResponse, error) {
+	var totalRecords []platformclientv2.Datatable
+
+	const pageSize = 100
+	tables, apiResponse, getErr := p.architectApi.GetFlowsDatatables("", 1, pageSize, "", "", nil, "")
+	if getErr != nil {
+		return &totalRecords, apiResponse, getErr
+	}
+
+	if tables.Entities == nil || len(*tables.Entities) == 0 {
+		return &totalRecords, apiResponse, nil
+	}
+	totalRecords = append(totalRecords, *tables.Entities...)
+
+	for pageNum := 2; pageNum <= *tables.PageCount; pageNum++ {
+		tables, apiResponse, getErr := p.architectApi.GetFlowsDatatables("", pageNum, pageSize, "", "", nil, "")
+		if getErr != nil {
+			return &totalRecords, apiResponse, getErr
+		}
+
+		if tables.Entities == nil || len(*tables.Entities) == 0 {
+			break
+		}
+		totalRecords = append(totalRecords, *tables.Entities...)
+	}
+	return &totalRecords, apiResponse, nil
+}
+
+func getAllArchitectDatatableRowsFn(_ context.Context, p *architectDatatableRowsProxy, tableId string) (*[]map[string]interface{}, *platformclientv2.APIResponse, error) {
+	resources := make([]map[string]interface{}, 0)
+	const pageSize = 100
+
+	rows, apiResponse, getErr := p.architectApi.GetFlowsDatatableRows(tableId, 1, pageSize, false, "")
+	if getErr != nil {
+		return nil, apiResponse, getErr
+	}
+
+	if rows.Entities == nil || len(*rows.Entities) == 0 {
+		return &resources, apiResponse, nil
+	}
+	resources = append(resources, *rows.Entities...)
+
+	for pageNum := 2; pageNum <= *rows.PageCount; pageNum++ {
+		rows, apiResponse, getErr := p.architectApi.GetFlowsDatatableRows(tableId, pageNum, pageSize, false, "")
+		if getErr != nil {
+			return nil, apiResponse, getErr
+		}
+
+		if rows.Entities == nil || len(*rows.Entities) == 0 {
+			break
+		}
+		resources = append(resources, *rows.Entities...)
+	}
+	return &resources, apiResponse, nil
+}
+
+func createArchitectDatatableRowFn(_ context.Context, p *architectDatatableRowsProxy, tableId string, row *map[string]interface{}) (*platformclientv2.APIResponse, error) {
+	_, resp, err := p.architectApi.PostFlowsDatatableRows(tableId, *row)
+	return resp, err
+}
+
+func updateArchitectDatatableRowFn(_ context.Context, p *architectDatatableRowsProxy, tableId string, key string, row *map[string]interface{}) (*platformclientv2.APIResponse, error) {
+	_, resp, err := p.architectApi.PutFlowsDatatableRow(tableId, key, *row)
+	return resp, err
+}
+
+func deleteArchitectDatatableRowFn(_ context.Context, p *architectDatatableRowsProxy, tableId string, key string) (*platformclientv2.APIResponse, error) {
+	return p.architectApi.DeleteFlowsDatatableRow(tableId, key)
+}
@@ -0,0 +1,81 @@
+package architect_datatable_rows
+
+import (
+	"terraform-provider-genesyscloud/genesyscloud/architect_datatable_row"
+	"terraform-provider-genesyscloud/genesyscloud/provider"
+	resourceExporter "terraform-provider-genesyscloud/genesyscloud/resource_exporter"
+	registrar "terraform-provider-genesyscloud/genesyscloud/resource_register"
+	"terraform-provider-genesyscloud/genesyscloud/validators"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+/*
+resource_genesyscloud_architect_datatable_rows_schema.go holds two functions within it:
+
+1.  The resource schema definitions for the architect_datatable_rows resource.
+2.  The resource exporter configuration for the architect_datatable_rows exporter.
+*/
+
+const ResourceType = "genesyscloud_architect_datatable_rows"
+
+// SetRegistrar registers all of the resources, datasources and exporters in the package
+func SetRegistrar(regInstance registrar.Registrar) {
+	regInstance.RegisterResource(ResourceType, ResourceArchitectDatatableRows())
+	//No Datasource defined
+	regInstance.RegisterExporter(ResourceType, ArchitectDatatableRowsExporter())
+}
+
+func ArchitectDatatableRowsExporter() *resourceExporter.ResourceExporter {
+	return &resourceExporter.ResourceExporter{
+		GetResourcesFunc: provider.GetAllWithPooledClient(getAllArchitectDatatableRows),
+		RefAttrs: map[string]*resourceExporter.RefAttrSettings{
+			"datatable_id": {RefType: "genesyscloud_architect_datatable"},
+		},
+		CustomFileWriter: resourceExporter.CustomFileWriterSettings{
+			RetrieveAndWriteFilesFunc: DatatableRowsExporterResolver,
+			SubDirectory:              "datatable_rows",
+		},
+		// The rows are exported as genesyscloud_architect_datatable_row resources unless this exporter is requested
+		Replaces: architect_datatable_row.ResourceType,
+	}
+}
+
+func ResourceArchitectDatatableRows() *schema.Resource {
+	return &schema.Resource{
+		Description: "Genesys Cloud Architect Datatable Rows. Manages all rows of a datatable from a CSV or JSON file. Rows of the datatable that are not in the file are deleted, so this resource should not be used together with `genesyscloud_architect_datatable_row` resources of the same datatable. `genesyscloud_tf_export` only exports this resource, in place of `genesyscloud_architect_datatable_row`, when it is listed in `replacement_exporters`.",
+
+		CreateContext: provider.CreateWithPooledClient(createArchitectDatatableRows),
+		ReadContext:   provider.ReadWithPooledClient(readArchitectDatatableRows),
+		UpdateContext: provider.UpdateWithPooledClient(updateArchitectDatatableRows),
+		DeleteContext: provider.DeleteWithPooledClient(deleteArchitectDatatableRows),
+		Importer: &schema.ResourceImporter{
+			StateContext: schema.ImportStatePassthroughContext,
+		},
+		SchemaVersion: 1,
+		Schema: map[string]*schema.Schema{
+			"datatable_id": {
+				Description: "ID of the datatable that contains the rows. If this is changed, the rows are created in the new datatable.",
+				Type:        schema.TypeString,
+				Required:    true,
+				ForceNew:    true,
+			},
+			"filepath": {
+				Description:  "Path to a CSV or JSON file with the rows of the datatable. A CSV file has a header with the property names, and a JSON file has an array of objects. Every row must have a `key`. Defaults are set for missing properties.",
+				Type:         schema.TypeString,
+				Required:     true,
+				ValidateFunc: validators.ValidatePath,
+			},
+			"file_content_hash": {
+				Description: "Hash value of the rows file content. Used to detect changes.",
+				Type:        schema.TypeString,
+				Required:    true,
+			},
+			"row_count": {
+				Description: "Number of rows in the datatable.",
+				Type:        schema.TypeInt,
+				Computed:    true,
+			},
+		},
+	}
+}
@@ -0,0 +1,144 @@
+package architect_datatable_rows
+
+import (
+	"fmt"
+	"strconv"
+	"strings"
+	"terraform-provider-genesyscloud/genesyscloud/provider"
+	"terraform-provider-genesyscloud/genesyscloud/util"
+	"terraform-provider-genesyscloud/genesyscloud/util/testrunner"
+	"testing"
+
+	"github.com/google/uuid"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+	"github.com/mypurecloud/platform-client-sdk-go/v152/platformclientv2"
+)
+
+func TestAccResourceArchitectDatatableRows(t *testing.T) {
+	var (
+		tableResourceLabel = "arch-table"
+		rowsResourceLabel  = "table-rows"
+		tableName          = "Terraform Table-" + uuid.NewString()
+		csvFilePath        = testrunner.GetTestDataPath("resource", ResourceType, "rows.csv")
+		jsonFilePath       = testrunner.GetTestDataPath("resource", ResourceType, "rows.json")
+
+		tableConfig = generateArchitectDatatableResource(
+			tableResourceLabel,
+			tableName,
+			generateArchitectDatatableProperty("key", "string", util.NullValue),
+			generateArchitectDatatableProperty("count", "integer", strconv.Quote("5")),
+			generateArchitectDatatableProperty("enabled", "boolean", util.NullValue),
+			generateArchitectDatatableProperty("label", "string", util.NullValue),
+		)
+	)
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:          func() { util.TestAccPreCheck(t) },
+		ProviderFactories: provider.GetProviderFactories(providerResources, providerDataSources),
+		Steps: []resource.TestStep{
+			{
+				// Create the rows of a CSV file
+				Config: tableConfig + generateArchitectDatatableRowsResource(
+					rowsResourceLabel,
+					"genesyscloud_architect_datatable."+tableResourceLabel+".id",
+					csvFilePath,
+				),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttrPair(ResourceType+"."+rowsResourceLabel, "datatable_id", "genesyscloud_architect_datatable."+tableResourceLabel, "id"),
+					resource.TestCheckResourceAttr(ResourceType+"."+rowsResourceLabel, "row_count", "3"),
+					validateDatatableRow("genesyscloud_architect_datatable."+tableResourceLabel, "third", map[string]interface{}{"count": float64(5), "enabled": false, "label": "three"}),
+				),
+			},
+			{
+				// Replace the rows with the rows of a JSON file
+				Config: tableConfig + generateArchitectDatatableRowsResource(
+					rowsResourceLabel,
+					"genesyscloud_architect_datatable."+tableResourceLabel+".id",
+					jsonFilePath,
+				),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(ResourceType+"."+rowsResourceLabel, "row_count", "2"),
+					validateDatatableRow("genesyscloud_architect_datatable."+tableResourceLabel, "first", map[string]interface{}{"count": float64(10), "enabled": false, "label": "one"}),
+					validateDatatableRow("genesyscloud_architect_datatable."+tableResourceLabel, "fourth", map[string]interface{}{"count": float64(4), "enabled": true, "label": ""}),
+				),
+			},
+			{
+				// Import/Read
+				ResourceName:            ResourceType + "." + rowsResourceLabel,
+				ImportState:             true,
+				ImportStateVerify:       true,
+				ImportStateVerifyIgnore: []string{"filepath", "file_content_hash"},
+			},
+		},
+		CheckDestroy: testVerifyDatatableRowsDestroyed,
+	})
+}
+
+func generateArchitectDatatableRowsResource(resourceLabel string, tableId string, filePath string) string {
+	return fmt.Sprintf(`resource "genesyscloud_architect_datatable_rows" "%s" {
+		datatable_id      = %s
+		filepath          = %s
+		file_content_hash = filesha256(%s)
+	}
+	`, resourceLabel, tableId, strconv.Quote(filePath), strconv.Quote(filePath))
+}
+
+func generateArchitectDatatableResource(resourceLabel string, name string, properties ...string) string {
+	return fmt.Sprintf(`resource "genesyscloud_architect_datatable" "%s" {
+		name = "%s"
+		%s
+	}
+	`, resourceLabel, name, strings.Join(properties, "\n"))
+}
+
+func generateArchitectDatatableProperty(name string, propType string, defaultVal string) string {
+	return fmt.Sprintf(`properties {
+		name = "%s"
+		type = "%s"
+		default = %s
+	}
+	`, name, propType, defaultVal)
+}
+
+func validateDatatableRow(tableResourcePath string, key string, expectedProperties map[string]interface{}) resource.TestCheckFunc {
+	return func(state *terraform.State) error {
+		tableResource, ok := state.RootModule().Resources[tableResourcePath]
+		if !ok {
+			return fmt.Errorf("failed to find table resource %s in state", tableResourcePath)
+		}
+
+		archAPI := platformclientv2.NewArchitectApi()
+		row, _, err := archAPI.GetFlowsDatatableRow(tableResource.Primary.ID, key, false)
+		if err != nil {
+			return fmt.Errorf("failed to get row %s: %v", key, err)
+		}
+		for name, expected := range expectedProperties {
+			if actual := (*row)[name]; actual != expected {
+				return fmt.Errorf("expected property %s of row %s to be %v, got %v", name, key, expected, actual)
+			}
+		}
+		return nil
+	}
+}
+
+func testVerifyDatatableRowsDestroyed(state *terraform.State) error {
+	archAPI := platformclientv2.NewArchitectApi()
+	for _, rs := range state.RootModule().Resources {
+		if rs.Type != ResourceType {
+			continue
+		}
+
+		rows, resp, err := archAPI.GetFlowsDatatableRows(rs.Primary.ID, 1, 1, true, "")
+		if util.IsStatus404(resp) {
+			// Datatable not found as expected
+			continue
+		} else if err != nil {
+			return fmt.Errorf("Unexpected error: %s", err)
+		} else if rows.Entities != nil && len(*rows.Entities) > 0 {
+			return fmt.Errorf("Datatable (%s) still has rows", rs.Primary.ID)
+		}
+	}
+	// Success. All Datatable Rows destroyed
+	return nil
+}
@@ -0,0 +1,332 @@
+package architect_datatable_rows
+
+import (
+	"context"
+	"encoding/csv"
+	"encoding/json"
+	"fmt"
+	"io"
+	"log"
+	"os"
+	"path/filepath"
+	"reflect"
+	"sort"
+	"strconv"
+	"strings"
+	"sync"
+	dt "terraform-provider-genesyscloud/genesyscloud/architect_datatable"
+	"terraform-provider-genesyscloud/genesyscloud/provider"
+	resourceExporter "terraform-provider-genesyscloud/genesyscloud/resource_exporter"
+	"terraform-provider-genesyscloud/genesyscloud/util"
+	"terraform-provider-genesyscloud/genesyscloud/util/chunks"
+	"terraform-provider-genesyscloud/genesyscloud/util/files"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+)
+
+// Number of rows that are sent to the API at the same time
+const rowsBatchSize = 20
+
+// datatableRowChanges are the changes that make the rows of a datatable match a rows file
+type datatableRowChanges struct {
+	creates []map[string]interface{}
+	updates []map[string]interface{}
+	deletes []string
+}
+
+// readDatatableRowsFile reads the rows of a CSV or JSON file and sets the defaults of the datatable schema on missing properties
+func readDatatableRowsFile(path string, datatable *dt.Datatable) ([]map[string]interface{}, error) {
+	reader, file, err := files.DownloadOrOpenFile(path)
+	if err != nil {
+		return nil, err
+	}
+	if file != nil {
+		defer file.Close()
+	}
+
+	properties := map[string]dt.Datatableproperty{}
+	if datatable.Schema != nil && datatable.Schema.Properties != nil {
+		properties = *datatable.Schema.Properties
+	}
+
+	var rows []map[string]interface{}
+	switch strings.ToLower(filepath.Ext(path)) {
+	case ".csv":
+		rows, err = parseCSVRows(reader, properties)
+	case ".json":
+		rows, err = parseJSONRows(reader)
+	default:
+		return nil, fmt.Errorf("rows file %s must be a .csv or .json file", path)
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	keys := make(map[string]bool, len(rows))
+	for i, row := range rows {
+		key, ok := row["key"].(string)
+		if !ok || key == "" {
+			return nil, fmt.Errorf("row %d has no key", i+1)
+		}
+		if keys[key] {
+			return nil, fmt.Errorf("key %s is used by more than one row", key)
+		}
+		keys[key] = true
+
+		for name := range row {
+			if _, exists := properties[name]; !exists && name != "key" {
+				return nil, fmt.Errorf("row %s has property %s that is not in the schema of datatable %s", key, name, *datatable.Id)
+			}
+		}
+		setPropertyDefaults(row, properties)
+	}
+	return rows, nil
+}
+
+// parseCSVRows reads rows from a CSV file with a header of property names. Values are converted to the types of the
+// properties, and empty values are left out so the defaults are used.
+func parseCSVRows(reader io.Reader, properties map[string]dt.Datatableproperty) ([]map[string]interface{}, error) {
+	csvReader := csv.NewReader(reader)
+	csvReader.TrimLeadingSpace = true
+
+	records, err := csvReader.ReadAll()
+	if err != nil {
+		return nil, fmt.Errorf("failed to parse CSV: %v", err)
+	}
+	if len(records) == 0 {
+		return nil, fmt.Errorf("CSV file has no header")
+	}
+
+	header := records[0]
+	rows := make([]map[string]interface{}, 0, len(records)-1)
+	for line, record := range records[1:] {
+		row := make(map[string]interface{}, len(header))
+		for i, name := range header {
+			if i >= len(record) || record[i] == "" {
+				continue
+			}
+			value, err := convertCSVValue(record[i], properties[name])
+			if err != nil {
+				return nil, fmt.Errorf("invalid value of property %s on line %d: %v", name, line+2, err)
+			}
+			row[name] = value
+		}
+		rows = append(rows, row)
+	}
+	return rows, nil
+}
+
+// convertCSVValue converts a CSV value to the JSON type of a datatable property
+func convertCSVValue(value string, property dt.Datatableproperty) (interface{}, error) {
+	if property.VarType == nil {
+		return value, nil
+	}
+	switch *property.VarType {
+	case "boolean":
+		return strconv.ParseBool(value)
+	case "integer":
+		intValue, err := strconv.ParseInt(value, 10, 64)
+		return float64(intValue), err
+	case "number":
+		return strconv.ParseFloat(value, 64)
+	default:
+		return value, nil
+	}
+}
+
+// parseJSONRows reads rows from a JSON array of objects
+func parseJSONRows(reader io.Reader) ([]map[string]interface{}, error) {
+	var rows []map[string]interface{}
+	if err := json.NewDecoder(reader).Decode(&rows); err != nil {
+		return nil, fmt.Errorf("failed to parse JSON: %v", err)
+	}
+	return rows, nil
+}
+
+// setPropertyDefaults sets the values the API uses for properties that are missing from a row
+func setPropertyDefaults(row map[string]interface{}, properties map[string]dt.Datatableproperty) {
+	for name, prop := range properties {
+		if _, set := row[name]; set || name == "key" {
+			continue
+		}
+		if prop.Default != nil {
+			row[name] = *prop.Default
+		} else if prop.VarType == nil {
+			continue
+		} else if *prop.VarType == "boolean" {
+			row[name] = false
+		} else if *prop.VarType == "string" {
+			row[name] = ""
+		} else if *prop.VarType == "integer" || *prop.VarType == "number" {
+			row[name] = float64(0)
+		}
+	}
+}
+
+// diffDatatableRows returns the changes that turn the current rows of a datatable into the desired rows
+func diffDatatableRows(desiredRows []map[string]interface{}, currentRows []map[string]interface{}) datatableRowChanges {
+	var changes datatableRowChanges
+
+	currentByKey := make(map[string]map[string]interface{}, len(currentRows))
+	for _, row := range currentRows {
+		if key, ok := row["key"].(string); ok {
+			currentByKey[key] = row
+		}
+	}
+
+	for _, row := range desiredRows {
+		key := row["key"].(string)
+		current, exists := currentByKey[key]
+		delete(currentByKey, key)
+		if !exists {
+			changes.creates = append(changes.creates, row)
+		} else if !rowsEqual(row, current) {
+			changes.updates = append(changes.updates, row)
+		}
+	}
+
+	for key := range currentByKey {
+		changes.deletes = append(changes.deletes, key)
+	}
+	sort.Strings(changes.deletes)
+	return changes
+}
+
+// rowsEqual compares rows by their JSON values, so numbers of different Go types are equal
+func rowsEqual(a map[string]interface{}, b map[string]interface{}) bool {
+	aJson, errA := json.Marshal(a)
+	bJson, errB := json.Marshal(b)
+	if errA != nil || errB != nil {
+		return false
+	}
+	var aValue, bValue interface{}
+	_ = json.Unmarshal(aJson, &aValue)
+	_ = json.Unmarshal(bJson, &bValue)
+	return reflect.DeepEqual(aValue, bValue)
+}
+
+// applyDatatableRowChanges deletes, updates and creates rows in batches. The rows of a batch are sent at the same time.
+func applyDatatableRowChanges(ctx context.Context, proxy *architectDatatableRowsProxy, tableId string, changes datatableRowChanges) diag.Diagnostics {
+	diagErr := chunks.ProcessChunks(chunks.ChunkBy(changes.deletes, rowsBatchSize), func(keys []string) diag.Diagnostics {
+		return processRowsBatch(keys, func(key string) diag.Diagnostics {
+			resp, err := proxy.deleteArchitectDatatableRow(ctx, tableId, key)
+			if err != nil && !util.IsStatus404(resp) {
+				return util.BuildAPIDiagnosticError(ResourceType, fmt.Sprintf("Failed to delete row %s of datatable %s error: %s", key, tableId, err), resp)
+			}
+			return nil
+		})
+	})
+	if diagErr != nil {
+		return diagErr
+	}
+
+	diagErr = chunks.ProcessChunks(chunks.ChunkBy(changes.updates, rowsBatchSize), func(rows []map[string]interface{}) diag.Diagnostics {
+		return processRowsBatch(rows, func(row map[string]interface{}) diag.Diagnostics {
+			key := row["key"].(string)
+			resp, err := proxy.updateArchitectDatatableRow(ctx, tableId, key, &row)
+			if err != nil {
+				return util.BuildAPIDiagnosticError(ResourceType, fmt.Sprintf("Failed to update row %s of datatable %s error: %s", key, tableId, err), resp)
+			}
+			return nil
+		})
+	})
+	if diagErr != nil {
+		return diagErr
+	}
+
+	return chunks.ProcessChunks(chunks.ChunkBy(changes.creates, rowsBatchSize), func(rows []map[string]interface{}) diag.Diagnostics {
+		return processRowsBatch(rows, func(row map[string]interface{}) diag.Diagnostics {
+			resp, err := proxy.createArchitectDatatableRow(ctx, tableId, &row)
+			if err != nil {
+				return util.BuildAPIDiagnosticError(ResourceType, fmt.Sprintf("Failed to create row %s of datatable %s error: %s", row["key"], tableId, err), resp)
+			}
+			return nil
+		})
+	})
+}
+
+// processRowsBatch processes the items of a batch at the same time and returns the diagnostics of all items
+func processRowsBatch[T any](batch []T, process func(T) diag.Diagnostics) diag.Diagnostics {
+	var (
+		wg      sync.WaitGroup
+		mutex   sync.Mutex
+		diagErr diag.Diagnostics
+	)
+	for _, item := range batch {
+		wg.Add(1)
+		go func(item T) {
+			defer wg.Done()
+			if itemErr := process(item); itemErr != nil {
+				mutex.Lock()
+				diagErr = append(diagErr, itemErr...)
+				mutex.Unlock()
+			}
+		}(item)
+	}
+	wg.Wait()
+	return diagErr
+}
+
+// hasDatatableRowsDrifted returns true if the rows of a datatable differ from the rows file
+func hasDatatableRowsDrifted(ctx context.Context, proxy *architectDatatableRowsProxy, tableId string, filePath string, currentRows []map[string]interface{}) (bool, error) {
+	datatable, _, err := proxy.getArchitectDatatable(ctx, tableId)
+	if err != nil {
+		return false, err
+	}
+	desiredRows, err := readDatatableRowsFile(filePath, datatable)
+	if err != nil {
+		return false, err
+	}
+	changes := diffDatatableRows(desiredRows, currentRows)
+	return len(changes.creates)+len(changes.updates)+len(changes.deletes) > 0, nil
+}
+
+// DatatableRowsExporterResolver writes the rows of a datatable to a JSON file in the export sub directory.
+// The filepath and file_content_hash attributes are then updated to point at the exported file.
+func DatatableRowsExporterResolver(tableId, exportDirectory, subDirectory string, configMap map[string]interface{}, meta interface{}, resource resourceExporter.ResourceInfo) error {
+	sdkConfig := meta.(*provider.ProviderMeta).ClientConfig
+	proxy := getArchitectDatatableRowsProxy(sdkConfig)
+
+	rows, _, err := proxy.getAllArchitectDatatableRows(context.Background(), tableId)
+	if err != nil {
+		return fmt.Errorf("failed to get rows of datatable %s: %v", tableId, err)
+	}
+	sort.Slice(*rows, func(i, j int) bool {
+		keyI, _ := (*rows)[i]["key"].(string)
+		keyJ, _ := (*rows)[j]["key"].(string)
+		return keyI < keyJ
+	})
+
+	rowsJson, err := json.MarshalIndent(*rows, "", "  ")
+	if err != nil {
+		return fmt.Errorf("failed to marshal rows of datatable %s: %v", tableId, err)
+	}
+
+	fullPath := filepath.Join(exportDirectory, subDirectory)
+	if err := os.MkdirAll(fullPath, os.ModePerm); err != nil {
+		return err
+	}
+
+	exportFileName := fmt.Sprintf("%s.json", resource.BlockLabel)
+	if diagErr := files.WriteToFile(rowsJson, filepath.Join(fullPath, exportFileName)); diagErr != nil {
+		return fmt.Errorf("failed to write rows file for datatable %s: %v", tableId, diagErr)
+	}
+
+	// Update filepath field in configMap to point to exported rows file
+	fileNameVal := filepath.Join(subDirectory, exportFileName)
+	configMap["filepath"] = fileNameVal
+	configMap["file_content_hash"] = fmt.Sprintf(`${filesha256("%s")}`, fileNameVal)
+
+	// Remove read only attributes from the config file
+	delete(configMap, "row_count")
+
+	resource.State.Attributes["filepath"] = fileNameVal
+
+	hash, err := files.HashFileContent(filepath.Join(fullPath, exportFileName))
+	if err != nil {
+		log.Printf("Error Calculating Hash '%s' ", err)
+	} else {
+		resource.State.Attributes["file_content_hash"] = hash
+	}
+	return nil
+}
@@ -0,0 +1,179 @@
+package architect_datatable_rows
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"os"
+	"path/filepath"
+	"reflect"
+	"sort"
+	"strings"
+	"sync"
+	dt "terraform-provider-genesyscloud/genesyscloud/architect_datatable"
+	"testing"
+
+	"github.com/mypurecloud/platform-client-sdk-go/v152/platformclientv2"
+)
+
+func buildTestDatatable() *dt.Datatable {
+	var (
+		id                       = "table-id"
+		stringType               = "string"
+		integerType              = "integer"
+		booleanType              = "boolean"
+		numberType               = "number"
+		countDefault interface{} = float64(5)
+	)
+	return &dt.Datatable{
+		Id: &id,
+		Schema: &dt.Jsonschemadocument{
+			Properties: &map[string]dt.Datatableproperty{
+				"key":     {VarType: &stringType},
+				"count":   {VarType: &integerType, Default: &countDefault},
+				"enabled": {VarType: &booleanType},
+				"ratio":   {VarType: &numberType},
+				"label":   {VarType: &stringType},
+			},
+		},
+	}
+}
+
+func writeTestRowsFile(t *testing.T, name string, content string) string {
+	path := filepath.Join(t.TempDir(), name)
+	if err := os.WriteFile(path, []byte(content), 0644); err != nil {
+		t.Fatalf("failed to write rows file: %v", err)
+	}
+	return path
+}
+
+func TestUnitReadDatatableRowsFile(t *testing.T) {
+	expected := []map[string]interface{}{
+		{"key": "first", "count": float64(1), "enabled": true, "ratio": 0.5, "label": "one"},
+		{"key": "second", "count": float64(5), "enabled": false, "ratio": float64(0), "label": ""},
+	}
+
+	csvPath := writeTestRowsFile(t, "rows.csv", "key,count,enabled,ratio,label\nfirst,1,true,0.5,one\nsecond,,,,\n")
+	rows, err := readDatatableRowsFile(csvPath, buildTestDatatable())
+	if err != nil {
+		t.Fatalf("failed to read CSV rows: %v", err)
+	}
+	if !reflect.DeepEqual(rows, expected) {
+		t.Errorf("expected CSV rows %v, got %v", expected, rows)
+	}
+
+	jsonPath := writeTestRowsFile(t, "rows.json", `[{"key":"first","count":1,"enabled":true,"ratio":0.5,"label":"one"},{"key":"second"}]`)
+	rows, err = readDatatableRowsFile(jsonPath, buildTestDatatable())
+	if err != nil {
+		t.Fatalf("failed to read JSON rows: %v", err)
+	}
+	if !reflect.DeepEqual(rows, expected) {
+		t.Errorf("expected JSON rows %v, got %v", expected, rows)
+	}
+}
+
+func TestUnitReadDatatableRowsFileErrors(t *testing.T) {
+	testCases := map[string]struct {
+		fileName      string
+		content       string
+		expectedError string
+	}{
+		"missing key":        {"rows.json", `[{"label":"one"}]`, "has no key"},
+		"duplicate key":      {"rows.csv", "key,label\nfirst,one\nfirst,two\n", "more than one row"},
+		"unknown property":   {"rows.csv", "key,colour\nfirst,red\n", "not in the schema"},
+		"invalid integer":    {"rows.csv", "key,count\nfirst,many\n", "property count on line 2"},
+		"unsupported format": {"rows.txt", "first", "must be a .csv or .json file"},
+	}
+
+	for name, testCase := range testCases {
+		t.Run(name, func(t *testing.T) {
+			path := writeTestRowsFile(t, testCase.fileName, testCase.content)
+			_, err := readDatatableRowsFile(path, buildTestDatatable())
+			if err == nil || !strings.Contains(err.Error(), testCase.expectedError) {
+				t.Errorf("expected error containing %q, got %v", testCase.expectedError, err)
+			}
+		})
+	}
+}
+
+func TestUnitDiffDatatableRows(t *testing.T) {
+	desiredRows := []map[string]interface{}{
+		{"key": "same", "count": float64(1)},
+		{"key": "changed", "count": float64(2)},
+		{"key": "new", "count": float64(3)},
+	}
+	currentRows := []map[string]interface{}{
+		{"key": "same", "count": 1},
+		{"key": "changed", "count": 1},
+		{"key": "removed-b", "count": 1},
+		{"key": "removed-a", "count": 1},
+	}
+
+	changes := diffDatatableRows(desiredRows, currentRows)
+	if len(changes.creates) != 1 || changes.creates[0]["key"] != "new" {
+		t.Errorf("expected row new to be created, got %v", changes.creates)
+	}
+	if len(changes.updates) != 1 || changes.updates[0]["key"] != "changed" {
+		t.Errorf("expected row changed to be updated, got %v", changes.updates)
+	}
+	if !reflect.DeepEqual(changes.deletes, []string{"removed-a", "removed-b"}) {
+		t.Errorf("expected rows removed-a and removed-b to be deleted, got %v", changes.deletes)
+	}
+}
+
+func TestUnitApplyDatatableRowChanges(t *testing.T) {
+	var (
+		mutex   sync.Mutex
+		calls   []string
+		rowKeys []string
+	)
+	for i := 0; i < rowsBatchSize*2+1; i++ {
+		rowKeys = append(rowKeys, fmt.Sprintf("row-%02d", i))
+	}
+	record := func(call string) {
+		mutex.Lock()
+		defer mutex.Unlock()
+		calls = append(calls, call)
+	}
+
+	proxy := &architectDatatableRowsProxy{}
+	proxy.createArchitectDatatableRowAttr = func(_ context.Context, _ *architectDatatableRowsProxy, _ string, row *map[string]interface{}) (*platformclientv2.APIResponse, error) {
+		record("create " + (*row)["key"].(string))
+		return &platformclientv2.APIResponse{StatusCode: http.StatusOK}, nil
+	}
+	proxy.updateArchitectDatatableRowAttr = func(_ context.Context, _ *architectDatatableRowsProxy, _ string, key string, _ *map[string]interface{}) (*platformclientv2.APIResponse, error) {
+		record("update " + key)
+		return &platformclientv2.APIResponse{StatusCode: http.StatusOK}, nil
+	}
+	proxy.deleteArchitectDatatableRowAttr = func(_ context.Context, _ *architectDatatableRowsProxy, _ string, key string) (*platformclientv2.APIResponse, error) {
+		record("delete " + key)
+		if key == rowKeys[0] {
+			// Rows deleted by someone else are ignored
+			return &platformclientv2.APIResponse{StatusCode: http.StatusNotFound}, fmt.Errorf("not found")
+		}
+		return &platformclientv2.APIResponse{StatusCode: http.StatusNoContent}, nil
+	}
+
+	changes := datatableRowChanges{
+		deletes: rowKeys,
+		updates: []map[string]interface{}{{"key": "updated"}},
+		creates: []map[string]interface{}{{"key": "created"}},
+	}
+	if diagErr := applyDatatableRowChanges(context.Background(), proxy, "table-id", changes); diagErr != nil {
+		t.Fatalf("failed to apply changes: %v", diagErr)
+	}
+
+	if len(calls) != len(rowKeys)+2 {
+		t.Fatalf("expected %d calls, got %v", len(rowKeys)+2, calls)
+	}
+	deletes := append([]string{}, calls[:len(rowKeys)]...)
+	sort.Strings(deletes)
+	for i, key := range rowKeys {
+		if deletes[i] != "delete "+key {
+			t.Errorf("expected row %s to be deleted first, got %v", key, calls)
+		}
+	}
+	if calls[len(rowKeys)] != "update updated" || calls[len(rowKeys)+1] != "create created" {
+		t.Errorf("expected the update before the create, got %v", calls[len(rowKeys):])
+	}
+}
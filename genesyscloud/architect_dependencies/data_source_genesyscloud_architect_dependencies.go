@@ -0,0 +1,70 @@
+package architect_dependencies
+
+import (
+	"context"
+	"fmt"
+	"sort"
+	dependentConsumers "terraform-provider-genesyscloud/genesyscloud/dependent_consumers"
+	"terraform-provider-genesyscloud/genesyscloud/provider"
+	"terraform-provider-genesyscloud/genesyscloud/util"
+	"terraform-provider-genesyscloud/genesyscloud/util/resourcedata"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/retry"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/mypurecloud/platform-client-sdk-go/v152/platformclientv2"
+)
+
+func dataSourceArchitectDependenciesRead(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	sdkConfig := m.(*provider.ProviderMeta).ClientConfig
+	proxy := dependentConsumers.GetDependentConsumerProxy(sdkConfig)
+
+	objectId := d.Get("object_id").(string)
+	resourceType := d.Get("resource_type").(string)
+
+	return util.WithRetries(ctx, 15*time.Second, func() *retry.RetryError {
+		object, resp, err := proxy.GetDependencyObject(ctx, resourceType, objectId)
+		if err != nil {
+			if util.IsStatus404(resp) {
+				return retry.RetryableError(util.BuildWithRetriesApiDiagnosticError(ResourceType, fmt.Sprintf("failed to find dependencies of %s %s | error: %s", resourceType, objectId, err), resp))
+			}
+			return retry.NonRetryableError(util.BuildWithRetriesApiDiagnosticError(ResourceType, fmt.Sprintf("failed to get dependencies of %s %s | error: %s", resourceType, objectId, err), resp))
+		}
+
+		d.SetId(objectId)
+		resourcedata.SetNillableValue(d, "object_type", object.VarType)
+		_ = d.Set("consumers", flattenDependencies(object.ConsumingResources))
+		_ = d.Set("consumed_resources", flattenDependencies(object.ConsumedResources))
+		return nil
+	})
+}
+
+// flattenDependencies converts dependencies to the consumers and consumed_resources attributes, sorted by type and name
+func flattenDependencies(dependencies *[]platformclientv2.Dependency) []interface{} {
+	if dependencies == nil {
+		return nil
+	}
+
+	objectMaps := dependentConsumers.SetDependentObjectMaps()
+	dependencyList := make([]interface{}, 0, len(*dependencies))
+	for _, dependency := range *dependencies {
+		dependencyMap := make(map[string]interface{})
+		resourcedata.SetMapValueIfNotNil(dependencyMap, "id", dependency.Id)
+		resourcedata.SetMapValueIfNotNil(dependencyMap, "name", dependency.Name)
+		resourcedata.SetMapValueIfNotNil(dependencyMap, "type", dependency.VarType)
+		if dependency.VarType != nil {
+			dependencyMap["resource_type"] = objectMaps[*dependency.VarType]
+		}
+		dependencyList = append(dependencyList, dependencyMap)
+	}
+
+	sort.SliceStable(dependencyList, func(i, j int) bool {
+		a, b := dependencyList[i].(map[string]interface{}), dependencyList[j].(map[string]interface{})
+		if a["type"] != b["type"] {
+			return fmt.Sprint(a["type"]) < fmt.Sprint(b["type"])
+		}
+		return fmt.Sprint(a["name"]) < fmt.Sprint(b["name"])
+	})
+	return dependencyList
+}
@@ -0,0 +1,101 @@
+package architect_dependencies
+
+import (
+	"fmt"
+	"path/filepath"
+	"reflect"
+	architectFlow "terraform-provider-genesyscloud/genesyscloud/architect_flow"
+	"terraform-provider-genesyscloud/genesyscloud/provider"
+	routingQueue "terraform-provider-genesyscloud/genesyscloud/routing_queue"
+	"terraform-provider-genesyscloud/genesyscloud/util"
+	"testing"
+
+	"github.com/google/uuid"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/mypurecloud/platform-client-sdk-go/v152/platformclientv2"
+)
+
+func TestAccDataSourceArchitectDependencies(t *testing.T) {
+	var (
+		queueResourceLabel = "queue"
+		queueName          = "Terraform Queue-" + uuid.NewString()
+		flowResourceLabel  = "flow"
+		flowName           = "Terraform Flow-" + uuid.NewString()
+		filePath           = filepath.Join(t.TempDir(), "inboundcall_flow.yaml")
+		flowConfig         = fmt.Sprintf("inboundCall:\n  name: %s\n  defaultLanguage: en-us\n  startUpRef: ./menus/menu[mainMenu]\n  initialGreeting:\n    tts: Archy says hi!!!\n  menus:\n    - menu:\n        name: Main Menu\n        audio:\n          tts: Press 1 for the queue.\n        refId: mainMenu\n        choices:\n          - menuTransferToAcd:\n              name: Transfer\n              dtmf: digit_1\n              targetQueue:\n                lit:\n                  name: \"{{queue_name}}\"", flowName)
+
+		queueDependenciesLabel = "queue_dependencies"
+		flowDependenciesLabel  = "flow_dependencies"
+	)
+
+	config := routingQueue.GenerateRoutingQueueResourceBasic(queueResourceLabel, queueName) +
+		architectFlow.GenerateFlowResource(
+			flowResourceLabel,
+			filePath,
+			flowConfig,
+			false,
+			fmt.Sprintf("substitutions = {\n queue_name = %s.%s.name\n}", routingQueue.ResourceType, queueResourceLabel),
+		)
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:          func() { util.TestAccPreCheck(t) },
+		ProviderFactories: provider.GetProviderFactories(providerResources, providerDataSources),
+		Steps: []resource.TestStep{
+			{
+				Config: config +
+					generateArchitectDependenciesDataSource(queueDependenciesLabel, routingQueue.ResourceType+"."+queueResourceLabel+".id", routingQueue.ResourceType, architectFlow.ResourceType+"."+flowResourceLabel) +
+					generateArchitectDependenciesDataSource(flowDependenciesLabel, architectFlow.ResourceType+"."+flowResourceLabel+".id", architectFlow.ResourceType, ""),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("data."+ResourceType+"."+queueDependenciesLabel, "object_type", "QUEUE"),
+					resource.TestCheckResourceAttrPair("data."+ResourceType+"."+queueDependenciesLabel, "consumers.0.id", architectFlow.ResourceType+"."+flowResourceLabel, "id"),
+					resource.TestCheckResourceAttr("data."+ResourceType+"."+queueDependenciesLabel, "consumers.0.resource_type", architectFlow.ResourceType),
+					resource.TestCheckResourceAttr("data."+ResourceType+"."+flowDependenciesLabel, "object_type", "INBOUNDCALLFLOW"),
+					resource.TestCheckTypeSetElemAttrPair("data."+ResourceType+"."+flowDependenciesLabel, "consumed_resources.*.id", routingQueue.ResourceType+"."+queueResourceLabel, "id"),
+				),
+			},
+		},
+	})
+}
+
+func TestUnitFlattenDependencies(t *testing.T) {
+	var (
+		flowId    = uuid.NewString()
+		flowName  = "b flow"
+		flowType  = "INBOUNDCALLFLOW"
+		queueId   = uuid.NewString()
+		queueName = "a queue"
+		queueType = "QUEUE"
+		otherId   = uuid.NewString()
+		otherType = "UNKNOWNTYPE"
+	)
+
+	dependencies := []platformclientv2.Dependency{
+		{Id: &queueId, Name: &queueName, VarType: &queueType},
+		{Id: &otherId, VarType: &otherType},
+		{Id: &flowId, Name: &flowName, VarType: &flowType},
+	}
+	expected := []interface{}{
+		map[string]interface{}{"id": flowId, "name": flowName, "type": flowType, "resource_type": "genesyscloud_flow"},
+		map[string]interface{}{"id": queueId, "name": queueName, "type": queueType, "resource_type": "genesyscloud_routing_queue"},
+		map[string]interface{}{"id": otherId, "type": otherType, "resource_type": ""},
+	}
+
+	if actual := flattenDependencies(&dependencies); !reflect.DeepEqual(actual, expected) {
+		t.Errorf("expected dependencies %v, got %v", expected, actual)
+	}
+	if actual := flattenDependencies(nil); actual != nil {
+		t.Errorf("expected no dependencies, got %v", actual)
+	}
+}
+
+func generateArchitectDependenciesDataSource(dataSourceLabel string, objectId string, resourceType string, dependsOn string) string {
+	if dependsOn != "" {
+		dependsOn = fmt.Sprintf("depends_on = [%s]", dependsOn)
+	}
+	return fmt.Sprintf(`data "%s" "%s" {
+		object_id     = %s
+		resource_type = "%s"
+		%s
+	}
+	`, ResourceType, dataSourceLabel, objectId, resourceType, dependsOn)
+}
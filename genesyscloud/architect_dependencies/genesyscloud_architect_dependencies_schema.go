@@ -0,0 +1,80 @@
+package architect_dependencies
+
+import (
+	dependentConsumers "terraform-provider-genesyscloud/genesyscloud/dependent_consumers"
+	"terraform-provider-genesyscloud/genesyscloud/provider"
+	registrar "terraform-provider-genesyscloud/genesyscloud/resource_register"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+)
+
+const ResourceType = "genesyscloud_architect_dependencies"
+
+// SetRegistrar registers all of the resources, datasources and exporters in the package
+func SetRegistrar(l registrar.Registrar) {
+	l.RegisterDataSource(ResourceType, DataSourceArchitectDependencies())
+}
+
+var dependencyResource = &schema.Resource{
+	Schema: map[string]*schema.Schema{
+		"id": {
+			Description: "ID of the object.",
+			Type:        schema.TypeString,
+			Computed:    true,
+		},
+		"name": {
+			Description: "Name of the object.",
+			Type:        schema.TypeString,
+			Computed:    true,
+		},
+		"type": {
+			Description: "Architect dependency tracking type of the object, e.g. `QUEUE` or `INBOUNDCALLFLOW`.",
+			Type:        schema.TypeString,
+			Computed:    true,
+		},
+		"resource_type": {
+			Description: "Terraform resource type of the object. Empty if the object has no resource type.",
+			Type:        schema.TypeString,
+			Computed:    true,
+		},
+	},
+}
+
+// DataSourceArchitectDependencies registers the genesyscloud_architect_dependencies data source
+func DataSourceArchitectDependencies() *schema.Resource {
+	return &schema.Resource{
+		Description: "Data source for Genesys Cloud Architect dependency tracking. Returns the objects that consume an object, e.g. the flows that use a queue, and the objects it consumes. The published version of a flow is used.",
+		ReadContext: provider.ReadWithPooledClient(dataSourceArchitectDependenciesRead),
+		Schema: map[string]*schema.Schema{
+			"object_id": {
+				Description: "ID of the object.",
+				Type:        schema.TypeString,
+				Required:    true,
+			},
+			"resource_type": {
+				Description:  "Terraform resource type of the object, e.g. `genesyscloud_flow` or `genesyscloud_routing_queue`.",
+				Type:         schema.TypeString,
+				Required:     true,
+				ValidateFunc: validation.StringInSlice(dependentConsumers.GetResourceTypes(), false),
+			},
+			"object_type": {
+				Description: "Architect dependency tracking type of the object.",
+				Type:        schema.TypeString,
+				Computed:    true,
+			},
+			"consumers": {
+				Description: "Objects that consume the object.",
+				Type:        schema.TypeList,
+				Computed:    true,
+				Elem:        dependencyResource,
+			},
+			"consumed_resources": {
+				Description: "Objects that the object consumes.",
+				Type:        schema.TypeList,
+				Computed:    true,
+				Elem:        dependencyResource,
+			},
+		},
+	}
+}
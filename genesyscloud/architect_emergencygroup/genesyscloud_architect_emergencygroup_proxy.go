@@ -42,11 +42,10 @@ func newArchitectEmergencyGroupProxy(clientConfig *platformclientv2.Configuratio
 }
 
 func getArchitectEmergencyGroupProxy(clientConfig *platformclientv2.Configuration) *architectEmergencyGroupProxy {
-	if internalProxy == nil {
-		internalProxy = newArchitectEmergencyGroupProxy(clientConfig)
+	if internalProxy != nil {
+		return internalProxy
 	}
-
-	return internalProxy
+	return newArchitectEmergencyGroupProxy(clientConfig)
 }
 
 func (p *architectEmergencyGroupProxy) getAllArchitectEmergencyGroups(ctx context.Context) (*[]platformclientv2.Emergencygroup, *platformclientv2.APIResponse, error) {
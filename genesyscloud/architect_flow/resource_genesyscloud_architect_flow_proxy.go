@@ -2,8 +2,13 @@ package architect_flow
 
 import (
 	"context"
+	"encoding/json"
 	"fmt"
 	"log"
+	"os"
+	"os/exec"
+	"path/filepath"
+	"strings"
 	rc "terraform-provider-genesyscloud/genesyscloud/resource_cache"
 
 	"github.com/mypurecloud/platform-client-sdk-go/v152/platformclientv2"
@@ -11,6 +16,8 @@ import (
 
 var internalProxy *architectFlowProxy
 
+var flowCache = rc.NewResourceCache[platformclientv2.Flow]()
+
 type getArchitectFunc func(context.Context, *architectFlowProxy, string) (*platformclientv2.Flow, *platformclientv2.APIResponse, error)
 type forceUnlockFlowFunc func(context.Context, *architectFlowProxy, string) (*platformclientv2.APIResponse, error)
 type deleteArchitectFlowFunc func(context.Context, *architectFlowProxy, string) (*platformclientv2.APIResponse, error)
@@ -18,6 +25,11 @@ type createArchitectFlowJobsFunc func(context.Context, *architectFlowProxy) (*pl
 type getArchitectFlowJobsFunc func(context.Context, *architectFlowProxy, string) (*platformclientv2.Architectjobstateresponse, *platformclientv2.APIResponse, error)
 type getAllArchitectFlowsFunc func(context.Context, *architectFlowProxy, string, []string) (*[]platformclientv2.Flow, *platformclientv2.APIResponse, error)
 type getFlowIdByNameAndTypeFunc func(ctx context.Context, a *architectFlowProxy, name string, varType string) (id string, resp *platformclientv2.APIResponse, retryable bool, err error)
+type getFlowVersionConfigurationFunc func(ctx context.Context, a *architectFlowProxy, flowId string, versionId string) (*interface{}, *platformclientv2.APIResponse, error)
+type checkoutFlowFunc func(ctx context.Context, a *architectFlowProxy, flowId string) (*platformclientv2.Flow, *platformclientv2.APIResponse, error)
+type createFlowVersionFunc func(ctx context.Context, a *architectFlowProxy, flowId string, configuration interface{}) (*platformclientv2.Flowversion, *platformclientv2.APIResponse, error)
+type publishFlowFunc func(ctx context.Context, a *architectFlowProxy, flowId string, versionId string) (*platformclientv2.Operation, *platformclientv2.APIResponse, error)
+type exportFlowFunc func(ctx context.Context, a *architectFlowProxy, flow platformclientv2.Flow, versionId string, outputDir string) (filePath string, err error)
 
 type architectFlowProxy struct {
 	clientConfig *platformclientv2.Configuration
@@ -30,13 +42,17 @@ type architectFlowProxy struct {
 	createArchitectFlowJobsAttr createArchitectFlowJobsFunc
 	getArchitectFlowJobsAttr    getArchitectFlowJobsFunc
 	getFlowIdByNameAndTypeAttr  getFlowIdByNameAndTypeFunc
+	getFlowVersionConfigAttr    getFlowVersionConfigurationFunc
+	checkoutFlowAttr            checkoutFlowFunc
+	createFlowVersionAttr       createFlowVersionFunc
+	publishFlowAttr             publishFlowFunc
+	exportFlowAttr              exportFlowFunc
 
 	flowCache rc.CacheInterface[platformclientv2.Flow]
 }
 
 func newArchitectFlowProxy(clientConfig *platformclientv2.Configuration) *architectFlowProxy {
 	api := platformclientv2.NewArchitectApiWithConfig(clientConfig)
-	flowCache := rc.NewResourceCache[platformclientv2.Flow]()
 	return &architectFlowProxy{
 		clientConfig: clientConfig,
 		api:          api,
@@ -48,15 +64,20 @@ func newArchitectFlowProxy(clientConfig *platformclientv2.Configuration) *archit
 		createArchitectFlowJobsAttr: createArchitectFlowJobsFn,
 		getArchitectFlowJobsAttr:    getArchitectFlowJobsFn,
 		getFlowIdByNameAndTypeAttr:  getFlowIdByNameAndTypeFn,
+		getFlowVersionConfigAttr:    getFlowVersionConfigurationFn,
+		checkoutFlowAttr:            checkoutFlowFn,
+		createFlowVersionAttr:       createFlowVersionFn,
+		publishFlowAttr:             publishFlowFn,
+		exportFlowAttr:              exportFlowFn,
 		flowCache:                   flowCache,
 	}
 }
 
 func getArchitectFlowProxy(clientConfig *platformclientv2.Configuration) *architectFlowProxy {
-	if internalProxy == nil {
-		internalProxy = newArchitectFlowProxy(clientConfig)
+	if internalProxy != nil {
+		return internalProxy
 	}
-	return internalProxy
+	return newArchitectFlowProxy(clientConfig)
 }
 
 func (a *architectFlowProxy) GetFlow(ctx context.Context, id string) (*platformclientv2.Flow, *platformclientv2.APIResponse, error) {
@@ -87,6 +108,31 @@ func (a *architectFlowProxy) getFlowIdByNameAndType(ctx context.Context, name, v
 	return a.getFlowIdByNameAndTypeAttr(ctx, a, name, varType)
 }
 
+func (a *architectFlowProxy) GetFlowVersionConfiguration(ctx context.Context, flowId, versionId string) (*interface{}, *platformclientv2.APIResponse, error) {
+	return a.getFlowVersionConfigAttr(ctx, a, flowId, versionId)
+}
+
+// CheckoutFlow locks the flow so new versions can be saved
+func (a *architectFlowProxy) CheckoutFlow(ctx context.Context, flowId string) (*platformclientv2.Flow, *platformclientv2.APIResponse, error) {
+	return a.checkoutFlowAttr(ctx, a, flowId)
+}
+
+// CreateFlowVersion saves a flow configuration in Architect's own format as a new version of a checked out flow
+func (a *architectFlowProxy) CreateFlowVersion(ctx context.Context, flowId string, configuration interface{}) (*platformclientv2.Flowversion, *platformclientv2.APIResponse, error) {
+	return a.createFlowVersionAttr(ctx, a, flowId, configuration)
+}
+
+// PublishFlow starts publishing a saved version of a flow. Publishing is asynchronous.
+func (a *architectFlowProxy) PublishFlow(ctx context.Context, flowId, versionId string) (*platformclientv2.Operation, *platformclientv2.APIResponse, error) {
+	return a.publishFlowAttr(ctx, a, flowId, versionId)
+}
+
+// ExportFlow exports a version of a flow as an Archy YAML file to outputDir and returns the path of the file.
+// errArchyNotFound is returned when the Archy CLI is not installed.
+func (a *architectFlowProxy) ExportFlow(ctx context.Context, flow platformclientv2.Flow, versionId, outputDir string) (string, error) {
+	return a.exportFlowAttr(ctx, a, flow, versionId, outputDir)
+}
+
 func getFlowIdByNameAndTypeFn(ctx context.Context, a *architectFlowProxy, name, varType string) (string, *platformclientv2.APIResponse, bool, error) {
 	var (
 		matchedFlowIds []string
@@ -128,8 +174,8 @@ func getFlowIdByNameAndTypeFn(ctx context.Context, a *architectFlowProxy, name,
 	return "", nil, true, noFlowsFoundErr
 }
 
-func getArchitectFlowFn(_ context.Context, p *architectFlowProxy, id string) (*platformclientv2.Flow, *platformclientv2.APIResponse, error) {
-	flow := rc.GetCacheItem(p.flowCache, id)
+func getArchitectFlowFn(ctx context.Context, p *architectFlowProxy, id string) (*platformclientv2.Flow, *platformclientv2.APIResponse, error) {
+	flow := rc.GetCacheItem(ctx, p.flowCache, id)
 	if flow != nil {
 		return flow, nil, nil
 	}
@@ -185,3 +231,66 @@ func getAllArchitectFlowsFn(ctx context.Context, p *architectFlowProxy, name str
 
 	return &totalFlows, nil, nil
 }
+
+func getFlowVersionConfigurationFn(_ context.Context, p *architectFlowProxy, flowId, versionId string) (*interface{}, *platformclientv2.APIResponse, error) {
+	return p.api.GetFlowVersionConfiguration(flowId, versionId, "false")
+}
+
+func checkoutFlowFn(_ context.Context, p *architectFlowProxy, flowId string) (*platformclientv2.Flow, *platformclientv2.APIResponse, error) {
+	return p.api.PostFlowsActionsCheckout(flowId)
+}
+
+func createFlowVersionFn(_ context.Context, p *architectFlowProxy, flowId string, configuration interface{}) (*platformclientv2.Flowversion, *platformclientv2.APIResponse, error) {
+	return p.api.PostFlowVersions(flowId, configuration)
+}
+
+func publishFlowFn(_ context.Context, p *architectFlowProxy, flowId, versionId string) (*platformclientv2.Operation, *platformclientv2.APIResponse, error) {
+	return p.api.PostFlowsActionsPublish(flowId, versionId)
+}
+
+// exportFlowFn runs the export command of the Archy CLI. The API has no endpoint that returns the YAML of a flow.
+// The auth token is passed in an options file so that it does not show up in the process list.
+func exportFlowFn(ctx context.Context, p *architectFlowProxy, flow platformclientv2.Flow, versionId, outputDir string) (string, error) {
+	archyPath, err := getArchyPath()
+	if err != nil {
+		return "", err
+	}
+
+	optionsFile, err := os.CreateTemp("", "archy-options-*.json")
+	if err != nil {
+		return "", err
+	}
+	defer os.Remove(optionsFile.Name())
+	options, _ := json.Marshal(map[string]string{
+		"authToken": p.clientConfig.AccessToken,
+		"location":  getArchyLocation(p.clientConfig.BasePath),
+	})
+	_, err = optionsFile.Write(options)
+	if closeErr := optionsFile.Close(); err == nil {
+		err = closeErr
+	}
+	if err != nil {
+		return "", err
+	}
+
+	cmd := exec.CommandContext(ctx, archyPath, "export",
+		"--flowName", *flow.Name,
+		"--flowType", strings.ToLower(*flow.VarType),
+		"--flowVersion", versionId,
+		"--exportType", "yaml",
+		"--outputDir", outputDir,
+		"--optionsFile", optionsFile.Name(),
+		"--force")
+	if output, err := cmd.CombinedOutput(); err != nil {
+		return "", fmt.Errorf("archy export of flow %s failed: %v: %s", *flow.Name, err, output)
+	}
+
+	exportedFiles, err := filepath.Glob(filepath.Join(outputDir, "*.yaml"))
+	if err != nil {
+		return "", err
+	}
+	if len(exportedFiles) != 1 {
+		return "", fmt.Errorf("expected archy to export one YAML file for flow %s, found %d", *flow.Name, len(exportedFiles))
+	}
+	return exportedFiles[0], nil
+}
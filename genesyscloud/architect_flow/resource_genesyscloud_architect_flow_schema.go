@@ -3,6 +3,7 @@ package architect_flow
 import (
 	"strings"
 	"terraform-provider-genesyscloud/genesyscloud/validators"
+	"time"
 
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
@@ -14,6 +15,9 @@ import (
 
 const (
 	ResourceType = "genesyscloud_flow"
+
+	// Max duration of the post publish check command
+	postPublishCheckTimeout = 10 * time.Minute
 )
 
 // SetRegistrar registers all resources, data sources and exporters in the package
@@ -27,11 +31,13 @@ func ArchitectFlowExporter() *resourceExporter.ResourceExporter {
 	return &resourceExporter.ResourceExporter{
 		GetResourcesFunc: provider.GetAllWithPooledClient(getAllFlows),
 		RefAttrs:         map[string]*resourceExporter.RefAttrSettings{},
+		// Replaced by the exported YAML file for every published flow that Archy can export
 		UnResolvableAttributes: map[string]*schema.Schema{
 			"filepath": ResourceArchitectFlow().Schema["filepath"],
 		},
-		CustomFlowResolver: map[string]*resourceExporter.CustomFlowResolver{
-			"file_content_hash": {ResolverFunc: resourceExporter.FileContentHashResolver},
+		CustomFileWriter: resourceExporter.CustomFileWriterSettings{
+			RetrieveAndWriteFilesFunc: ArchitectFlowResolver,
+			SubDirectory:              "flows",
 		},
 	}
 }
@@ -48,6 +54,7 @@ func ResourceArchitectFlow() *schema.Resource {
 			StateContext: schema.ImportStatePassthroughContext,
 		},
 		SchemaVersion: 1,
+		CustomizeDiff: customizeFlowDiff,
 		Schema: map[string]*schema.Schema{
 			"name": {
 				Description: "Flow Name used for export purposes. Note: The 'substitutions' block should be used to set/change 'name' and any other fields in the yaml file",
@@ -77,6 +84,27 @@ func ResourceArchitectFlow() *schema.Resource {
 				Type:        schema.TypeMap,
 				Optional:    true,
 			},
+			"pinned_version": {
+				Description: "Version of the flow to publish instead of the file at `filepath`, e.g. `3.0`. The configuration of the version is saved and published again as a new version, so setting this to a previous version rolls the flow back without the old YAML file. Removing it publishes the file again. Can only be set on an existing flow.",
+				Type:        schema.TypeString,
+				Optional:    true,
+			},
+			"post_publish_check_command": {
+				Description: "Command that checks the flow after it is published, e.g. a script that places a test call. The command is run by the shell with the `GENESYSCLOUD_FLOW_ID` and `GENESYSCLOUD_FLOW_VERSION` environment variables set to the flow and the version that was published. If the command fails, the version that was published before is published again as a new version and the apply fails. The command is stopped after 10 minutes.",
+				Type:        schema.TypeString,
+				Optional:    true,
+			},
+			"published_version": {
+				Description: "Version of the flow that is currently published. Every publish creates a new version. Architect keeps all versions of a flow and has no API to delete them, so the number of versions is not limited.",
+				Type:        schema.TypeString,
+				Computed:    true,
+			},
+			"validate_on_plan": {
+				Description: "Validate the flow configuration file during plan, after the substitutions are applied. The file must be valid YAML with one flow type and a flow name, every substitution in the file must be set and every reference, e.g. startUpRef, must refer to an element with that refId. Architect itself only validates the flow when it is published.",
+				Type:        schema.TypeBool,
+				Optional:    true,
+				Default:     false,
+			},
 			"force_unlock": {
 				Description: `Will perform a force unlock on an architect flow before beginning the publication process.  NOTE: The force unlock publishes the 'draft'
 				              architect flow and then publishes the flow named in this resource. This mirrors the behavior found in the archy CLI tool.`,
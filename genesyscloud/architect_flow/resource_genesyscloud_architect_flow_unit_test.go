@@ -0,0 +1,325 @@
+//go:build unit
+// +build unit
+
+package architect_flow
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"os"
+	"path/filepath"
+	"reflect"
+	"runtime"
+	"strings"
+	"terraform-provider-genesyscloud/genesyscloud/provider"
+	resourceExporter "terraform-provider-genesyscloud/genesyscloud/resource_exporter"
+	"testing"
+
+	"github.com/google/uuid"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+	"github.com/mypurecloud/platform-client-sdk-go/v152/platformclientv2"
+)
+
+func TestUnitArchitectFlowResolver(t *testing.T) {
+	var (
+		flowId     = uuid.NewString()
+		versionId  = "3.0"
+		blockLabel = "inboundcall_test_flow"
+		exportDir  = t.TempDir()
+		flowName   = "test flow"
+		flowType   = "INBOUNDCALL"
+		flowYaml   = "inboundCall:\n  name: test flow\n  startUpRef: ./menus/menu[mainMenu]\n"
+		exportErr  error
+	)
+
+	publishedVersion := &platformclientv2.Flowversion{Id: &versionId}
+	flowProxy := newArchitectFlowProxy(nil)
+	flowProxy.getArchitectFlowAttr = func(ctx context.Context, p *architectFlowProxy, id string) (*platformclientv2.Flow, *platformclientv2.APIResponse, error) {
+		return &platformclientv2.Flow{
+			Id:               &id,
+			Name:             &flowName,
+			VarType:          &flowType,
+			PublishedVersion: publishedVersion,
+		}, nil, nil
+	}
+	flowProxy.exportFlowAttr = func(ctx context.Context, p *architectFlowProxy, flow platformclientv2.Flow, version string, outputDir string) (string, error) {
+		if *flow.Name != flowName || version != versionId {
+			t.Errorf("Expected version %s of flow %s to be exported, got version %s of flow %s", versionId, flowName, version, *flow.Name)
+		}
+		if exportErr != nil {
+			return "", exportErr
+		}
+		filePath := filepath.Join(outputDir, "test-flow_v3-0.yaml")
+		return filePath, os.WriteFile(filePath, []byte(flowYaml), 0600)
+	}
+	internalProxy = flowProxy
+	defer func() { internalProxy = nil }()
+
+	newResource := func() resourceExporter.ResourceInfo {
+		return resourceExporter.ResourceInfo{
+			BlockLabel: blockLabel,
+			Type:       ResourceType,
+			State: &terraform.InstanceState{
+				ID:         flowId,
+				Attributes: map[string]string{},
+			},
+		}
+	}
+
+	configMap := map[string]interface{}{
+		"name": flowName,
+		"type": flowType,
+	}
+	resource := newResource()
+	err := ArchitectFlowResolver(flowId, exportDir, "flows", configMap, &provider.ProviderMeta{}, resource)
+	if err != nil {
+		t.Fatalf("Expected error to be nil, got '%v'", err)
+	}
+
+	expectedFilePath := filepath.Join("flows", blockLabel+".yaml")
+	if configMap["filepath"] != expectedFilePath || resource.State.Attributes["filepath"] != expectedFilePath {
+		t.Errorf("Expected filepath to be %s, got %v", expectedFilePath, configMap["filepath"])
+	}
+	if !strings.Contains(configMap["file_content_hash"].(string), expectedFilePath) {
+		t.Errorf("Expected file_content_hash to reference %s, got %v", expectedFilePath, configMap["file_content_hash"])
+	}
+	if resource.State.Attributes["file_content_hash"] == "" {
+		t.Errorf("Expected file_content_hash to be set on the resource state")
+	}
+
+	// The exported file holds the YAML of Archy unchanged, so it can be deployed as it is
+	exported, err := os.ReadFile(filepath.Join(exportDir, expectedFilePath))
+	if err != nil {
+		t.Fatalf("Failed to read exported flow file: %v", err)
+	}
+	if string(exported) != flowYaml {
+		t.Errorf("Expected the exported flow file to hold the YAML exported by archy, got %s", exported)
+	}
+
+	// A flow that cannot be exported because archy is not installed keeps the filepath variable
+	variable := "${var.genesyscloud_flow_" + blockLabel + "_filepath}"
+	expectedHash := "${filesha256(var.genesyscloud_flow_" + blockLabel + "_filepath)}"
+	exportErr = fmt.Errorf("%w. Install archy", errArchyNotFound)
+	configMap = map[string]interface{}{"filepath": variable}
+	if err := ArchitectFlowResolver(flowId, exportDir, "flows", configMap, &provider.ProviderMeta{}, newResource()); err != nil {
+		t.Fatalf("Expected error to be nil, got '%v'", err)
+	}
+	if configMap["filepath"] != variable || configMap["file_content_hash"] != expectedHash {
+		t.Errorf("Expected filepath to stay %s when archy is not installed, got %v", variable, configMap["filepath"])
+	}
+
+	// Other export failures fail the export
+	exportErr = fmt.Errorf("archy export of flow %s failed", flowName)
+	if err := ArchitectFlowResolver(flowId, exportDir, "flows", map[string]interface{}{}, &provider.ProviderMeta{}, newResource()); err == nil {
+		t.Errorf("Expected the failed archy export to be returned")
+	}
+
+	// A flow that was never published keeps the filepath variable
+	publishedVersion = nil
+	configMap = map[string]interface{}{"filepath": variable}
+	if err := ArchitectFlowResolver(flowId, exportDir, "flows", configMap, &provider.ProviderMeta{}, newResource()); err != nil {
+		t.Fatalf("Expected error to be nil, got '%v'", err)
+	}
+	if configMap["filepath"] != variable || configMap["file_content_hash"] != expectedHash {
+		t.Errorf("Expected filepath to stay %s for a flow without a published version, got %v", variable, configMap["filepath"])
+	}
+}
+
+func TestUnitGetArchyLocation(t *testing.T) {
+	for basePath, location := range map[string]string{
+		"https://api.mypurecloud.com": "mypurecloud.com",
+		"https://api.mypurecloud.ie":  "mypurecloud.ie",
+		"https://api.euw2.pure.cloud": "euw2.pure.cloud",
+	} {
+		if got := getArchyLocation(basePath); got != location {
+			t.Errorf("Expected location %s for %s, got %s", location, basePath, got)
+		}
+	}
+}
+
+func TestUnitValidateFlowConfiguration(t *testing.T) {
+	validFlow := `inboundCall:
+  name: {{flow_name}}
+  defaultLanguage: en-us
+  startUpRef: "/inboundCall/tasks/task[Main Task_10]"
+  tasks:
+    - task:
+        name: Main Task
+        refId: Main Task_10
+        actions:
+          - disconnect:
+              name: Disconnect
+`
+	content := substituteFlowValues(validFlow, map[string]interface{}{"flow_name": "test flow"})
+	if err := validateFlowConfiguration(content); err != nil {
+		t.Errorf("expected flow configuration to be valid, got %v", err)
+	}
+
+	testCases := map[string]struct {
+		content       string
+		expectedError string
+	}{
+		"unresolved substitution": {validFlow, "no substitution is set for {{flow_name}}"},
+		"invalid yaml":            {"inboundCall: [name", "failed to parse YAML"},
+		"several flows":           {"inboundCall:\n  name: a\ninboundChat:\n  name: b\n", "exactly one top-level key"},
+		"unknown flow type":       {"callFlow:\n  name: a\n", "unknown flow type callFlow"},
+		"missing name":            {"inboundCall:\n  defaultLanguage: en-us\n", "the flow has no name"},
+		"unknown reference":       {strings.Replace(content, "refId: Main Task_10", "refId: Main Task_11", 1), "no element has refId Main Task_10"},
+	}
+
+	for name, testCase := range testCases {
+		t.Run(name, func(t *testing.T) {
+			err := validateFlowConfiguration(testCase.content)
+			if err == nil || !strings.Contains(err.Error(), testCase.expectedError) {
+				t.Errorf("expected error containing %q, got %v", testCase.expectedError, err)
+			}
+		})
+	}
+}
+
+func TestUnitCustomizeFlowDiffValidatesKnownValues(t *testing.T) {
+	// The value Terraform sets for attributes that are only known after apply
+	const unknownValue = "74D93920-ED26-11E3-AC10-0800200C9A66"
+
+	// The file does not exist yet, because it is generated during apply
+	filePath := filepath.Join(t.TempDir(), "generated_flow.yaml")
+
+	testCases := map[string]struct {
+		fileContentHash string
+		expectError     bool
+	}{
+		"known hash":   {"hash", true},
+		"unknown hash": {unknownValue, false},
+	}
+
+	for name, testCase := range testCases {
+		t.Run(name, func(t *testing.T) {
+			config := terraform.NewResourceConfigRaw(map[string]interface{}{
+				"filepath":          filePath,
+				"file_content_hash": testCase.fileContentHash,
+				"validate_on_plan":  true,
+			})
+			_, err := ResourceArchitectFlow().Diff(context.Background(), nil, config, nil)
+			if testCase.expectError && err == nil {
+				t.Errorf("Expected the missing file %s to fail validation", filePath)
+			}
+			if !testCase.expectError && err != nil {
+				t.Errorf("Expected validation to be skipped, got %v", err)
+			}
+		})
+	}
+}
+
+// A flow version configuration as returned by Architect. It is Architect's own flow definition rather than Archy YAML,
+// so it must be saved as a new version unchanged.
+const flowVersionConfiguration = `{
+  "name": "test flow",
+  "type": "INBOUNDCALL",
+  "defaultLanguage": "en-us",
+  "supportedLanguages": [{"language": "en-us", "defaultLanguageSkill": {"id": "c6b7f3a0-0d6e-4a58-9c41-1f1c6a2b5e10"}}],
+  "flowSequenceItemList": [{"id": "0d9d5d6a-5a8e-4c4b-8d6c-6a0b2c7f2e11", "name": "Main Menu", "__type": "Menu"}],
+  "startUpObject": {"id": "0d9d5d6a-5a8e-4c4b-8d6c-6a0b2c7f2e11"}
+}`
+
+// newPublishingFlowProxy returns a proxy for a flow with the given versions. Saved versions are published right away.
+func newPublishingFlowProxy(t *testing.T, flowId string, versions map[string]interface{}, publishedVersion *string) *architectFlowProxy {
+	flowProxy := newArchitectFlowProxy(nil)
+	flowProxy.getArchitectFlowAttr = func(ctx context.Context, p *architectFlowProxy, id string) (*platformclientv2.Flow, *platformclientv2.APIResponse, error) {
+		version := *publishedVersion
+		return &platformclientv2.Flow{Id: &id, PublishedVersion: &platformclientv2.Flowversion{Id: &version}}, nil, nil
+	}
+	flowProxy.getFlowVersionConfigAttr = func(ctx context.Context, p *architectFlowProxy, id string, version string) (*interface{}, *platformclientv2.APIResponse, error) {
+		configuration, ok := versions[version]
+		if id != flowId || !ok {
+			return nil, &platformclientv2.APIResponse{StatusCode: http.StatusNotFound}, fmt.Errorf("version %s of flow %s not found", version, id)
+		}
+		return &configuration, nil, nil
+	}
+	checkedOut := false
+	flowProxy.checkoutFlowAttr = func(ctx context.Context, p *architectFlowProxy, id string) (*platformclientv2.Flow, *platformclientv2.APIResponse, error) {
+		checkedOut = true
+		return &platformclientv2.Flow{Id: &id}, nil, nil
+	}
+	flowProxy.createFlowVersionAttr = func(ctx context.Context, p *architectFlowProxy, id string, configuration interface{}) (*platformclientv2.Flowversion, *platformclientv2.APIResponse, error) {
+		if !checkedOut {
+			t.Errorf("Expected flow %s to be checked out before a version is saved", id)
+		}
+		versionId := fmt.Sprintf("%d.0", len(versions)+1)
+		versions[versionId] = configuration
+		return &platformclientv2.Flowversion{Id: &versionId}, nil, nil
+	}
+	flowProxy.publishFlowAttr = func(ctx context.Context, p *architectFlowProxy, id string, versionId string) (*platformclientv2.Operation, *platformclientv2.APIResponse, error) {
+		*publishedVersion = versionId
+		checkedOut = false
+		return &platformclientv2.Operation{}, nil, nil
+	}
+	return flowProxy
+}
+
+func TestUnitPublishFlowVersion(t *testing.T) {
+	var configuration interface{}
+	if err := json.Unmarshal([]byte(flowVersionConfiguration), &configuration); err != nil {
+		t.Fatalf("Failed to parse flow configuration: %v", err)
+	}
+	flowId := uuid.NewString()
+	publishedVersion := "2.0"
+	versions := map[string]interface{}{"1.0": configuration, "2.0": map[string]interface{}{"name": "test flow"}}
+	flowProxy := newPublishingFlowProxy(t, flowId, versions, &publishedVersion)
+
+	newVersion, err := publishFlowVersion(context.Background(), flowProxy, flowId, "1.0")
+	if err != nil {
+		t.Fatalf("Expected error to be nil, got '%v'", err)
+	}
+	if newVersion != "3.0" || publishedVersion != "3.0" {
+		t.Errorf("Expected version 3.0 to be saved and published, got %s with %s published", newVersion, publishedVersion)
+	}
+	// The configuration is saved as it was returned, without converting it to another format
+	if !reflect.DeepEqual(versions["3.0"], configuration) {
+		t.Errorf("Expected the configuration of version 1.0 to be saved unchanged, got %v", versions["3.0"])
+	}
+
+	if _, err := publishFlowVersion(context.Background(), flowProxy, flowId, "9.0"); err == nil || !strings.Contains(err.Error(), "version 9.0") {
+		t.Errorf("Expected an error for a missing version, got %v", err)
+	}
+}
+
+func TestUnitCheckPublishedFlowRollsBack(t *testing.T) {
+	if runtime.GOOS == "windows" {
+		t.Skip("The check commands require a POSIX shell")
+	}
+	flowId := uuid.NewString()
+	newResourceData := func(command string) *schema.ResourceData {
+		d := schema.TestResourceDataRaw(t, ResourceArchitectFlow().Schema, map[string]interface{}{"post_publish_check_command": command})
+		d.SetId(flowId)
+		return d
+	}
+
+	// A passing check keeps the published version
+	publishedVersion := "2.0"
+	versions := map[string]interface{}{"1.0": map[string]interface{}{"name": "v1"}, "2.0": map[string]interface{}{"name": "v2"}}
+	flowProxy := newPublishingFlowProxy(t, flowId, versions, &publishedVersion)
+	if err := checkPublishedFlow(context.Background(), flowProxy, newResourceData(`test "$GENESYSCLOUD_FLOW_VERSION" = 2.0`), "1.0"); err != nil {
+		t.Errorf("Expected the check to pass, got %v", err)
+	}
+	if publishedVersion != "2.0" {
+		t.Errorf("Expected version 2.0 to stay published, got %s", publishedVersion)
+	}
+
+	// A failing check publishes the previous version again
+	err := checkPublishedFlow(context.Background(), flowProxy, newResourceData(`echo "test call failed"; exit 1`), "1.0")
+	if err == nil || !strings.Contains(err.Error(), "test call failed") || !strings.Contains(err.Error(), "Rolled back to version 1.0") {
+		t.Errorf("Expected the flow to be rolled back after a failed check, got %v", err)
+	}
+	if publishedVersion != "3.0" || !reflect.DeepEqual(versions["3.0"], versions["1.0"]) {
+		t.Errorf("Expected the configuration of version 1.0 to be published as version 3.0, got %s published", publishedVersion)
+	}
+
+	// A new flow has no version to roll back to
+	err = checkPublishedFlow(context.Background(), flowProxy, newResourceData("exit 1"), "")
+	if err == nil || !strings.Contains(err.Error(), "no previous version") {
+		t.Errorf("Expected an error without a previous version, got %v", err)
+	}
+}
@@ -1,12 +1,30 @@
 package architect_flow
 
 import (
+	"context"
+	"errors"
 	"fmt"
+	"io"
 	"log"
+	"os"
+	"os/exec"
+	"path/filepath"
+	"regexp"
+	"runtime"
+	"sort"
 	"strconv"
 	"strings"
+	"terraform-provider-genesyscloud/genesyscloud/provider"
+	rc "terraform-provider-genesyscloud/genesyscloud/resource_cache"
+	resourceExporter "terraform-provider-genesyscloud/genesyscloud/resource_exporter"
+	"terraform-provider-genesyscloud/genesyscloud/util"
+	"terraform-provider-genesyscloud/genesyscloud/util/files"
+	"terraform-provider-genesyscloud/genesyscloud/util/lists"
+	"time"
 
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/retry"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"gopkg.in/yaml.v3"
 )
 
 func isForceUnlockEnabled(d *schema.ResourceData) bool {
@@ -40,3 +58,332 @@ func GenerateFlowResource(resourceLabel, srcFile, fileContent string, forceUnloc
 func setFileContentHashToNil(d *schema.ResourceData) {
 	_ = d.Set("file_content_hash", nil)
 }
+
+// Environment variable with the path of the Archy CLI. Archy is looked up in the PATH when it is not set.
+const archyPathEnvVar = "GENESYSCLOUD_ARCHY_PATH"
+
+// errArchyNotFound is returned by ExportFlow when the Archy CLI is not installed
+var errArchyNotFound = errors.New("archy was not found")
+
+func getArchyPath() (string, error) {
+	if archyPath := os.Getenv(archyPathEnvVar); archyPath != "" {
+		return archyPath, nil
+	}
+	archyPath, err := exec.LookPath("archy")
+	if err != nil {
+		return "", fmt.Errorf("%w. Install archy or set %s: %v", errArchyNotFound, archyPathEnvVar, err)
+	}
+	return archyPath, nil
+}
+
+// getArchyLocation returns the Archy location of an API base path, e.g. mypurecloud.com for https://api.mypurecloud.com
+func getArchyLocation(basePath string) string {
+	location := strings.TrimPrefix(basePath, "https://")
+	return strings.TrimPrefix(location, "api.")
+}
+
+// ArchitectFlowResolver exports the published version of a flow as an Archy YAML file in the export sub directory with
+// the Archy CLI. The filepath and file_content_hash attributes are then updated to point at the exported file. Flows that
+// have never been published, or that cannot be exported because Archy is not installed, keep the filepath variable, so
+// the file can be supplied by hand.
+func ArchitectFlowResolver(flowId, exportDirectory, subDirectory string, configMap map[string]interface{}, meta interface{}, resource resourceExporter.ResourceInfo) error {
+	sdkConfig := meta.(*provider.ProviderMeta).ClientConfig
+	proxy := getArchitectFlowProxy(sdkConfig)
+	ctx := context.Background()
+	filepathVariable := fmt.Sprintf("%s_%s_filepath", ResourceType, resource.BlockLabel)
+
+	flow, _, err := proxy.GetFlow(ctx, flowId)
+	if err != nil {
+		return fmt.Errorf("failed to read flow %s: %v", flowId, err)
+	}
+	if flow.PublishedVersion == nil || flow.PublishedVersion.Id == nil {
+		log.Printf("Flow %s has no published version. Its filepath is exported as a variable.", flowId)
+		return resourceExporter.FileContentHashResolver(configMap, filepathVariable)
+	}
+
+	archyDir, err := os.MkdirTemp("", "archy-export-")
+	if err != nil {
+		return err
+	}
+	defer os.RemoveAll(archyDir)
+
+	exportedFile, err := proxy.ExportFlow(ctx, *flow, *flow.PublishedVersion.Id, archyDir)
+	if errors.Is(err, errArchyNotFound) {
+		log.Printf("Flow %s cannot be exported: %v. Its filepath is exported as a variable.", flowId, err)
+		return resourceExporter.FileContentHashResolver(configMap, filepathVariable)
+	}
+	if err != nil {
+		return fmt.Errorf("failed to export version %s of flow %s: %v", *flow.PublishedVersion.Id, flowId, err)
+	}
+	flowYaml, err := os.ReadFile(exportedFile)
+	if err != nil {
+		return fmt.Errorf("failed to read exported file of flow %s: %v", flowId, err)
+	}
+
+	fullPath := filepath.Join(exportDirectory, subDirectory)
+	if err := os.MkdirAll(fullPath, os.ModePerm); err != nil {
+		return err
+	}
+
+	exportFileName := fmt.Sprintf("%s.yaml", resource.BlockLabel)
+	if diagErr := files.WriteToFile(flowYaml, filepath.Join(fullPath, exportFileName)); diagErr != nil {
+		return fmt.Errorf("failed to write configuration file for flow %s: %v", flowId, diagErr)
+	}
+
+	// Update filepath field in configMap to point to exported flow file
+	fileNameVal := filepath.Join(subDirectory, exportFileName)
+	configMap["filepath"] = fileNameVal
+	configMap["file_content_hash"] = fmt.Sprintf(`${filesha256("%s")}`, fileNameVal)
+
+	resource.State.Attributes["filepath"] = fileNameVal
+
+	hash, err := files.HashFileContent(filepath.Join(fullPath, exportFileName))
+	if err != nil {
+		log.Printf("Error Calculating Hash '%s' ", err)
+	} else {
+		resource.State.Attributes["file_content_hash"] = hash
+	}
+	return nil
+}
+
+// publishFlowVersion publishes the configuration of an existing version of a flow again as a new version. The
+// configuration is in Architect's own format rather than Archy YAML, so it is saved as a version of the flow and
+// published directly instead of by a deploy job. The new version is returned.
+func publishFlowVersion(ctx context.Context, p *architectFlowProxy, flowId, versionId string) (string, error) {
+	configuration, _, err := p.GetFlowVersionConfiguration(ctx, flowId, versionId)
+	if err != nil {
+		return "", fmt.Errorf("failed to get configuration for version %s of flow %s: %v", versionId, flowId, err)
+	}
+	if configuration == nil {
+		return "", fmt.Errorf("no configuration returned for version %s of flow %s", versionId, flowId)
+	}
+	return publishFlowConfiguration(ctx, p, flowId, *configuration)
+}
+
+// publishFlowConfiguration saves a configuration in Architect's own format as a new version of a flow, publishes it and
+// waits until it is the published version of the flow. The new version is returned.
+func publishFlowConfiguration(ctx context.Context, p *architectFlowProxy, flowId string, configuration interface{}) (string, error) {
+	if _, _, err := p.CheckoutFlow(ctx, flowId); err != nil {
+		return "", fmt.Errorf("failed to check out flow %s: %v", flowId, err)
+	}
+	version, _, err := p.CreateFlowVersion(ctx, flowId, configuration)
+	if err != nil {
+		return "", fmt.Errorf("failed to save a new version of flow %s: %v", flowId, err)
+	}
+	if version == nil || version.Id == nil {
+		return "", fmt.Errorf("no version returned when saving a new version of flow %s", flowId)
+	}
+	versionId := *version.Id
+	if _, _, err := p.PublishFlow(ctx, flowId, versionId); err != nil {
+		return "", fmt.Errorf("failed to publish version %s of flow %s: %v", versionId, flowId, err)
+	}
+
+	diagErr := util.WithRetries(ctx, 5*time.Minute, func() *retry.RetryError {
+		// The cached flow still has the version that was published before
+		rc.DeleteCacheItem(p.flowCache, flowId)
+		flow, resp, err := p.GetFlow(ctx, flowId)
+		if err != nil {
+			return retry.NonRetryableError(util.BuildWithRetriesApiDiagnosticError(ResourceType, fmt.Sprintf("failed to read flow %s: %v", flowId, err), resp))
+		}
+		if flow.PublishedVersion == nil || flow.PublishedVersion.Id == nil || *flow.PublishedVersion.Id != versionId {
+			time.Sleep(publishPollInterval)
+			return retry.RetryableError(fmt.Errorf("version %s of flow %s is not published yet", versionId, flowId))
+		}
+		return nil
+	})
+	if diagErr != nil {
+		return "", fmt.Errorf("failed to publish version %s of flow %s: %v", versionId, flowId, diagErr)
+	}
+	log.Printf("Published version %s of flow %s", versionId, flowId)
+	return versionId, nil
+}
+
+// Interval between the checks whether a published version is live
+const publishPollInterval = 5 * time.Second
+
+// runPostPublishCheck runs the post publish check command of a flow with the ID and version of the flow in its
+// environment. An error is returned if the command fails.
+func runPostPublishCheck(ctx context.Context, command, flowId, versionId string) error {
+	ctx, cancel := context.WithTimeout(ctx, postPublishCheckTimeout)
+	defer cancel()
+
+	var cmd *exec.Cmd
+	if runtime.GOOS == "windows" {
+		cmd = exec.CommandContext(ctx, "cmd", "/C", command)
+	} else {
+		cmd = exec.CommandContext(ctx, "sh", "-c", command)
+	}
+	cmd.Env = append(os.Environ(), "GENESYSCLOUD_FLOW_ID="+flowId, "GENESYSCLOUD_FLOW_VERSION="+versionId)
+	output, err := cmd.CombinedOutput()
+	if err != nil {
+		return fmt.Errorf("post publish check of version %s of flow %s failed: %v\n%s", versionId, flowId, err, strings.TrimSpace(string(output)))
+	}
+	return nil
+}
+
+// checkPublishedFlow runs the post publish check of a flow when one is configured. If the check fails, the version that
+// was published before is published again and an error is returned either way.
+func checkPublishedFlow(ctx context.Context, p *architectFlowProxy, d *schema.ResourceData, previousVersion string) error {
+	command := d.Get("post_publish_check_command").(string)
+	if command == "" {
+		return nil
+	}
+	rc.DeleteCacheItem(p.flowCache, d.Id())
+	flow, _, err := p.GetFlow(ctx, d.Id())
+	if err != nil {
+		return fmt.Errorf("failed to read flow %s: %v", d.Id(), err)
+	}
+	publishedVersion := ""
+	if flow.PublishedVersion != nil && flow.PublishedVersion.Id != nil {
+		publishedVersion = *flow.PublishedVersion.Id
+	}
+
+	checkErr := runPostPublishCheck(ctx, command, d.Id(), publishedVersion)
+	if checkErr == nil {
+		return nil
+	}
+	if previousVersion == "" || previousVersion == publishedVersion {
+		return fmt.Errorf("%v. Flow %s has no previous version to roll back to", checkErr, d.Id())
+	}
+
+	log.Printf("Rolling back flow %s to version %s: %v", d.Id(), previousVersion, checkErr)
+	rollbackVersion, err := publishFlowVersion(ctx, p, d.Id(), previousVersion)
+	if err != nil {
+		return fmt.Errorf("%v. Failed to roll back to version %s: %v", checkErr, previousVersion, err)
+	}
+	return fmt.Errorf("%v. Rolled back to version %s, which is published as version %s", checkErr, previousVersion, rollbackVersion)
+}
+
+var (
+	unresolvedSubstitutionRegex = regexp.MustCompile(`{{\s*([^{}\s]+)\s*}}`)
+	flowReferenceRegex          = regexp.MustCompile(`\[([^\[\]]+)\]$`)
+)
+
+// customizeFlowDiff marks the published version as changing when the flow is published again, and validates the flow
+// configuration during plan when validate_on_plan is set, so broken flows fail before a deploy job is started
+func customizeFlowDiff(_ context.Context, diff *schema.ResourceDiff, _ interface{}) error {
+	if diff.Id() != "" && diff.HasChanges("filepath", "file_content_hash", "substitutions", "pinned_version") {
+		// Every publish creates a new version
+		if err := diff.SetNewComputed("published_version"); err != nil {
+			return err
+		}
+	}
+
+	if !diff.Get("validate_on_plan").(bool) || diff.Get("pinned_version").(string) != "" {
+		return nil
+	}
+	if diff.Id() != "" && !diff.HasChanges("filepath", "file_content_hash", "substitutions") {
+		return nil
+	}
+	if !diff.NewValueKnown("filepath") || !diff.NewValueKnown("file_content_hash") || !diff.NewValueKnown("substitutions") {
+		// The configuration can only be validated once all values are known. An unknown hash means the file is
+		// generated during apply, so it may not exist yet or still hold its old content.
+		return nil
+	}
+
+	filePath := diff.Get("filepath").(string)
+	substitutions := diff.Get("substitutions").(map[string]interface{})
+
+	reader, file, err := files.DownloadOrOpenFile(filePath)
+	if err != nil {
+		return err
+	}
+	if file != nil {
+		defer file.Close()
+	}
+	content, err := io.ReadAll(reader)
+	if err != nil {
+		return fmt.Errorf("failed to read flow configuration file %s: %v", filePath, err)
+	}
+
+	if err := validateFlowConfiguration(substituteFlowValues(string(content), substitutions)); err != nil {
+		return fmt.Errorf("flow configuration file %s is invalid: %v", filePath, err)
+	}
+	return nil
+}
+
+// substituteFlowValues replaces the substitutions in a flow configuration the same way they are replaced on upload
+func substituteFlowValues(content string, substitutions map[string]interface{}) string {
+	for k, v := range substitutions {
+		content = strings.ReplaceAll(content, fmt.Sprintf("{{%s}}", k), v.(string))
+	}
+	return content
+}
+
+// validateFlowConfiguration performs the structural checks of a flow configuration that Architect would otherwise
+// only report after a deploy job
+func validateFlowConfiguration(content string) error {
+	var errs []string
+
+	unresolved := make(map[string]bool)
+	for _, match := range unresolvedSubstitutionRegex.FindAllStringSubmatch(content, -1) {
+		if !unresolved[match[1]] {
+			unresolved[match[1]] = true
+			errs = append(errs, fmt.Sprintf("no substitution is set for {{%s}}", match[1]))
+		}
+	}
+
+	var configuration map[string]interface{}
+	if err := yaml.Unmarshal([]byte(content), &configuration); err != nil {
+		// Unresolved substitutions usually make the YAML invalid, so they are reported as well
+		errs = append(errs, fmt.Sprintf("failed to parse YAML: %v", err))
+		return fmt.Errorf("%s", strings.Join(errs, "; "))
+	}
+	if len(configuration) != 1 {
+		errs = append(errs, fmt.Sprintf("expected exactly one top-level key with the flow type, got %d", len(configuration)))
+		return fmt.Errorf("%s", strings.Join(errs, "; "))
+	}
+
+	for flowType, flowBody := range configuration {
+		if !lists.ItemInSlice(strings.ToLower(flowType), validFlowTypes) {
+			errs = append(errs, fmt.Sprintf("unknown flow type %s", flowType))
+		}
+
+		flow, ok := flowBody.(map[string]interface{})
+		if !ok {
+			errs = append(errs, fmt.Sprintf("%s must be a map of flow settings", flowType))
+			break
+		}
+		if name, _ := flow["name"].(string); strings.TrimSpace(name) == "" {
+			errs = append(errs, "the flow has no name")
+		}
+
+		refIds := make(map[string]bool)
+		var references []string
+		collectFlowReferences(flow, refIds, &references)
+		for _, reference := range references {
+			match := flowReferenceRegex.FindStringSubmatch(reference)
+			if match != nil && !refIds[match[1]] {
+				errs = append(errs, fmt.Sprintf("%s refers to %s, but no element has refId %s", reference, match[1], match[1]))
+			}
+		}
+	}
+
+	if len(errs) > 0 {
+		sort.Strings(errs)
+		return fmt.Errorf("%s", strings.Join(errs, "; "))
+	}
+	return nil
+}
+
+// collectFlowReferences collects the refIds of a flow and the values of its references, e.g. startUpRef or targetTaskRef
+func collectFlowReferences(value interface{}, refIds map[string]bool, references *[]string) {
+	switch typed := value.(type) {
+	case map[string]interface{}:
+		for key, nested := range typed {
+			if stringValue, ok := nested.(string); ok {
+				if key == "refId" {
+					refIds[stringValue] = true
+				} else if strings.HasSuffix(key, "Ref") {
+					*references = append(*references, stringValue)
+				}
+				continue
+			}
+			collectFlowReferences(nested, refIds, references)
+		}
+	case []interface{}:
+		for _, nested := range typed {
+			collectFlowReferences(nested, refIds, references)
+		}
+	}
+}
@@ -44,6 +44,10 @@ func getAllFlows(ctx context.Context, clientConfig *platformclientv2.Configurati
 
 		//This is our go forward naming standard for flows.
 		resources[*flow.Id] = &resourceExporter.ResourceMeta{BlockLabel: *flow.VarType + "_" + *flow.Name}
+		// Renaming a flow does not publish a new version, so the name is part of the version
+		if flow.PublishedVersion != nil && flow.PublishedVersion.Id != nil {
+			resources[*flow.Id].Version = *flow.PublishedVersion.Id + "/" + *flow.Name
+		}
 	}
 
 	return resources, nil
@@ -65,6 +69,11 @@ func readFlow(ctx context.Context, d *schema.ResourceData, meta interface{}) dia
 
 		resourcedata.SetNillableValue(d, "name", flow.Name)
 		resourcedata.SetNillableValue(d, "type", flow.VarType)
+		if flow.PublishedVersion != nil {
+			resourcedata.SetNillableValue(d, "published_version", flow.PublishedVersion.Id)
+		} else {
+			_ = d.Set("published_version", nil)
+		}
 
 		log.Printf("Read flow %s %s", d.Id(), *flow.Name)
 		return nil
@@ -82,6 +91,11 @@ func updateFlow(ctx context.Context, d *schema.ResourceData, meta interface{}) d
 
 	log.Printf("Updating flow")
 
+	pinnedVersion := d.Get("pinned_version").(string)
+	if pinnedVersion != "" && d.Id() == "" {
+		return util.BuildDiagnosticError(ResourceType, "Failed to create flow", fmt.Errorf("pinned_version %s can only be set on an existing flow", pinnedVersion))
+	}
+
 	//Check to see if we need to force and unlock on an architect flow
 	if isForceUnlockEnabled(d) {
 		resp, err := p.ForceUnlockFlow(ctx, d.Id())
@@ -91,6 +105,46 @@ func updateFlow(ctx context.Context, d *schema.ResourceData, meta interface{}) d
 		}
 	}
 
+	// The version published before is kept, so the flow can be rolled back if the post publish check fails
+	previousVersion := ""
+	if d.Id() != "" {
+		flow, resp, err := p.GetFlow(ctx, d.Id())
+		if err != nil {
+			setFileContentHashToNil(d)
+			return util.BuildAPIDiagnosticError(ResourceType, fmt.Sprintf("Failed to read flow %s: %s", d.Id(), err), resp)
+		}
+		if flow.PublishedVersion != nil && flow.PublishedVersion.Id != nil {
+			previousVersion = *flow.PublishedVersion.Id
+		}
+	}
+
+	var diagErr diag.Diagnostics
+	if pinnedVersion != "" {
+		// The file is not used while the flow is pinned to a version
+		log.Printf("Publishing version %s of flow %s", pinnedVersion, d.Id())
+		if _, err := publishFlowVersion(ctx, p, d.Id(), pinnedVersion); err != nil {
+			diagErr = util.BuildDiagnosticError(ResourceType, fmt.Sprintf("Failed to publish version %s of flow %s", pinnedVersion, d.Id()), err)
+		}
+	} else {
+		diagErr = deployFlowFile(ctx, p, d)
+	}
+	if diagErr != nil {
+		setFileContentHashToNil(d)
+		return diagErr
+	}
+
+	if err := checkPublishedFlow(ctx, p, d, previousVersion); err != nil {
+		// The file is published again on the next apply
+		setFileContentHashToNil(d)
+		return util.BuildDiagnosticError(ResourceType, fmt.Sprintf("Post publish check of flow %s failed", d.Id()), err)
+	}
+
+	log.Printf("Updated flow %s. ", d.Id())
+	return readFlow(ctx, d, meta)
+}
+
+// deployFlowFile publishes the YAML file of a flow with an Archy deploy job and sets the ID of the flow
+func deployFlowFile(ctx context.Context, p *architectFlowProxy, d *schema.ResourceData) diag.Diagnostics {
 	flowJob, response, err := p.CreateFlowsDeployJob(ctx)
 
 	if err != nil || response.Error != nil {
@@ -100,7 +154,6 @@ func updateFlow(ctx context.Context, d *schema.ResourceData, meta interface{}) d
 		} else {
 			errorString = response.ErrorMessage
 		}
-		setFileContentHashToNil(d)
 		return util.BuildAPIDiagnosticError(ResourceType, fmt.Sprintf("Failed to register job %s", errorString), response)
 	}
 
@@ -113,7 +166,6 @@ func updateFlow(ctx context.Context, d *schema.ResourceData, meta interface{}) d
 
 	reader, _, err := files.DownloadOrOpenFile(filePath)
 	if err != nil {
-		setFileContentHashToNil(d)
 		return diag.FromErr(err)
 	}
 
@@ -121,7 +173,6 @@ func updateFlow(ctx context.Context, d *schema.ResourceData, meta interface{}) d
 
 	_, uploadErr := s3Uploader.UploadWithRetries(ctx, filePath, 20*time.Second)
 	if uploadErr != nil {
-		setFileContentHashToNil(d)
 		return diag.FromErr(uploadErr)
 	}
 
@@ -155,19 +206,15 @@ func updateFlow(ctx context.Context, d *schema.ResourceData, meta interface{}) d
 	})
 
 	if retryErr != nil {
-		setFileContentHashToNil(d)
 		return retryErr
 	}
 
 	if flowID == "" {
-		setFileContentHashToNil(d)
 		return util.BuildDiagnosticError(ResourceType, fmt.Sprintf("Failed to get the flowId from Architect Job (%s).", jobId), fmt.Errorf("FlowID is nil"))
 	}
 
 	d.SetId(flowID)
-
-	log.Printf("Updated flow %s. ", d.Id())
-	return readFlow(ctx, d, meta)
+	return nil
 }
 
 func deleteFlow(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
@@ -15,9 +15,11 @@ with the Genesys Cloud SDK. We use composition here for each function on the pro
 out during testing.
 */
 
-// internalProxy holds a proxy instance that can be used throughout the package
+// internalProxy holds a proxy instance that unit tests can set to replace the proxy of the package
 var internalProxy *architectGrammarProxy
 
+var grammarCache = rc.NewResourceCache[platformclientv2.Grammar]()
+
 // Type definitions for each func on our proxy so that we can easily mock them out later
 type createArchitectGrammarFunc func(ctx context.Context, p *architectGrammarProxy, grammar *platformclientv2.Grammar) (*platformclientv2.Grammar, *platformclientv2.APIResponse, error)
 type getAllArchitectGrammarFunc func(ctx context.Context, p *architectGrammarProxy) (*[]platformclientv2.Grammar, *platformclientv2.APIResponse, error)
@@ -42,7 +44,6 @@ type architectGrammarProxy struct {
 // newArchitectGrammarProxy initializes the grammar proxy with all the data needed to communicate with Genesys Cloud
 func newArchitectGrammarProxy(clientConfig *platformclientv2.Configuration) *architectGrammarProxy {
 	api := platformclientv2.NewArchitectApiWithConfig(clientConfig)
-	grammarCache := rc.NewResourceCache[platformclientv2.Grammar]()
 	return &architectGrammarProxy{
 		clientConfig:                    clientConfig,
 		architectApi:                    api,
@@ -56,14 +57,14 @@ func newArchitectGrammarProxy(clientConfig *platformclientv2.Configuration) *arc
 	}
 }
 
-// getArchitectGrammarProxy acts as a singleton for the internalProxy. It also ensures
-// that we can still proxy our tests by directly setting internalProxy package variable
+// getArchitectGrammarProxy returns a new proxy for the client config of every call, so provider configurations for
+// several orgs never share a proxy. It also ensures that we can still proxy our tests by directly setting
+// internalProxy package variable
 func getArchitectGrammarProxy(clientConfig *platformclientv2.Configuration) *architectGrammarProxy {
-	if internalProxy == nil {
-		internalProxy = newArchitectGrammarProxy(clientConfig)
+	if internalProxy != nil {
+		return internalProxy
 	}
-
-	return internalProxy
+	return newArchitectGrammarProxy(clientConfig)
 }
 
 // createArchitectGrammar creates a Genesys Cloud Architect Grammar
@@ -141,8 +142,8 @@ func getAllArchitectGrammarFn(_ context.Context, p *architectGrammarProxy) (*[]p
 }
 
 // getArchitectGrammarByIdFn is an implementation of the function to get a Genesys Cloud Architect Grammar by ID
-func getArchitectGrammarByIdFn(_ context.Context, p *architectGrammarProxy, grammarId string) (*platformclientv2.Grammar, *platformclientv2.APIResponse, error) {
-	grammar := rc.GetCacheItem(p.grammarCache, grammarId)
+func getArchitectGrammarByIdFn(ctx context.Context, p *architectGrammarProxy, grammarId string) (*platformclientv2.Grammar, *platformclientv2.APIResponse, error) {
+	grammar := rc.GetCacheItem(ctx, p.grammarCache, grammarId)
 	if grammar != nil {
 		return grammar, nil, nil
 	}
@@ -24,9 +24,11 @@ with the Genesys Cloud SDK. We use composition here for each function on the pro
 out during testing.
 */
 
-// internalProxy holds a proxy instance that can be used throughout the package
+// internalProxy holds a proxy instance that unit tests can set to replace the proxy of the package
 var internalProxy *architectGrammarLanguageProxy
 
+var grammarLanguageCache = rc.NewResourceCache[platformclientv2.Grammarlanguage]()
+
 // Type definitions for each func on our proxy so that we can easily mock them out later
 type GrammarLanguageEntry struct {
 	Grammar         *platformclientv2.Grammar
@@ -53,7 +55,6 @@ type architectGrammarLanguageProxy struct {
 // newArchitectGrammarLanguageProxy initializes the grammar Language proxy with all the data needed to communicate with Genesys Cloud
 func newArchitectGrammarLanguageProxy(clientConfig *platformclientv2.Configuration) *architectGrammarLanguageProxy {
 	api := platformclientv2.NewArchitectApiWithConfig(clientConfig)
-	grammarLanguageCache := rc.NewResourceCache[platformclientv2.Grammarlanguage]()
 	return &architectGrammarLanguageProxy{
 		clientConfig:                        clientConfig,
 		architectApi:                        api,
@@ -66,14 +67,14 @@ func newArchitectGrammarLanguageProxy(clientConfig *platformclientv2.Configurati
 	}
 }
 
-// getArchitectGrammarLanguageProxy acts as a singleton for the internalProxy. It also ensures
-// that we can still proxy our tests by directly setting internalProxy package variable
+// getArchitectGrammarLanguageProxy returns a new proxy for the client config of every call, so provider configurations for
+// several orgs never share a proxy. It also ensures that we can still proxy our tests by directly setting
+// internalProxy package variable
 func getArchitectGrammarLanguageProxy(clientConfig *platformclientv2.Configuration) *architectGrammarLanguageProxy {
-	if internalProxy == nil {
-		internalProxy = newArchitectGrammarLanguageProxy(clientConfig)
+	if internalProxy != nil {
+		return internalProxy
 	}
-
-	return internalProxy
+	return newArchitectGrammarLanguageProxy(clientConfig)
 }
 
 // createArchitectGrammarLanguage creates a Genesys Cloud Architect Grammar Language
@@ -126,8 +127,8 @@ func createArchitectGrammarLanguageFn(_ context.Context, p *architectGrammarLang
 }
 
 // getArchitectGrammarLanguageByIdFn is an implementation of the function to get a Genesys Cloud Architect Grammar Language by ID
-func getArchitectGrammarLanguageByIdFn(_ context.Context, p *architectGrammarLanguageProxy, grammarId string, languageCode string) (*platformclientv2.Grammarlanguage, *platformclientv2.APIResponse, error) {
-	language := rc.GetCacheItem(p.grammarLanguageCache, buildGrammarLanguageId(grammarId, languageCode))
+func getArchitectGrammarLanguageByIdFn(ctx context.Context, p *architectGrammarLanguageProxy, grammarId string, languageCode string) (*platformclientv2.Grammarlanguage, *platformclientv2.APIResponse, error) {
+	language := rc.GetCacheItem(ctx, p.grammarLanguageCache, buildGrammarLanguageId(grammarId, languageCode))
 	if language != nil {
 		return language, nil, nil
 	}
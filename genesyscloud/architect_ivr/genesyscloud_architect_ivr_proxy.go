@@ -30,7 +30,7 @@ Each proxy implementation:
 7.  Function implementations for each function type definition.
 */
 
-// internalProxy holds a proxy instance that can be used throughout the package
+// internalProxy holds a proxy instance that unit tests can set to replace the proxy of the package
 var internalProxy *architectIvrProxy
 
 // Type definitions for each func on our proxy so we can easily mock them out later
@@ -81,13 +81,14 @@ func newArchitectIvrProxy(clientConfig *platformclientv2.Configuration) *archite
 	}
 }
 
-// getArchitectIvrProxy acts as a singleton to for the internalProxy.  It also ensures
-// that we can still proxy our tests by directly setting internalProxy package variable
+// getArchitectIvrProxy returns a new proxy for the client config of every call, so provider configurations for
+// several orgs never share a proxy. It also ensures that we can still proxy our tests by directly setting
+// internalProxy package variable
 func getArchitectIvrProxy(clientConfig *platformclientv2.Configuration) *architectIvrProxy {
-	if internalProxy == nil {
-		internalProxy = newArchitectIvrProxy(clientConfig)
+	if internalProxy != nil {
+		return internalProxy
 	}
-	return internalProxy
+	return newArchitectIvrProxy(clientConfig)
 }
 
 // getAllArchitectIvrs retrieves all Genesys Cloud Architect IVRs
@@ -14,7 +14,7 @@ with the Genesys Cloud SDK. We use composition here for each function on the pro
 out during testing.
 */
 
-// internalProxy holds a proxy instance that can be used throughout the package
+// internalProxy holds a proxy instance that unit tests can set to replace the proxy of the package
 var internalProxy *architectSchedulegroupsProxy
 
 // Type definitions for each func on our proxy so we can easily mock them out later
@@ -52,13 +52,14 @@ func newArchitectSchedulegroupsProxy(clientConfig *platformclientv2.Configuratio
 	}
 }
 
-// getArchitectSchedulegroupsProxy acts as a singleton to for the internalProxy.  It also ensures
-// that we can still proxy our tests by directly setting internalProxy package variable
+// getArchitectSchedulegroupsProxy returns a new proxy for the client config of every call, so provider configurations for
+// several orgs never share a proxy. It also ensures that we can still proxy our tests by directly setting
+// internalProxy package variable
 func getArchitectSchedulegroupsProxy(clientConfig *platformclientv2.Configuration) *architectSchedulegroupsProxy {
-	if internalProxy == nil {
-		internalProxy = newArchitectSchedulegroupsProxy(clientConfig)
+	if internalProxy != nil {
+		return internalProxy
 	}
-	return internalProxy
+	return newArchitectSchedulegroupsProxy(clientConfig)
 }
 
 // createArchitectSchedulegroups creates a Genesys Cloud architect schedulegroups
@@ -18,9 +18,11 @@ to isolate and test individual functions more easily. For testing purposes, we c
 simulate these smaller parts, known as stubs, to ensure that each function behaves correctly in different scenarios.
 */
 
-// internalProxy holds a proxy instance that can be used throughout the package
+// internalProxy holds a proxy instance that unit tests can set to replace the proxy of the package
 var internalProxy *architectSchedulesProxy
 
+var schedulesCache = rc.NewResourceCache[platformclientv2.Schedule]() // Create Cache for architect schedules resource
+
 // Type definitions for each func on our proxy so we can easily mock them out later
 type createArchitectSchedulesFunc func(ctx context.Context, p *architectSchedulesProxy, schedules *platformclientv2.Schedule) (*platformclientv2.Schedule, *platformclientv2.APIResponse, error)
 type getAllArchitectSchedulesFunc func(ctx context.Context, p *architectSchedulesProxy) (*[]platformclientv2.Schedule, *platformclientv2.APIResponse, error)
@@ -55,8 +57,7 @@ This includes configuring the proxy with the required data and settings so that
 seamlessly with the Genesys Cloud platform.
 */
 func newArchitectSchedulesProxy(clientConfig *platformclientv2.Configuration) *architectSchedulesProxy {
-	api := platformclientv2.NewArchitectApiWithConfig(clientConfig)    // NewArchitectApiWithConfig creates an Genesyc Cloud API instance using the provided configuration
-	schedulesCache := rc.NewResourceCache[platformclientv2.Schedule]() // Create Cache for architect schedules resource
+	api := platformclientv2.NewArchitectApiWithConfig(clientConfig) // NewArchitectApiWithConfig creates an Genesyc Cloud API instance using the provided configuration
 	return &architectSchedulesProxy{
 		clientConfig:                      clientConfig,
 		architectApi:                      api,
@@ -71,17 +72,16 @@ func newArchitectSchedulesProxy(clientConfig *platformclientv2.Configuration) *a
 }
 
 /*
-The function getArchitectSchedulesProxy serves a dual purpose: first, it functions as a singleton for
-the internalProxy, meaning it ensures that only one instance of the internalProxy exists. Second,
-it enables us to proxy our tests by allowing us to directly set the internalProxy package variable.
-This ensures consistency and control in managing the internalProxy across our codebase, while also
-facilitating efficient testing by providing a straightforward way to substitute the proxy for testing purposes.
+The function getArchitectSchedulesProxy serves a dual purpose: first, it returns a new proxy for the client config of every
+call, so provider configurations for several orgs never share a proxy. Second, it enables us to proxy our tests by
+allowing us to directly set the internalProxy package variable, which facilitates efficient testing by providing a
+straightforward way to substitute the proxy for testing purposes.
 */
 func getArchitectSchedulesProxy(clientConfig *platformclientv2.Configuration) *architectSchedulesProxy {
-	if internalProxy == nil {
-		internalProxy = newArchitectSchedulesProxy(clientConfig)
+	if internalProxy != nil {
+		return internalProxy
 	}
-	return internalProxy
+	return newArchitectSchedulesProxy(clientConfig)
 }
 
 // createArchitectSchedules creates a Genesys Cloud architect schedules
@@ -101,7 +101,7 @@ func (p *architectSchedulesProxy) getArchitectSchedulesIdByName(ctx context.Cont
 
 // getArchitectSchedulesById returns a single Genesys Cloud architect schedules by Id
 func (p *architectSchedulesProxy) getArchitectSchedulesById(ctx context.Context, id string) (architectSchedules *platformclientv2.Schedule, response *platformclientv2.APIResponse, err error) {
-	if schedule := rc.GetCacheItem(p.schedulesCache, id); schedule != nil { // Get the schedule from the cache, if not there in the cache then call p.getArchitectSchedulesByIdAttr()
+	if schedule := rc.GetCacheItem(ctx, p.schedulesCache, id); schedule != nil { // Get the schedule from the cache, if not there in the cache then call p.getArchitectSchedulesByIdAttr()
 		return schedule, nil, nil
 	}
 	return p.getArchitectSchedulesByIdAttr(ctx, p, id)
@@ -18,9 +18,11 @@ import (
 	"github.com/mypurecloud/platform-client-sdk-go/v152/platformclientv2"
 )
 
-// internalProxy holds a proxy instance that can be used throughout the package
+// internalProxy holds a proxy instance that unit tests can set to replace the proxy of the package
 var internalProxy *architectUserPromptProxy
 
+var promptCache = rc.NewResourceCache[platformclientv2.Prompt]()
+
 type createArchitectUserPromptFunc func(ctx context.Context, p *architectUserPromptProxy, body platformclientv2.Prompt) (*platformclientv2.Prompt, *platformclientv2.APIResponse, error)
 type getArchitectUserPromptFunc func(ctx context.Context, p *architectUserPromptProxy, id string, includeMediaUris bool, includeResources bool, language []string, checkCache bool) (*platformclientv2.Prompt, *platformclientv2.APIResponse, error)
 type getAllArchitectUserPromptsFilterByNameFunc func(ctx context.Context, p *architectUserPromptProxy, includeMediaUris bool, includeResources bool, name string) (*[]platformclientv2.Prompt, *platformclientv2.APIResponse, error)
@@ -59,7 +61,6 @@ type architectUserPromptProxy struct {
 
 func newArchitectUserPromptProxy(clientConfig *platformclientv2.Configuration) *architectUserPromptProxy {
 	api := platformclientv2.NewArchitectApiWithConfig(clientConfig)
-	promptCache := rc.NewResourceCache[platformclientv2.Prompt]()
 	return &architectUserPromptProxy{
 		clientConfig:                                   clientConfig,
 		architectApi:                                   api,
@@ -82,11 +83,10 @@ func newArchitectUserPromptProxy(clientConfig *platformclientv2.Configuration) *
 }
 
 func getArchitectUserPromptProxy(clientConfig *platformclientv2.Configuration) *architectUserPromptProxy {
-	if internalProxy == nil {
-		internalProxy = newArchitectUserPromptProxy(clientConfig)
+	if internalProxy != nil {
+		return internalProxy
 	}
-
-	return internalProxy
+	return newArchitectUserPromptProxy(clientConfig)
 }
 
 // createArchitectUserPrompt creates a new user prompt
@@ -158,8 +158,8 @@ func createArchitectUserPromptFn(_ context.Context, p *architectUserPromptProxy,
 	return p.architectApi.PostArchitectPrompts(body)
 }
 
-func getArchitectUserPromptFn(_ context.Context, p *architectUserPromptProxy, id string, includeMediaUris, includeResources bool, languages []string, checkCache bool) (*platformclientv2.Prompt, *platformclientv2.APIResponse, error) {
-	if prompt := rc.GetCacheItem(p.promptCache, id); prompt != nil && checkCache {
+func getArchitectUserPromptFn(ctx context.Context, p *architectUserPromptProxy, id string, includeMediaUris, includeResources bool, languages []string, checkCache bool) (*platformclientv2.Prompt, *platformclientv2.APIResponse, error) {
+	if prompt := rc.GetCacheItem(ctx, p.promptCache, id); prompt != nil && checkCache {
 		return prompt, nil, nil
 	}
 	return p.architectApi.GetArchitectPrompt(id, includeMediaUris, includeResources, languages)
@@ -4,6 +4,7 @@ import (
 	"context"
 	"fmt"
 	"log"
+	dependentConsumers "terraform-provider-genesyscloud/genesyscloud/dependent_consumers"
 	"terraform-provider-genesyscloud/genesyscloud/provider"
 	"terraform-provider-genesyscloud/genesyscloud/util"
 	"terraform-provider-genesyscloud/genesyscloud/util/constants"
@@ -148,6 +149,10 @@ func deleteUserPrompt(ctx context.Context, d *schema.ResourceData, meta interfac
 	sdkConfig := meta.(*provider.ProviderMeta).ClientConfig
 	proxy := getArchitectUserPromptProxy(sdkConfig)
 
+	if diagErr := dependentConsumers.CheckDeleteProtection(ctx, meta, ResourceType, d.Id()); diagErr != nil {
+		return diagErr
+	}
+
 	log.Printf("Deleting user prompt %s", name)
 	if resp, err := proxy.deleteArchitectUserPrompt(ctx, d.Id(), true); err != nil {
 		return util.BuildAPIDiagnosticError(ResourceType, fmt.Sprintf("Failed to delete user prompt %s: %s", name, err), resp)
@@ -11,6 +11,8 @@ import (
 
 var internalProxy *authDivisionProxy
 
+var authDivisionCache = rc.NewResourceCache[platformclientv2.Authzdivision]()
+
 type getAllAuthDivisionFunc func(ctx context.Context, p *authDivisionProxy, name string) (*[]platformclientv2.Authzdivision, *platformclientv2.APIResponse, error)
 type createAuthDivisionFunc func(ctx context.Context, p *authDivisionProxy, authzDivision *platformclientv2.Authzdivision) (*platformclientv2.Authzdivision, *platformclientv2.APIResponse, error)
 type getAuthDivisionIdByNameFunc func(ctx context.Context, p *authDivisionProxy, name string) (string, *platformclientv2.APIResponse, bool, error)
@@ -33,7 +35,6 @@ type authDivisionProxy struct {
 // newAuthDivisionProxy initializes the auth division proxy with all of the data needed to communicate with Genesys Cloud
 func newAuthDivisionProxy(clientConfig *platformclientv2.Configuration) *authDivisionProxy {
 	api := platformclientv2.NewAuthorizationApiWithConfig(clientConfig)
-	authDivisionCache := rc.NewResourceCache[platformclientv2.Authzdivision]()
 	return &authDivisionProxy{
 		clientConfig:                clientConfig,
 		authorizationApi:            api,
@@ -47,14 +48,14 @@ func newAuthDivisionProxy(clientConfig *platformclientv2.Configuration) *authDiv
 	}
 }
 
-// getAuthDivisionProxy acts as a singleton to for the internalProxy.  It also ensures
-// that we can still proxy our tests by directly setting internalProxy package variable
+// getAuthDivisionProxy returns a new proxy for the client config of every call, so provider configurations for
+// several orgs never share a proxy. It also ensures that we can still proxy our tests by directly setting
+// internalProxy package variable
 func getAuthDivisionProxy(clientConfig *platformclientv2.Configuration) *authDivisionProxy {
-	if internalProxy == nil {
-		internalProxy = newAuthDivisionProxy(clientConfig)
+	if internalProxy != nil {
+		return internalProxy
 	}
-
-	return internalProxy
+	return newAuthDivisionProxy(clientConfig)
 }
 
 func (p *authDivisionProxy) getAllAuthDivision(ctx context.Context, name string) (*[]platformclientv2.Authzdivision, *platformclientv2.APIResponse, error) {
@@ -121,7 +122,7 @@ func createAuthDivisionFn(ctx context.Context, p *authDivisionProxy, authDivisio
 
 func getAuthDivisionByIdFn(ctx context.Context, p *authDivisionProxy, id string, objectCount, checkCache bool) (*platformclientv2.Authzdivision, *platformclientv2.APIResponse, error) {
 	if checkCache {
-		div := rc.GetCacheItem(p.authDivisionCache, id)
+		div := rc.GetCacheItem(ctx, p.authDivisionCache, id)
 		if div != nil {
 			return div, nil, nil
 		}
@@ -14,9 +14,11 @@ with the Genesys Cloud SDK. We use composition here for each function on the pro
 out during testing.
 */
 
-// internalProxy holds a proxy instance that can be used throughout the package
+// internalProxy holds a proxy instance that unit tests can set to replace the proxy of the package
 var internalProxy *authRoleProxy
 
+var authRoleCache = rc.NewResourceCache[platformclientv2.Domainorganizationrole]() // Create Cache for authRole resource
+
 // Type definitions for each func on our proxy so we can easily mock them out later
 type createAuthRoleFunc func(ctx context.Context, p *authRoleProxy, domainOrganizationRole *platformclientv2.Domainorganizationrolecreate) (*platformclientv2.Domainorganizationrole, *platformclientv2.APIResponse, error)
 type getAllAuthRoleFunc func(ctx context.Context, p *authRoleProxy) (*[]platformclientv2.Domainorganizationrole, *platformclientv2.APIResponse, error)
@@ -47,7 +49,6 @@ type authRoleProxy struct {
 // newAuthRoleProxy initializes the auth role proxy with all of the data needed to communicate with Genesys Cloud
 func newAuthRoleProxy(clientConfig *platformclientv2.Configuration) *authRoleProxy {
 	api := platformclientv2.NewAuthorizationApiWithConfig(clientConfig)
-	authRoleCache := rc.NewResourceCache[platformclientv2.Domainorganizationrole]() // Create Cache for authRole resource
 	return &authRoleProxy{
 		clientConfig:              clientConfig,
 		authorizationApi:          api,
@@ -64,13 +65,14 @@ func newAuthRoleProxy(clientConfig *platformclientv2.Configuration) *authRolePro
 	}
 }
 
-// getAuthRoleProxy acts as a singleton to for the internalProxy.  It also ensures
-// that we can still proxy our tests by directly setting internalProxy package variable
+// getAuthRoleProxy returns a new proxy for the client config of every call, so provider configurations for
+// several orgs never share a proxy. It also ensures that we can still proxy our tests by directly setting
+// internalProxy package variable
 func getAuthRoleProxy(clientConfig *platformclientv2.Configuration) *authRoleProxy {
-	if internalProxy == nil {
-		internalProxy = newAuthRoleProxy(clientConfig)
+	if internalProxy != nil {
+		return internalProxy
 	}
-	return internalProxy
+	return newAuthRoleProxy(clientConfig)
 }
 
 // createAuthRole creates a Genesys Cloud auth role
@@ -90,7 +92,7 @@ func (p *authRoleProxy) getAuthRoleIdByName(ctx context.Context, name string) (i
 
 // getAuthRoleById returns a single Genesys Cloud auth role by Id
 func (p *authRoleProxy) getAuthRoleById(ctx context.Context, id string) (authRole *platformclientv2.Domainorganizationrole, response *platformclientv2.APIResponse, err error) {
-	if authRole := rc.GetCacheItem(p.authRoleCache, id); authRole != nil {
+	if authRole := rc.GetCacheItem(ctx, p.authRoleCache, id); authRole != nil {
 		return authRole, nil, nil
 	}
 	return p.getAuthRoleByIdAttr(ctx, p, id)
@@ -98,7 +100,7 @@ func (p *authRoleProxy) getAuthRoleById(ctx context.Context, id string) (authRol
 
 // getAuthRoleById returns a single Genesys Cloud auth role by Id
 func (p *authRoleProxy) getDefaultRoleById(ctx context.Context, defaultRoleId string) (roleId string, response *platformclientv2.APIResponse, err error) {
-	if authRole := rc.GetCacheItem(p.authRoleCache, defaultRoleId); authRole != nil {
+	if authRole := rc.GetCacheItem(ctx, p.authRoleCache, defaultRoleId); authRole != nil {
 		return *authRole.Id, nil, nil
 	}
 	return p.getDefaultRoleIdAttr(ctx, p, defaultRoleId)
@@ -2,16 +2,13 @@ package consistency_checker
 
 import (
 	"context"
-	"encoding/json"
 	"fmt"
 	"log"
-	"os"
 	"reflect"
+	"sort"
 	"strconv"
 	"strings"
 	"sync"
-	"terraform-provider-genesyscloud/genesyscloud/util/constants"
-	featureToggles "terraform-provider-genesyscloud/genesyscloud/util/feature_toggles"
 	"unsafe"
 
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/retry"
@@ -40,6 +37,9 @@ type ConsistencyCheck struct {
 	checks         int
 	maxStateChecks int
 	resourceType   string
+	config         ResourceTypeConfig
+	lastMismatches consistencyErrors
+	lastName       string
 }
 
 type consistencyError struct {
@@ -48,12 +48,8 @@ type consistencyError struct {
 	newValue interface{}
 }
 
-type consistencyErrorJson struct {
-	ResourceType     string `json:"resourceType"`
-	ResourceId       string `json:"resourceId"`
-	GCloudObjectName string `json:"GCloudObjectName"`
-	ErrorMessage     string `json:"errorMessage"`
-}
+// consistencyErrors are all attributes that mismatched in a single check
+type consistencyErrors []*consistencyError
 
 func (e *consistencyError) Error() string {
 	return fmt.Sprintf(`mismatch on attribute %s:
@@ -61,6 +57,14 @@ expected value: %v
 actual value: %v`, e.key, e.oldValue, e.newValue)
 }
 
+func (e consistencyErrors) Error() string {
+	messages := make([]string, 0, len(e))
+	for _, err := range e {
+		messages = append(messages, err.Error())
+	}
+	return strings.Join(messages, "\n")
+}
+
 func NewConsistencyCheck(ctx context.Context, d *schema.ResourceData, meta interface{}, r *schema.Resource, maxStateChecks int, resourceType string) *ConsistencyCheck {
 	emptyState := isEmptyState(d)
 	if *emptyState {
@@ -84,14 +88,16 @@ func NewConsistencyCheck(ctx context.Context, d *schema.ResourceData, meta inter
 		originalState[k] = d.Get(k)
 	}
 
+	config := GetResourceTypeConfig(getOrgKey(meta), resourceType)
 	cc = &ConsistencyCheck{
 		ctx:            ctx,
 		r:              r,
 		originalState:  originalState,
 		meta:           meta,
 		isEmptyState:   emptyState,
-		maxStateChecks: maxStateChecks,
+		maxStateChecks: config.maxChecks(maxStateChecks),
 		resourceType:   resourceType,
+		config:         config,
 	}
 	mccMutex.Lock()
 	mcc[d.Id()] = cc
@@ -210,8 +216,9 @@ func (c *ConsistencyCheck) CheckState(currentState *schema.ResourceData) *retry.
 		return nil
 	}
 
-	// If the user has set BYPASS_CONSISTENCY_CHECKER and CONSISTENCY_CHECKS=0 then the consistency checker will not run
-	if featureToggles.CCToggleExists() && constants.ConsistencyChecks() == 0 {
+	// If the user has set BYPASS_CONSISTENCY_CHECKER and CONSISTENCY_CHECKS=0, globally or for the resource type,
+	// then the consistency checker will not run
+	if c.config.isDisabled() {
 		log.Print("Consistency checker disabled")
 		return nil
 	}
@@ -224,15 +231,20 @@ func (c *ConsistencyCheck) CheckState(currentState *schema.ResourceData) *retry.
 		Raw:          originalState,
 	}
 
+	// Collect every mismatched attribute, so all of them are reported together
+	var mismatches consistencyErrors
 	diff, _ := c.r.SimpleDiff(c.ctx, currentState.State(), resourceConfig, c.meta)
 	if diff != nil && len(diff.Attributes) > 0 {
 		for k, v := range diff.Attributes {
-			if strings.HasSuffix(k, "#") {
+			if strings.HasSuffix(k, "#") || c.config.isIgnored(k) {
 				continue
 			}
 			vTemp := v.Old
 			v.Old = v.New
 			v.New = vTemp
+			if !currentState.HasChange(k) {
+				continue
+			}
 			parts := strings.Split(k, ".")
 			if strings.Contains(k, ".") {
 				slice1Index, _ := strconv.Atoi(parts[1])
@@ -245,68 +257,57 @@ func (c *ConsistencyCheck) CheckState(currentState *schema.ResourceData) *retry.
 					}
 				}
 
-				vv := v.New
-				if currentState.HasChange(k) {
-					if !compareValues(c.originalState[parts[0]], vv, slice1Index, slice2Index, key) {
-						err := retry.RetryableError(&consistencyError{
-							key:      k,
-							oldValue: c.originalState[k],
-							newValue: currentState.Get(k),
-						})
-
-						if exists := featureToggles.CCToggleExists(); c.checks >= c.maxStateChecks && exists {
-							c.writeConsistencyErrorToFile(currentState, err)
-							return nil
-						}
-
-						c.checks++
-						return err
-					}
+				if compareValues(c.originalState[parts[0]], v.New, slice1Index, slice2Index, key) {
+					continue
 				}
-			} else {
-				if currentState.HasChange(k) {
-					err := retry.RetryableError(&consistencyError{
-						key:      k,
-						oldValue: c.originalState[k],
-						newValue: currentState.Get(k),
-					})
-
-					if exists := featureToggles.CCToggleExists(); c.checks >= c.maxStateChecks && exists {
-						c.writeConsistencyErrorToFile(currentState, err)
-						return nil
-					}
+			}
 
-					c.checks++
-					return err
-				}
+			// Nested attributes are not in the original state, so the value from the diff is used instead
+			oldValue, ok := c.originalState[k]
+			if !ok {
+				oldValue = v.Old
 			}
+			mismatches = append(mismatches, &consistencyError{
+				key:      k,
+				oldValue: c.maskSensitiveValue(k, oldValue),
+				newValue: c.maskSensitiveValue(k, currentState.Get(k)),
+			})
 		}
 	}
 
-	DeleteConsistencyCheck(currentState.Id())
-	return nil
-}
+	if len(mismatches) > 0 {
+		sort.Slice(mismatches, func(i, j int) bool {
+			return mismatches[i].key < mismatches[j].key
+		})
+		err := retry.RetryableError(mismatches)
 
-func (c *ConsistencyCheck) writeConsistencyErrorToFile(d *schema.ResourceData, consistencyError *retry.RetryError) {
-	const filePath = "consistency-errors.log.json"
-	errorJson := consistencyErrorJson{
-		ResourceType: c.resourceType,
-		ResourceId:   d.Id(),
-		ErrorMessage: consistencyError.Err.Error(),
-	}
+		name, _ := currentState.Get("name").(string)
+		if c.checks >= c.maxStateChecks && c.config.isBypassed() {
+			c.writeConsistencyReport(currentState.Id(), name, mismatches, consistencyStatusUnresolved)
+			return nil
+		}
 
-	if name, _ := d.Get("name").(string); name != "" {
-		errorJson.GCloudObjectName = name
-	}
+		// A resource type with max_checks that is not bypassed fails once all of its checks are done rather than
+		// retrying until the read times out
+		if c.checks >= c.maxStateChecks && c.config.failsAfterMaxChecks() {
+			c.writeConsistencyReport(currentState.Id(), name, mismatches, consistencyStatusUnresolved)
+			DeleteConsistencyCheck(currentState.Id())
+			return retry.NonRetryableError(mismatches)
+		}
 
-	jsonData, err := json.Marshal(errorJson)
-	if err != nil {
-		fmt.Println("Error marshaling JSON:", err)
-		return
+		c.lastMismatches = mismatches
+		c.lastName = name
+		c.checks++
+		c.config.waitBackoff(c.ctx)
+		return err
 	}
 
-	err = os.WriteFile(filePath, jsonData, os.ModePerm)
-	if err != nil {
-		log.Printf("Error writing file %s: %v", filePath, err)
+	// Mismatches that only resolved after retrying are reported as well, as they are caused by eventual consistency
+	if len(c.lastMismatches) > 0 {
+		name, _ := currentState.Get("name").(string)
+		c.writeConsistencyReport(currentState.Id(), name, c.lastMismatches, consistencyStatusResolved)
 	}
+
+	DeleteConsistencyCheck(currentState.Id())
+	return nil
 }
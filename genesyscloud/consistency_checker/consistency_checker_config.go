@@ -0,0 +1,108 @@
+package consistency_checker
+
+import (
+	"context"
+	"strings"
+	"sync"
+	"terraform-provider-genesyscloud/genesyscloud/util/constants"
+	featureToggles "terraform-provider-genesyscloud/genesyscloud/util/feature_toggles"
+	"time"
+)
+
+/*
+The consistency checker is configured globally with the BYPASS_CONSISTENCY_CHECKER and CONSISTENCY_CHECKS environment
+variables. ResourceTypeConfig overrides these settings for a single resource type, e.g. to give resource types that are
+slow to converge more time without relaxing the checks of every other resource type. The overrides of each provider
+configuration only apply to its own org.
+*/
+
+// ResourceTypeConfig is the consistency checker configuration of a resource type. Nil values fall back to the global settings.
+type ResourceTypeConfig struct {
+	MaxChecks        *int
+	Backoff          time.Duration
+	Bypass           *bool
+	IgnoreAttributes []string
+}
+
+// Configurations by org key and resource type, so provider configurations for several orgs each use their own settings
+var (
+	resourceTypeConfigs      = make(map[string]map[string]ResourceTypeConfig)
+	resourceTypeConfigsMutex sync.RWMutex
+)
+
+// orgKeyProvider is implemented by the provider meta, which tells the org of a consistency check
+type orgKeyProvider interface {
+	GetOrgKey() string
+}
+
+// SetResourceTypeConfigs replaces the consistency checker configurations of all resource types of an org
+func SetResourceTypeConfigs(orgKey string, configs map[string]ResourceTypeConfig) {
+	resourceTypeConfigsMutex.Lock()
+	defer resourceTypeConfigsMutex.Unlock()
+	resourceTypeConfigs[orgKey] = configs
+}
+
+func GetResourceTypeConfig(orgKey string, resourceType string) ResourceTypeConfig {
+	resourceTypeConfigsMutex.RLock()
+	defer resourceTypeConfigsMutex.RUnlock()
+	return resourceTypeConfigs[orgKey][resourceType]
+}
+
+// getOrgKey returns the org key of the provider meta of a consistency check, or an empty key if it has none
+func getOrgKey(meta interface{}) string {
+	if provider, ok := meta.(orgKeyProvider); ok {
+		return provider.GetOrgKey()
+	}
+	return ""
+}
+
+// isBypassed returns true if mismatches are written to the consistency report instead of failing once all checks are done
+func (c ResourceTypeConfig) isBypassed() bool {
+	if c.Bypass != nil {
+		return *c.Bypass
+	}
+	return featureToggles.CCToggleExists()
+}
+
+// failsAfterMaxChecks returns true if mismatches fail once all checks are done. Without bypass, the checker only stops
+// retrying after max_checks when it is set for the resource type, otherwise it retries until the read times out.
+func (c ResourceTypeConfig) failsAfterMaxChecks() bool {
+	return c.MaxChecks != nil && !c.isBypassed()
+}
+
+// maxChecks returns the number of checks of a resource type, or the given default if it is not configured
+func (c ResourceTypeConfig) maxChecks(defaultChecks int) int {
+	if c.MaxChecks != nil {
+		return *c.MaxChecks
+	}
+	return defaultChecks
+}
+
+// isDisabled returns true if the consistency checker does not run for the resource type at all
+func (c ResourceTypeConfig) isDisabled() bool {
+	return c.isBypassed() && c.maxChecks(constants.ConsistencyChecks()) == 0
+}
+
+// isIgnored returns true if the attribute or one of its parents is in the list of attributes to ignore
+func (c ResourceTypeConfig) isIgnored(key string) bool {
+	for _, ignored := range c.IgnoreAttributes {
+		if key == ignored || strings.HasPrefix(key, ignored+".") {
+			return true
+		}
+	}
+	return false
+}
+
+// waitBackoff waits for the backoff of the resource type before the next check
+func (c ResourceTypeConfig) waitBackoff(ctx context.Context) {
+	if c.Backoff <= 0 {
+		return
+	}
+	if ctx == nil {
+		ctx = context.Background()
+	}
+	select {
+	case <-ctx.Done():
+	case <-time.After(c.Backoff):
+	}
+}
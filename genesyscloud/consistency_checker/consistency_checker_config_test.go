@@ -0,0 +1,67 @@
+package consistency_checker
+
+import (
+	"context"
+	"path/filepath"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+func TestUnitResourceTypeConfig(t *testing.T) {
+	consistencyReportFilePath = filepath.Join(t.TempDir(), "consistency-errors.jsonl")
+	t.Setenv("BYPASS_CONSISTENCY_CHECKER", "true")
+
+	strict := false
+	maxChecks := 2
+	SetResourceTypeConfigs("", map[string]ResourceTypeConfig{
+		"genesyscloud_ignored": {IgnoreAttributes: []string{"description"}},
+		"genesyscloud_strict":  {Bypass: &strict},
+		"genesyscloud_limited": {Bypass: &strict, MaxChecks: &maxChecks},
+	})
+	defer SetResourceTypeConfigs("", make(map[string]ResourceTypeConfig))
+
+	resource := &schema.Resource{
+		Schema: map[string]*schema.Schema{
+			"name":        {Type: schema.TypeString, Required: true},
+			"description": {Type: schema.TypeString, Optional: true},
+		},
+	}
+	newResourceData := func(id, description string) *schema.ResourceData {
+		d := schema.TestResourceDataRaw(t, resource.Schema, map[string]interface{}{
+			"name":        "name",
+			"description": description,
+		})
+		d.SetId(id)
+		return d
+	}
+
+	// Ignored attributes never mismatch
+	cc := NewConsistencyCheck(context.Background(), newResourceData("ignored-id", "expected"), nil, resource, 0, "genesyscloud_ignored")
+	if retryErr := cc.CheckState(newResourceData("ignored-id", "actual")); retryErr != nil {
+		t.Errorf("Expected the ignored attribute not to be checked, got %v", retryErr)
+	}
+
+	// A resource type that is not bypassed keeps failing after all checks, even with BYPASS_CONSISTENCY_CHECKER set
+	cc = NewConsistencyCheck(context.Background(), newResourceData("strict-id", "expected"), nil, resource, 0, "genesyscloud_strict")
+	defer DeleteConsistencyCheck("strict-id")
+	if retryErr := cc.CheckState(newResourceData("strict-id", "actual")); retryErr == nil {
+		t.Errorf("Expected a mismatch for a strict resource type")
+	}
+
+	if retryErr := cc.CheckState(newResourceData("strict-id", "actual")); retryErr == nil || !retryErr.Retryable {
+		t.Errorf("Expected a strict resource type without max_checks to keep retrying, got %v", retryErr)
+	}
+
+	// A resource type with max_checks that is not bypassed fails for good once all checks are done
+	cc = NewConsistencyCheck(context.Background(), newResourceData("limited-id", "expected"), nil, resource, 0, "genesyscloud_limited")
+	defer DeleteConsistencyCheck("limited-id")
+	for i := 0; i < maxChecks; i++ {
+		if retryErr := cc.CheckState(newResourceData("limited-id", "actual")); retryErr == nil || !retryErr.Retryable {
+			t.Fatalf("Expected check %d to be retried, got %v", i+1, retryErr)
+		}
+	}
+	if retryErr := cc.CheckState(newResourceData("limited-id", "actual")); retryErr == nil || retryErr.Retryable {
+		t.Errorf("Expected a non-retryable error after %d checks, got %v", maxChecks, retryErr)
+	}
+}
@@ -0,0 +1,174 @@
+package consistency_checker
+
+import (
+	"encoding/json"
+	"log"
+	"os"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+/*
+The consistency report lists every attribute that the consistency checker found mismatched in a run. Each mismatch is
+appended as a single JSON line, so the report of a run holds the mismatches of all resources. Mismatches are reported when
+the checker gives up on a resource with BYPASS_CONSISTENCY_CHECKER set, when the read retrying them times out, and when
+they resolved after retrying. The report grows across runs, so every entry has the ID of the run that wrote it.
+*/
+
+const (
+	consistencyStatusUnresolved = "unresolved"
+	consistencyStatusResolved   = "resolved"
+
+	sensitiveValueMask = "(sensitive value)"
+)
+
+var (
+	consistencyReportFilePath = "consistency-errors.jsonl"
+	consistencyReportMutex    sync.Mutex
+	consistencyReportRunId    = uuid.NewString()
+)
+
+type consistencyReportEntry struct {
+	RunId            string      `json:"runId"`
+	Timestamp        string      `json:"timestamp"`
+	ResourceType     string      `json:"resourceType"`
+	ResourceId       string      `json:"resourceId"`
+	GCloudObjectName string      `json:"GCloudObjectName,omitempty"`
+	Attribute        string      `json:"attribute"`
+	Expected         interface{} `json:"expected"`
+	Actual           interface{} `json:"actual"`
+	Retries          int         `json:"retries"`
+	Status           string      `json:"status"`
+}
+
+// ReportUnresolvedConsistencyCheck appends the mismatches of the last check of a resource to the consistency report as
+// unresolved. It is called when the read retrying the check times out, so failures that never resolve are reported too.
+func ReportUnresolvedConsistencyCheck(id string) {
+	mccMutex.RLock()
+	cc := mcc[id]
+	mccMutex.RUnlock()
+
+	if cc == nil || len(cc.lastMismatches) == 0 {
+		return
+	}
+	cc.writeConsistencyReport(id, cc.lastName, cc.lastMismatches, consistencyStatusUnresolved)
+}
+
+// writeConsistencyReport appends a line for every mismatched attribute to the consistency report
+func (c *ConsistencyCheck) writeConsistencyReport(id string, name string, mismatches consistencyErrors, status string) {
+	timestamp := time.Now().UTC().Format(time.RFC3339)
+
+	var data []byte
+	for _, mismatch := range mismatches {
+		entry := consistencyReportEntry{
+			RunId:            consistencyReportRunId,
+			Timestamp:        timestamp,
+			ResourceType:     c.resourceType,
+			ResourceId:       id,
+			GCloudObjectName: name,
+			Attribute:        mismatch.key,
+			Expected:         toReportValue(mismatch.oldValue),
+			Actual:           toReportValue(mismatch.newValue),
+			Retries:          c.checks,
+			Status:           status,
+		}
+		jsonData, err := json.Marshal(entry)
+		if err != nil {
+			log.Printf("Error marshaling consistency report entry of %s: %v", mismatch.key, err)
+			continue
+		}
+		data = append(append(data, jsonData...), '\n')
+	}
+
+	consistencyReportMutex.Lock()
+	defer consistencyReportMutex.Unlock()
+
+	file, err := os.OpenFile(consistencyReportFilePath, os.O_APPEND|os.O_CREATE|os.O_WRONLY, 0644)
+	if err != nil {
+		log.Printf("Error opening file %s: %v", consistencyReportFilePath, err)
+		return
+	}
+	defer file.Close()
+
+	if _, err := file.Write(data); err != nil {
+		log.Printf("Error writing file %s: %v", consistencyReportFilePath, err)
+	}
+}
+
+// toReportValue converts the values of sets to lists, because sets cannot be marshalled to JSON
+func toReportValue(value interface{}) interface{} {
+	switch v := value.(type) {
+	case *schema.Set:
+		return toReportValue(v.List())
+	case []interface{}:
+		values := make([]interface{}, 0, len(v))
+		for _, item := range v {
+			values = append(values, toReportValue(item))
+		}
+		return values
+	case map[string]interface{}:
+		values := make(map[string]interface{}, len(v))
+		for key, item := range v {
+			values[key] = toReportValue(item)
+		}
+		return values
+	default:
+		return value
+	}
+}
+
+// maskSensitiveValue replaces the value of a mismatched attribute if it is sensitive in the schema of the resource, so
+// secrets such as passwords never reach the report or the error. The sensitive attributes of a mismatched block are
+// replaced as well.
+func (c *ConsistencyCheck) maskSensitiveValue(key string, value interface{}) interface{} {
+	if c.r == nil {
+		return value
+	}
+	return maskSensitiveValue(c.r.SchemaMap(), strings.Split(key, "."), value)
+}
+
+// maskSensitiveValue masks the value at the flatmap path of an attribute, e.g. "credentials.0.secret". The second segment
+// of the path of a block is its list or set index.
+func maskSensitiveValue(schemaMap map[string]*schema.Schema, path []string, value interface{}) interface{} {
+	attrSchema, ok := schemaMap[path[0]]
+	if !ok {
+		return value
+	}
+	if attrSchema.Sensitive {
+		return sensitiveValueMask
+	}
+	elem, ok := attrSchema.Elem.(*schema.Resource)
+	if !ok {
+		return value
+	}
+	if len(path) >= 3 {
+		return maskSensitiveValue(elem.SchemaMap(), path[2:], value)
+	}
+	return maskSensitiveBlockValue(elem.SchemaMap(), value)
+}
+
+// maskSensitiveBlockValue masks the sensitive attributes of every element of a block
+func maskSensitiveBlockValue(schemaMap map[string]*schema.Schema, value interface{}) interface{} {
+	switch v := value.(type) {
+	case *schema.Set:
+		return maskSensitiveBlockValue(schemaMap, v.List())
+	case []interface{}:
+		values := make([]interface{}, 0, len(v))
+		for _, item := range v {
+			values = append(values, maskSensitiveBlockValue(schemaMap, item))
+		}
+		return values
+	case map[string]interface{}:
+		values := make(map[string]interface{}, len(v))
+		for key, item := range v {
+			values[key] = maskSensitiveValue(schemaMap, []string{key}, item)
+		}
+		return values
+	default:
+		return value
+	}
+}
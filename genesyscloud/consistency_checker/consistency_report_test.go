@@ -0,0 +1,244 @@
+package consistency_checker
+
+import (
+	"bufio"
+	"context"
+	"encoding/json"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+func TestUnitConsistencyReport(t *testing.T) {
+	consistencyReportFilePath = filepath.Join(t.TempDir(), "consistency-errors.jsonl")
+	t.Setenv("BYPASS_CONSISTENCY_CHECKER", "true")
+
+	resource := &schema.Resource{
+		Schema: map[string]*schema.Schema{
+			"name":        {Type: schema.TypeString, Required: true},
+			"description": {Type: schema.TypeString, Optional: true},
+			"enabled":     {Type: schema.TypeBool, Optional: true},
+		},
+	}
+	newResourceData := func(name, description string) *schema.ResourceData {
+		d := schema.TestResourceDataRaw(t, resource.Schema, map[string]interface{}{
+			"name":        name,
+			"description": description,
+			"enabled":     true,
+		})
+		d.SetId("resource-id")
+		return d
+	}
+
+	cc := NewConsistencyCheck(context.Background(), newResourceData("expected name", "expected description"), nil, resource, 1, "genesyscloud_test")
+	defer DeleteConsistencyCheck("resource-id")
+
+	// Every mismatched attribute is part of the error
+	current := newResourceData("actual name", "actual description")
+	retryErr := cc.CheckState(current)
+	if retryErr == nil || !retryErr.Retryable {
+		t.Fatalf("Expected a retryable error, got %v", retryErr)
+	}
+	for _, attribute := range []string{"description", "name"} {
+		if !strings.Contains(retryErr.Err.Error(), "mismatch on attribute "+attribute) {
+			t.Errorf("Expected a mismatch on %s, got %v", attribute, retryErr.Err)
+		}
+	}
+
+	// Once the checks are exhausted, every mismatch is appended to the report
+	if retryErr := cc.CheckState(current); retryErr != nil {
+		t.Fatalf("Expected the consistency checker to give up, got %v", retryErr)
+	}
+	entries := readConsistencyReport(t)
+	if len(entries) != 2 {
+		t.Fatalf("Expected 2 report entries, got %d", len(entries))
+	}
+	expected := consistencyReportEntry{
+		RunId:            consistencyReportRunId,
+		Timestamp:        entries[0].Timestamp,
+		ResourceType:     "genesyscloud_test",
+		ResourceId:       "resource-id",
+		GCloudObjectName: "actual name",
+		Attribute:        "description",
+		Expected:         "expected description",
+		Actual:           "actual description",
+		Retries:          1,
+		Status:           consistencyStatusUnresolved,
+	}
+	if entries[0] != expected {
+		t.Errorf("Expected report entry %+v, got %+v", expected, entries[0])
+	}
+	if entries[1].Attribute != "name" {
+		t.Errorf("Expected the second report entry to be for name, got %s", entries[1].Attribute)
+	}
+}
+
+func TestUnitConsistencyReportMasksSensitiveValues(t *testing.T) {
+	consistencyReportFilePath = filepath.Join(t.TempDir(), "consistency-errors.jsonl")
+	t.Setenv("BYPASS_CONSISTENCY_CHECKER", "true")
+
+	resource := &schema.Resource{
+		Schema: map[string]*schema.Schema{
+			"name":     {Type: schema.TypeString, Required: true},
+			"password": {Type: schema.TypeString, Optional: true, Sensitive: true},
+			"credentials": {
+				Type:     schema.TypeList,
+				Optional: true,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"client_id":     {Type: schema.TypeString, Optional: true},
+						"client_secret": {Type: schema.TypeString, Optional: true, Sensitive: true},
+					},
+				},
+			},
+		},
+	}
+	newResourceData := func(password, clientId, clientSecret string) *schema.ResourceData {
+		d := schema.TestResourceDataRaw(t, resource.Schema, map[string]interface{}{
+			"name":        "name",
+			"password":    password,
+			"credentials": []interface{}{map[string]interface{}{"client_id": clientId, "client_secret": clientSecret}},
+		})
+		d.SetId("sensitive-id")
+		return d
+	}
+
+	cc := NewConsistencyCheck(context.Background(), newResourceData("expected-p4ss", "expected-client", "expected-s3cret"), nil, resource, 1, "genesyscloud_test")
+	defer DeleteConsistencyCheck("sensitive-id")
+	current := newResourceData("actual-p4ss", "actual-client", "actual-s3cret")
+	retryErr := cc.CheckState(current)
+	if retryErr == nil {
+		t.Fatalf("Expected a retryable error")
+	}
+	if retryErr := cc.CheckState(current); retryErr != nil {
+		t.Fatalf("Expected the consistency checker to give up, got %v", retryErr)
+	}
+
+	data, err := os.ReadFile(consistencyReportFilePath)
+	if err != nil {
+		t.Fatalf("Failed to read report: %v", err)
+	}
+	for _, secret := range []string{"p4ss", "s3cret"} {
+		if strings.Contains(string(data), secret) || strings.Contains(retryErr.Err.Error(), secret) {
+			t.Errorf("Expected sensitive value %s to be masked, got report:\n%s\nerror: %v", secret, data, retryErr.Err)
+		}
+	}
+	attributes := make(map[string]consistencyReportEntry)
+	for _, entry := range readConsistencyReport(t) {
+		attributes[entry.Attribute] = entry
+	}
+	if entry, ok := attributes["password"]; !ok || entry.Expected != sensitiveValueMask || entry.Actual != sensitiveValueMask {
+		t.Errorf("Expected the password mismatch to be reported masked, got %+v", attributes)
+	}
+	if entry, ok := attributes["credentials.0.client_id"]; !ok || entry.Actual != "actual-client" {
+		t.Errorf("Expected the client_id mismatch to be reported as it is, got %+v", attributes)
+	}
+}
+
+func TestUnitConsistencyReportResolved(t *testing.T) {
+	consistencyReportFilePath = filepath.Join(t.TempDir(), "consistency-errors.jsonl")
+
+	resource := &schema.Resource{
+		Schema: map[string]*schema.Schema{
+			"name": {Type: schema.TypeString, Required: true},
+		},
+	}
+	newResourceData := func(name string) *schema.ResourceData {
+		d := schema.TestResourceDataRaw(t, resource.Schema, map[string]interface{}{"name": name})
+		d.SetId("resolved-id")
+		return d
+	}
+
+	cc := NewConsistencyCheck(context.Background(), newResourceData("name"), nil, resource, 5, "genesyscloud_test")
+	if retryErr := cc.CheckState(newResourceData("stale name")); retryErr == nil {
+		t.Fatalf("Expected a retryable error")
+	}
+	if retryErr := cc.CheckState(newResourceData("name")); retryErr != nil {
+		t.Fatalf("Expected the state to be consistent, got %v", retryErr)
+	}
+
+	entries := readConsistencyReport(t)
+	if len(entries) != 1 {
+		t.Fatalf("Expected 1 report entry, got %d", len(entries))
+	}
+	if entries[0].Status != consistencyStatusResolved || entries[0].Retries != 1 || entries[0].Actual != "stale name" {
+		t.Errorf("Expected a resolved mismatch after 1 retry, got %+v", entries[0])
+	}
+}
+
+func TestUnitConsistencyReportTimeout(t *testing.T) {
+	consistencyReportFilePath = filepath.Join(t.TempDir(), "consistency-errors.jsonl")
+	// The toggle is on whenever the variable exists, so it is unset. t.Setenv restores it after the test.
+	t.Setenv("BYPASS_CONSISTENCY_CHECKER", "")
+	_ = os.Unsetenv("BYPASS_CONSISTENCY_CHECKER")
+
+	resource := &schema.Resource{
+		Schema: map[string]*schema.Schema{
+			"name": {Type: schema.TypeString, Required: true},
+		},
+	}
+	newResourceData := func(name string) *schema.ResourceData {
+		d := schema.TestResourceDataRaw(t, resource.Schema, map[string]interface{}{"name": name})
+		d.SetId("timeout-id")
+		return d
+	}
+
+	// Without bypass, the checker keeps retrying and nothing is reported until the read times out
+	cc := NewConsistencyCheck(context.Background(), newResourceData("name"), nil, resource, 1, "genesyscloud_test")
+	defer DeleteConsistencyCheck("timeout-id")
+	for i := 0; i < 3; i++ {
+		if retryErr := cc.CheckState(newResourceData("stale name")); retryErr == nil {
+			t.Fatalf("Expected a retryable error")
+		}
+	}
+	if _, err := os.Stat(consistencyReportFilePath); !os.IsNotExist(err) {
+		t.Fatalf("Expected no report before the timeout")
+	}
+
+	ReportUnresolvedConsistencyCheck("timeout-id")
+	ReportUnresolvedConsistencyCheck("unknown-id")
+	entries := readConsistencyReport(t)
+	if len(entries) != 1 {
+		t.Fatalf("Expected 1 report entry, got %d", len(entries))
+	}
+	expected := consistencyReportEntry{
+		RunId:            consistencyReportRunId,
+		Timestamp:        entries[0].Timestamp,
+		ResourceType:     "genesyscloud_test",
+		ResourceId:       "timeout-id",
+		GCloudObjectName: "stale name",
+		Attribute:        "name",
+		Expected:         "name",
+		Actual:           "stale name",
+		Retries:          3,
+		Status:           consistencyStatusUnresolved,
+	}
+	if entries[0] != expected {
+		t.Errorf("Expected report entry %+v, got %+v", expected, entries[0])
+	}
+	if entries[0].RunId == "" {
+		t.Errorf("Expected the report entry to have a run ID")
+	}
+}
+
+func readConsistencyReport(t *testing.T) []consistencyReportEntry {
+	file, err := os.Open(consistencyReportFilePath)
+	if err != nil {
+		t.Fatalf("Failed to open consistency report: %v", err)
+	}
+	defer file.Close()
+
+	var entries []consistencyReportEntry
+	scanner := bufio.NewScanner(file)
+	for scanner.Scan() {
+		var entry consistencyReportEntry
+		if err := json.Unmarshal(scanner.Bytes(), &entry); err != nil {
+			t.Fatalf("Failed to parse consistency report line %s: %v", scanner.Text(), err)
+		}
+		entries = append(entries, entry)
+	}
+	return entries
+}
@@ -14,7 +14,7 @@ with the Genesys Cloud SDK. We use composition here for each function on the pro
 out during testing.
 */
 
-// internalProxy holds a proxy instance that can be used throughout the package
+// internalProxy holds a proxy instance that unit tests can set to replace the proxy of the package
 var internalProxy *conversationsMessagingIntegrationsOpenProxy
 
 // Type definitions for each func on our proxy so we can easily mock them out later
@@ -52,14 +52,14 @@ func newConversationsMessagingIntegrationsOpenProxy(clientConfig *platformclient
 	}
 }
 
-// getConversationsMessagingIntegrationsOpenProxy acts as a singleton to for the internalProxy.  It also ensures
-// that we can still proxy our tests by directly setting internalProxy package variable
+// getConversationsMessagingIntegrationsOpenProxy returns a new proxy for the client config of every call, so provider configurations for
+// several orgs never share a proxy. It also ensures that we can still proxy our tests by directly setting
+// internalProxy package variable
 func getConversationsMessagingIntegrationsOpenProxy(clientConfig *platformclientv2.Configuration) *conversationsMessagingIntegrationsOpenProxy {
-	if internalProxy == nil {
-		internalProxy = newConversationsMessagingIntegrationsOpenProxy(clientConfig)
+	if internalProxy != nil {
+		return internalProxy
 	}
-
-	return internalProxy
+	return newConversationsMessagingIntegrationsOpenProxy(clientConfig)
 }
 
 // createConversationsMessagingIntegrationsOpen creates a Genesys Cloud conversations messaging integrations open
@@ -11,6 +11,8 @@ import (
 
 var internalProxy *conversationsMessagingSettingsProxy
 
+var messagingSettingsCache = rc.NewResourceCache[platformclientv2.Messagingsetting]()
+
 type getAllConversationsMessagingSettingsFunc func(ctx context.Context, p *conversationsMessagingSettingsProxy) (*[]platformclientv2.Messagingsetting, *platformclientv2.APIResponse, error)
 type createConversationsMessagingSettingsFunc func(ctx context.Context, p *conversationsMessagingSettingsProxy, messagingSettingRequest *platformclientv2.Messagingsettingrequest) (*platformclientv2.Messagingsetting, *platformclientv2.APIResponse, error)
 type getConversationsMessagingSettingsByIdFunc func(ctx context.Context, p *conversationsMessagingSettingsProxy, id string) (*platformclientv2.Messagingsetting, *platformclientv2.APIResponse, error)
@@ -36,7 +38,6 @@ type conversationsMessagingSettingsProxy struct {
 // newConversationsMessagingSettingsProxy initializes the conversations messaging settings proxy with all of the data needed to communicate with Genesys Cloud
 func newConversationsMessagingSettingsProxy(clientConfig *platformclientv2.Configuration) *conversationsMessagingSettingsProxy {
 	api := platformclientv2.NewConversationsApiWithConfig(clientConfig)
-	messagingSettingsCache := rc.NewResourceCache[platformclientv2.Messagingsetting]()
 	return &conversationsMessagingSettingsProxy{
 		clientConfig:                                  clientConfig,
 		conversationsApi:                              api,
@@ -51,13 +52,14 @@ func newConversationsMessagingSettingsProxy(clientConfig *platformclientv2.Confi
 	}
 }
 
-// getConversationsMessagingSettingsProxy acts as a singleton to for the internalProxy.  It also ensures
-// that we can still proxy our tests by directly setting internalProxy package variable
+// getConversationsMessagingSettingsProxy returns a new proxy for the client config of every call, so provider configurations for
+// several orgs never share a proxy. It also ensures that we can still proxy our tests by directly setting
+// internalProxy package variable
 func getConversationsMessagingSettingsProxy(clientConfig *platformclientv2.Configuration) *conversationsMessagingSettingsProxy {
-	if internalProxy == nil {
-		internalProxy = newConversationsMessagingSettingsProxy(clientConfig)
+	if internalProxy != nil {
+		return internalProxy
 	}
-	return internalProxy
+	return newConversationsMessagingSettingsProxy(clientConfig)
 }
 
 // getConversationsMessagingSettings retrieves all Genesys Cloud conversations messaging settings
@@ -139,7 +141,7 @@ func createConversationsMessagingSettingsFn(ctx context.Context, p *conversation
 
 // getConversationsMessagingSettingsByIdFn is an implementation of the function to get a Genesys Cloud conversations messaging settings by Id
 func getConversationsMessagingSettingsByIdFn(ctx context.Context, p *conversationsMessagingSettingsProxy, id string) (*platformclientv2.Messagingsetting, *platformclientv2.APIResponse, error) {
-	if setting := rc.GetCacheItem(p.messagingSettingsCache, id); setting != nil {
+	if setting := rc.GetCacheItem(ctx, p.messagingSettingsCache, id); setting != nil {
 		return setting, nil, nil
 	}
 	return p.conversationsApi.GetConversationsMessagingSetting(id)
@@ -12,7 +12,7 @@ import (
 	out during testing.
 */
 
-// internalProxy holds a proxy instance that can be used throughout the package
+// internalProxy holds a proxy instance that unit tests can set to replace the proxy of the package
 var internalProxy *conversationsMessagingSettingsDefaultProxy
 
 // Type definitions for each func on our proxy so we can easily mock them out later
@@ -41,13 +41,14 @@ func newConversationsMessagingSettingsDefaultProxy(clientConfig *platformclientv
 	}
 }
 
-// getConversationsMessagingSettingsDefaultProxy acts as a singleton to for the internalProxy.  It also ensures
-// that we can still proxy our tests by directly setting internalProxy package variable
+// getConversationsMessagingSettingsDefaultProxy returns a new proxy for the client config of every call, so provider configurations for
+// several orgs never share a proxy. It also ensures that we can still proxy our tests by directly setting
+// internalProxy package variable
 func getConversationsMessagingSettingsDefaultProxy(clientConfig *platformclientv2.Configuration) *conversationsMessagingSettingsDefaultProxy {
-	if internalProxy == nil {
-		internalProxy = newConversationsMessagingSettingsDefaultProxy(clientConfig)
+	if internalProxy != nil {
+		return internalProxy
 	}
-	return internalProxy
+	return newConversationsMessagingSettingsDefaultProxy(clientConfig)
 }
 
 // getConversationsMessagingSettingsDefault returns a single Genesys Cloud conversations messaging settings default by Id
@@ -15,9 +15,11 @@ with the Genesys Cloud SDK. We use composition here for each function on the pro
 out during testing.
 */
 
-// internalProxy holds a proxy instance that can be used throughout the package
+// internalProxy holds a proxy instance that unit tests can set to replace the proxy of the package
 var internalProxy *supportedContentProxy
 
+var supportedContentCache = rc.NewResourceCache[platformclientv2.Supportedcontent]()
+
 // Type definitions for each func on our proxy so we can easily mock them out later
 type createSupportedContentFunc func(ctx context.Context, p *supportedContentProxy, supportedContent *platformclientv2.Supportedcontent) (*platformclientv2.Supportedcontent, *platformclientv2.APIResponse, error)
 type getAllSupportedContentFunc func(ctx context.Context, p *supportedContentProxy) (*[]platformclientv2.Supportedcontent, *platformclientv2.APIResponse, error)
@@ -42,7 +44,6 @@ type supportedContentProxy struct {
 // newSupportedContentProxy initializes the supported content proxy with all of the data needed to communicate with Genesys Cloud
 func newSupportedContentProxy(clientConfig *platformclientv2.Configuration) *supportedContentProxy {
 	api := platformclientv2.NewConversationsApiWithConfig(clientConfig)
-	supportedContentCache := rc.NewResourceCache[platformclientv2.Supportedcontent]()
 	return &supportedContentProxy{
 		clientConfig:                    clientConfig,
 		conversationsApi:                api,
@@ -56,14 +57,14 @@ func newSupportedContentProxy(clientConfig *platformclientv2.Configuration) *sup
 	}
 }
 
-// getSupportedContentProxy acts as a singleton to for the internalProxy.  It also ensures
-// that we can still proxy our tests by directly setting internalProxy package variable
+// getSupportedContentProxy returns a new proxy for the client config of every call, so provider configurations for
+// several orgs never share a proxy. It also ensures that we can still proxy our tests by directly setting
+// internalProxy package variable
 func getSupportedContentProxy(clientConfig *platformclientv2.Configuration) *supportedContentProxy {
-	if internalProxy == nil {
-		internalProxy = newSupportedContentProxy(clientConfig)
+	if internalProxy != nil {
+		return internalProxy
 	}
-
-	return internalProxy
+	return newSupportedContentProxy(clientConfig)
 }
 
 // createSupportedContent creates a Genesys Cloud supported content
@@ -159,7 +160,7 @@ func getSupportedContentIdByNameFn(ctx context.Context, p *supportedContentProxy
 
 // getSupportedContentByIdFn is an implementation of the function to get a Genesys Cloud supported content by Id
 func getSupportedContentByIdFn(ctx context.Context, p *supportedContentProxy, id string) (supportedContent *platformclientv2.Supportedcontent, response *platformclientv2.APIResponse, err error) {
-	content := rc.GetCacheItem(p.supportedContentCache, id)
+	content := rc.GetCacheItem(ctx, p.supportedContentCache, id)
 	if content != nil {
 		return content, nil, nil
 	}
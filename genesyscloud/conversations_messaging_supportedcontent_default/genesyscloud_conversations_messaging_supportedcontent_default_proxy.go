@@ -12,7 +12,7 @@ with the Genesys Cloud SDK. We use composition here for each function on the pro
 out during testing.
 */
 
-// internalProxy holds a proxy instance that can be used throughout the package
+// internalProxy holds a proxy instance that unit tests can set to replace the proxy of the package
 var internalProxy *conversationsMessagingSupportedcontentDefaultProxy
 
 // Type definitions for each func on our proxy so we can easily mock them out later
@@ -38,14 +38,14 @@ func newConversationsMessagingSupportedcontentDefaultProxy(clientConfig *platfor
 	}
 }
 
-// getConversationsMessagingSupportedcontentDefaultProxy acts as a singleton to for the internalProxy.  It also ensures
-// that we can still proxy our tests by directly setting internalProxy package variable
+// getConversationsMessagingSupportedcontentDefaultProxy returns a new proxy for the client config of every call, so provider configurations for
+// several orgs never share a proxy. It also ensures that we can still proxy our tests by directly setting
+// internalProxy package variable
 func getConversationsMessagingSupportedcontentDefaultProxy(clientConfig *platformclientv2.Configuration) *conversationsMessagingSupportedcontentDefaultProxy {
-	if internalProxy == nil {
-		internalProxy = newConversationsMessagingSupportedcontentDefaultProxy(clientConfig)
+	if internalProxy != nil {
+		return internalProxy
 	}
-
-	return internalProxy
+	return newConversationsMessagingSupportedcontentDefaultProxy(clientConfig)
 }
 
 // getConversationsMessagingSupportedcontentDefault retrieves all Genesys Cloud conversations messaging supportedcontent default
@@ -0,0 +1,55 @@
+package dependent_consumers
+
+import (
+	"context"
+	"fmt"
+	"log"
+	"sort"
+	"strings"
+	"terraform-provider-genesyscloud/genesyscloud/provider"
+	"terraform-provider-genesyscloud/genesyscloud/util"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+)
+
+// CheckDeleteProtection returns an error listing the flows that consume an object when delete protection is enabled
+// in the provider configuration. Deleting an object that a flow uses breaks the flow.
+func CheckDeleteProtection(ctx context.Context, meta interface{}, resourceType string, id string) diag.Diagnostics {
+	providerMeta := meta.(*provider.ProviderMeta)
+	if !providerMeta.DeleteProtection {
+		return nil
+	}
+
+	proxy := GetDependentConsumerProxy(providerMeta.ClientConfig)
+	object, resp, err := proxy.GetDependencyObject(ctx, resourceType, id)
+	if err != nil {
+		if util.IsStatus404(resp) {
+			// Objects that dependency tracking does not know about have no consumers
+			log.Printf("No dependency tracking object found for %s %s", resourceType, id)
+			return nil
+		}
+		return util.BuildAPIDiagnosticError(resourceType, fmt.Sprintf("Failed to get the consumers of %s for delete protection error: %s", id, err), resp)
+	}
+	if object.ConsumingResources == nil {
+		return nil
+	}
+
+	var flows []string
+	for _, consumer := range *object.ConsumingResources {
+		if consumer.VarType == nil || !IsFlowObjectType(*consumer.VarType) {
+			continue
+		}
+		name := ""
+		if consumer.Name != nil {
+			name = *consumer.Name
+		}
+		flows = append(flows, fmt.Sprintf("%s (%s)", name, *consumer.Id))
+	}
+	if len(flows) == 0 {
+		return nil
+	}
+
+	sort.Strings(flows)
+	return util.BuildDiagnosticError(resourceType, fmt.Sprintf("%s is used by flows and was not deleted because delete_protection is enabled", id),
+		fmt.Errorf("remove %s from these flows before deleting it: %s", id, strings.Join(flows, ", ")))
+}
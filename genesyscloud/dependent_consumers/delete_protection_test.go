@@ -0,0 +1,140 @@
+package dependent_consumers
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"sync"
+	"terraform-provider-genesyscloud/genesyscloud/provider"
+	"testing"
+
+	"github.com/google/uuid"
+	"github.com/mypurecloud/platform-client-sdk-go/v152/platformclientv2"
+)
+
+func TestUnitCheckDeleteProtection(t *testing.T) {
+	var (
+		queueId    = uuid.NewString()
+		flowId     = uuid.NewString()
+		flowName   = "Main flow"
+		flowType   = "INBOUNDCALLFLOW"
+		scriptId   = uuid.NewString()
+		scriptType = "COMPOSERSCRIPT"
+		consumers  []platformclientv2.Dependency
+	)
+
+	InternalProxy = &DependentConsumerProxy{
+		GetDependencyObjectAttr: func(_ context.Context, _ *DependentConsumerProxy, resourceType string, id string) (*platformclientv2.Dependencyobject, *platformclientv2.APIResponse, error) {
+			if id != queueId {
+				return nil, &platformclientv2.APIResponse{StatusCode: http.StatusNotFound}, fmt.Errorf("object %s not found", id)
+			}
+			return &platformclientv2.Dependencyobject{Id: &id, ConsumingResources: &consumers}, nil, nil
+		},
+	}
+	defer func() { InternalProxy = nil }()
+
+	protected := &provider.ProviderMeta{DeleteProtection: true}
+
+	consumers = []platformclientv2.Dependency{
+		{Id: &scriptId, VarType: &scriptType},
+		{Id: &flowId, Name: &flowName, VarType: &flowType},
+	}
+	diagErr := CheckDeleteProtection(context.Background(), protected, "genesyscloud_routing_queue", queueId)
+	if diagErr == nil || !strings.Contains(fmt.Sprint(diagErr), fmt.Sprintf("%s (%s)", flowName, flowId)) {
+		t.Errorf("expected the delete to be refused because of flow %s, got %v", flowId, diagErr)
+	}
+	if strings.Contains(fmt.Sprint(diagErr), scriptId) {
+		t.Errorf("expected only flows to be listed, got %v", diagErr)
+	}
+
+	if diagErr := CheckDeleteProtection(context.Background(), &provider.ProviderMeta{}, "genesyscloud_routing_queue", queueId); diagErr != nil {
+		t.Errorf("expected the delete to be allowed without delete protection, got %v", diagErr)
+	}
+
+	// Every flow type is a flow consumer, including the ones that are missing from the object type maps
+	for _, otherFlowType := range []string{"DIGITALBOTFLOW", "NEWCHANNELFLOW"} {
+		otherFlowType := otherFlowType
+		consumers = []platformclientv2.Dependency{{Id: &flowId, Name: &flowName, VarType: &otherFlowType}}
+		if diagErr := CheckDeleteProtection(context.Background(), protected, "genesyscloud_routing_queue", queueId); diagErr == nil {
+			t.Errorf("expected the delete to be refused because of %s %s", otherFlowType, flowId)
+		}
+	}
+
+	consumers = []platformclientv2.Dependency{{Id: &scriptId, VarType: &scriptType}}
+	if diagErr := CheckDeleteProtection(context.Background(), protected, "genesyscloud_routing_queue", queueId); diagErr != nil {
+		t.Errorf("expected the delete to be allowed when no flow uses the queue, got %v", diagErr)
+	}
+
+	if diagErr := CheckDeleteProtection(context.Background(), protected, "genesyscloud_routing_queue", uuid.NewString()); diagErr != nil {
+		t.Errorf("expected the delete to be allowed when the queue is not tracked, got %v", diagErr)
+	}
+}
+
+// TestUnitCheckDeleteProtectionConcurrent deletes queues in two orgs at the same time. Every check must query the org of
+// its own provider configuration. Run it with -race to detect shared proxy state.
+func TestUnitCheckDeleteProtectionConcurrent(t *testing.T) {
+	var (
+		queueId  = uuid.NewString()
+		flowId   = uuid.NewString()
+		flowName = "Org A flow"
+		flowType = "INBOUNDCALLFLOW"
+	)
+
+	// Only org A tracks the queue. Org B answers 404, so its deletes are allowed.
+	orgA := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Content-Type", "application/json")
+		_ = json.NewEncoder(w).Encode(platformclientv2.Dependencyobject{
+			Id:                 &queueId,
+			ConsumingResources: &[]platformclientv2.Dependency{{Id: &flowId, Name: &flowName, VarType: &flowType}},
+		})
+	}))
+	defer orgA.Close()
+	orgB := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Content-Type", "application/json")
+		w.WriteHeader(http.StatusNotFound)
+		_, _ = w.Write([]byte(`{"status":404,"code":"not.found","message":"not found"}`))
+	}))
+	defer orgB.Close()
+
+	// Each check gets its own client, like operations that take clients from the pool. The SDK configures logging
+	// globally when a client is created, so the clients are created before the checks start.
+	newMeta := func(basePath string) *provider.ProviderMeta {
+		config := platformclientv2.NewConfiguration()
+		config.BasePath = basePath
+		config.AccessToken = uuid.NewString()
+		return &provider.ProviderMeta{ClientConfig: config, DeleteProtection: true}
+	}
+	const checks = 20
+	var metasA, metasB []*provider.ProviderMeta
+	for i := 0; i < checks; i++ {
+		metasA = append(metasA, newMeta(orgA.URL))
+		metasB = append(metasB, newMeta(orgB.URL))
+	}
+
+	var wg sync.WaitGroup
+	errs := make(chan string, 2*checks)
+	for i := 0; i < checks; i++ {
+		wg.Add(2)
+		go func(meta *provider.ProviderMeta) {
+			defer wg.Done()
+			diagErr := CheckDeleteProtection(context.Background(), meta, "genesyscloud_routing_queue", queueId)
+			if diagErr == nil || !strings.Contains(fmt.Sprint(diagErr), flowId) {
+				errs <- fmt.Sprintf("expected the org A delete to be refused because of flow %s, got %v", flowId, diagErr)
+			}
+		}(metasA[i])
+		go func(meta *provider.ProviderMeta) {
+			defer wg.Done()
+			if diagErr := CheckDeleteProtection(context.Background(), meta, "genesyscloud_routing_queue", queueId); diagErr != nil {
+				errs <- fmt.Sprintf("expected the org B delete to be allowed, got %v", diagErr)
+			}
+		}(metasB[i])
+	}
+	wg.Wait()
+	close(errs)
+	for err := range errs {
+		t.Error(err)
+	}
+}
@@ -1,77 +1,118 @@
 package dependent_consumers
 
-var dependentConsumerMap map[string]string
-var flowTypeObjectMap map[string]string
+import (
+	"sort"
+	"strings"
+)
+
+// dependentConsumerMap maps dependency tracking object types to resource types. It is never modified, so it is safe to
+// read from parallel resource and data source operations.
+var dependentConsumerMap = map[string]string{
+	"ACDLANGUAGE":             "genesyscloud_routing_language",
+	"ACDSKILL":                "genesyscloud_routing_skill",
+	"ACDWRAPUPCODE":           "genesyscloud_routing_wrapupcode",
+	"BOTFLOW":                 "genesyscloud_flow",
+	"COMPOSERSCRIPT":          "genesyscloud_script",
+	"COMMONMODULEFLOW":        "genesyscloud_flow",
+	"CONTACTLIST":             "genesyscloud_outbound_contact_list",
+	"DATAACTION":              "genesyscloud_integration_action",
+	"DATATABLE":               "genesyscloud_architect_datatable",
+	"DIGITALBOTFLOW":          "genesyscloud_flow",
+	"EMAILROUTE":              "genesyscloud_routing_email_route",
+	"EMERGENCYGROUP":          "genesyscloud_architect_emergencygroup",
+	"FLOWMILESTONE":           "genesyscloud_flow_milestone",
+	"FLOWOUTCOME":             "genesyscloud_flow_outcome",
+	"GRAMMAR":                 "genesyscloud_architect_grammar",
+	"GROUP":                   "genesyscloud_group",
+	"INBOUNDCALLFLOW":         "genesyscloud_flow",
+	"INBOUNDEMAILFLOW":        "genesyscloud_flow",
+	"INBOUNDCHATFLOW":         "genesyscloud_flow",
+	"INBOUNDSHORTMESSAGEFLOW": "genesyscloud_flow",
+	"INQUEUECALLFLOW":         "genesyscloud_flow",
+	"INQUEUEEMAILFLOW":        "genesyscloud_flow",
+	"INQUEUESHORTMESSAGEFLOW": "genesyscloud_flow",
+	"IVRCONFIGURATION":        "genesyscloud_architect_ivr",
+	"KNOWLEDGEBASE":           "genesyscloud_knowledge_knowledgebase",
+	"KNOWLEDGEBASEDOCUMENT":   "genesyscloud_knowledge_document",
+	"LANGUAGE":                "genesyscloud_routing_language",
+	"OAUTHCLIENT":             "genesyscloud_oauth_client",
+	"OUTBOUNDCALLFLOW":        "genesyscloud_flow",
+	"QUEUE":                   "genesyscloud_routing_queue",
+	"RECORDINGPOLICY":         "genesyscloud_recording_media_retention_policy",
+	"RESPONSE":                "genesyscloud_responsemanagement_response",
+	"SCHEDULE":                "genesyscloud_architect_schedules",
+	"SCHEDULEGROUP":           "genesyscloud_architect_schedulegroups",
+	"SECURECALLFLOW":          "genesyscloud_flow",
+	"SURVEYINVITEFLOW":        "genesyscloud_flow",
+	"USER":                    "genesyscloud_user",
+	"USERPROMPT":              "genesyscloud_architect_user_prompt",
+	"VOICEFLOW":               "genesyscloud_flow",
+	"VOICEMAILFLOW":           "genesyscloud_flow",
+	"WIDGET":                  "genesyscloud_widget_deployment",
+	"WORKFLOW":                "genesyscloud_flow",
+	"WORKITEMFLOW":            "genesyscloud_flow",
+}
+
+// flowTypeObjectMap maps flow types to dependency tracking object types
+var flowTypeObjectMap = map[string]string{
+	"BOT":                 "BOTFLOW",
+	"COMMONMODULE":        "COMMONMODULEFLOW",
+	"DIGITALBOT":          "DIGITALBOTFLOW",
+	"INBOUNDCALL":         "INBOUNDCALLFLOW",
+	"INBOUNDCHAT":         "INBOUNDCHATFLOW",
+	"INBOUNDEMAIL":        "INBOUNDEMAILFLOW",
+	"INBOUNDSHORTMESSAGE": "INBOUNDSHORTMESSAGEFLOW",
+	"INQUEUECALL":         "INQUEUECALLFLOW",
+	"INQUEUEEMAIL":        "INBOUNDEMAILFLOW",
+	"INQUEUESHORTMESSAGE": "INQUEUESHORTMESSAGEFLOW",
+	"OUTBOUNDCALL":        "OUTBOUNDCALLFLOW",
+	"SECURECALL":          "SECURECALLFLOW",
+	"SURVEYINVITE":        "SURVEYINVITEFLOW",
+	"VOICE":               "VOICEFLOW",
+	"VOICEMAIL":           "VOICEMAILFLOW",
+	"WORKFLOW":            "WORKFLOW",
+	"WORKITEM":            "WORKITEMFLOW",
+}
 
 func SetDependentObjectMaps() map[string]string {
-	if len(dependentConsumerMap) < 1 {
-		dependentConsumerMap = make(map[string]string)
-		dependentConsumerMap["ACDLANGUAGE"] = "genesyscloud_routing_language"
-		dependentConsumerMap["ACDSKILL"] = "genesyscloud_routing_skill"
-		dependentConsumerMap["ACDWRAPUPCODE"] = "genesyscloud_routing_wrapupcode"
-		dependentConsumerMap["BOTFLOW"] = "genesyscloud_flow"
-		dependentConsumerMap["COMPOSERSCRIPT"] = "genesyscloud_script"
-		dependentConsumerMap["COMMONMODULEFLOW"] = "genesyscloud_flow"
-		dependentConsumerMap["CONTACTLIST"] = "genesyscloud_outbound_contact_list"
-		dependentConsumerMap["DATAACTION"] = "genesyscloud_integration_action"
-		dependentConsumerMap["DATATABLE"] = "genesyscloud_architect_datatable"
-		dependentConsumerMap["EMAILROUTE"] = "genesyscloud_routing_email_route"
-		dependentConsumerMap["EMERGENCYGROUP"] = "genesyscloud_architect_emergencygroup"
-		dependentConsumerMap["FLOWMILESTONE"] = "genesyscloud_flow_milestone"
-		dependentConsumerMap["FLOWOUTCOME"] = "genesyscloud_flow_outcome"
-		dependentConsumerMap["GRAMMAR"] = "genesyscloud_architect_grammar"
-		dependentConsumerMap["GROUP"] = "genesyscloud_group"
-		dependentConsumerMap["INBOUNDCALLFLOW"] = "genesyscloud_flow"
-		dependentConsumerMap["INBOUNDEMAILFLOW"] = "genesyscloud_flow"
-		dependentConsumerMap["INBOUNDCHATFLOW"] = "genesyscloud_flow"
-		dependentConsumerMap["INBOUNDSHORTMESSAGEFLOW"] = "genesyscloud_flow"
-		dependentConsumerMap["INQUEUECALLFLOW"] = "genesyscloud_flow"
-		dependentConsumerMap["INQUEUEEMAILFLOW"] = "genesyscloud_flow"
-		dependentConsumerMap["INQUEUESHORTMESSAGEFLOW"] = "genesyscloud_flow"
-		dependentConsumerMap["IVRCONFIGURATION"] = "genesyscloud_architect_ivr"
-		dependentConsumerMap["KNOWLEDGEBASE"] = "genesyscloud_knowledge_knowledgebase"
-		dependentConsumerMap["KNOWLEDGEBASEDOCUMENT"] = "genesyscloud_knowledge_document"
-		dependentConsumerMap["LANGUAGE"] = "genesyscloud_routing_language"
-		dependentConsumerMap["OAUTHCLIENT"] = "genesyscloud_oauth_client"
-		dependentConsumerMap["OUTBOUNDCALLFLOW"] = "genesyscloud_flow"
-		dependentConsumerMap["QUEUE"] = "genesyscloud_routing_queue"
-		dependentConsumerMap["RECORDINGPOLICY"] = "genesyscloud_recording_media_retention_policy"
-		dependentConsumerMap["RESPONSE"] = "genesyscloud_responsemanagement_response"
-		dependentConsumerMap["SCHEDULE"] = "genesyscloud_architect_schedules"
-		dependentConsumerMap["SCHEDULEGROUP"] = "genesyscloud_architect_schedulegroups"
-		dependentConsumerMap["SECURECALLFLOW"] = "genesyscloud_flow"
-		dependentConsumerMap["SURVEYINVITEFLOW"] = "genesyscloud_flow"
-		dependentConsumerMap["USER"] = "genesyscloud_user"
-		dependentConsumerMap["USERPROMPT"] = "genesyscloud_architect_user_prompt"
-		dependentConsumerMap["VOICEFLOW"] = "genesyscloud_flow"
-		dependentConsumerMap["VOICEMAILFLOW"] = "genesyscloud_flow"
-		dependentConsumerMap["WIDGET"] = "genesyscloud_widget_deployment"
-		dependentConsumerMap["WORKFLOW"] = "genesyscloud_flow"
-		dependentConsumerMap["WORKITEMFLOW"] = "genesyscloud_flow"
-	}
 	return dependentConsumerMap
 }
 
 func SetFlowTypeObjectMaps() map[string]string {
-	if len(flowTypeObjectMap) < 1 {
-		flowTypeObjectMap = make(map[string]string)
-		flowTypeObjectMap["BOT"] = "BOTFLOW"
-		flowTypeObjectMap["COMMONMODULE"] = "COMMONMODULEFLOW"
-		flowTypeObjectMap["DIGITALBOT"] = "DIGITALBOTFLOW"
-		flowTypeObjectMap["INBOUNDCALL"] = "INBOUNDCALLFLOW"
-		flowTypeObjectMap["INBOUNDCHAT"] = "INBOUNDCHATFLOW"
-		flowTypeObjectMap["INBOUNDEMAIL"] = "INBOUNDEMAILFLOW"
-		flowTypeObjectMap["INBOUNDSHORTMESSAGE"] = "INBOUNDSHORTMESSAGEFLOW"
-		flowTypeObjectMap["INQUEUECALL"] = "INQUEUECALLFLOW"
-		flowTypeObjectMap["INQUEUEEMAIL"] = "INBOUNDEMAILFLOW"
-		flowTypeObjectMap["INQUEUESHORTMESSAGE"] = "INQUEUESHORTMESSAGEFLOW"
-		flowTypeObjectMap["OUTBOUNDCALL"] = "OUTBOUNDCALLFLOW"
-		flowTypeObjectMap["SECURECALL"] = "SECURECALLFLOW"
-		flowTypeObjectMap["SURVEYINVITE"] = "SURVEYINVITEFLOW"
-		flowTypeObjectMap["VOICE"] = "VOICEFLOW"
-		flowTypeObjectMap["VOICEMAIL"] = "VOICEMAILFLOW"
-		flowTypeObjectMap["WORKFLOW"] = "WORKFLOW"
-		flowTypeObjectMap["WORKITEM"] = "WORKITEMFLOW"
-	}
 	return flowTypeObjectMap
 }
+
+// IsFlowObjectType returns true if a dependency tracking object type is a flow. Every flow type has an object type
+// ending in FLOW, so flow types that are missing from the maps are matched as well.
+func IsFlowObjectType(objectType string) bool {
+	return SetDependentObjectMaps()[objectType] == gflow || strings.HasSuffix(objectType, "FLOW")
+}
+
+// GetObjectType returns the dependency tracking object type of a resource type. Flows have an object type per flow type,
+// so they are not included.
+func GetObjectType(resourceType string) (string, bool) {
+	var objectTypes []string
+	for objectType, consumerResourceType := range SetDependentObjectMaps() {
+		if consumerResourceType == resourceType && resourceType != gflow {
+			objectTypes = append(objectTypes, objectType)
+		}
+	}
+	if len(objectTypes) == 0 {
+		return "", false
+	}
+	// Languages have two object types. The ACD one is used by routing.
+	sort.Strings(objectTypes)
+	return objectTypes[0], true
+}
+
+// GetResourceTypes returns the resource types that dependency tracking can be queried for
+func GetResourceTypes() []string {
+	var resourceTypes []string
+	for _, resourceType := range SetDependentObjectMaps() {
+		if !stringInSlice(resourceType, resourceTypes) {
+			resourceTypes = append(resourceTypes, resourceType)
+		}
+	}
+	sort.Strings(resourceTypes)
+	return resourceTypes
+}
@@ -19,6 +19,7 @@ type DependentConsumerProxy struct {
 	ArchitectApi                   *platformclientv2.ArchitectApi
 	RetrieveDependentConsumersAttr retrieveDependentConsumersFunc
 	GetPooledClientAttr            retrievePooledClientFunc
+	GetDependencyObjectAttr        getDependencyObjectFunc
 }
 
 var gflow = "genesyscloud_flow"
@@ -31,31 +32,40 @@ func (p *DependentConsumerProxy) GetAllWithPooledClient(method provider.GetCusto
 	return p.GetPooledClientAttr(method)
 }
 
+// GetDependencyObject returns the consumers and consumed resources of an object of a resource type
+func (p *DependentConsumerProxy) GetDependencyObject(ctx context.Context, resourceType string, id string) (*platformclientv2.Dependencyobject, *platformclientv2.APIResponse, error) {
+	return p.GetDependencyObjectAttr(ctx, p, resourceType, id)
+}
+
 type retrieveDependentConsumersFunc func(ctx context.Context, p *DependentConsumerProxy, resourceKeys resourceExporter.ResourceInfo) (resourceExporter.ResourceIDMetaMap, *resourceExporter.DependencyResource, error)
 type retrievePooledClientFunc func(method provider.GetCustomConfigFunc) (resourceExporter.ResourceIDMetaMap, *resourceExporter.DependencyResource, diag.Diagnostics)
+type getDependencyObjectFunc func(ctx context.Context, p *DependentConsumerProxy, resourceType string, id string) (*platformclientv2.Dependencyobject, *platformclientv2.APIResponse, error)
 
+// InternalProxy replaces the proxy returned by GetDependentConsumerProxy when it is set by unit tests
 var InternalProxy *DependentConsumerProxy
 
-// GetDependentConsumerProxy acts as a singleton to for the InternalProxy.
+// GetDependentConsumerProxy returns a proxy that uses ClientConfig. Every call gets its own proxy, so parallel
+// operations never query dependency tracking with another operation's client or org.
 func GetDependentConsumerProxy(ClientConfig *platformclientv2.Configuration) *DependentConsumerProxy {
+	if InternalProxy != nil {
+		return InternalProxy
+	}
 	return newDependentConsumerProxy(ClientConfig)
 }
 
-// newDependentConsumerProxy initializes the ruleset proxy with all of the data needed to communicate with Genesys Cloud
+// newDependentConsumerProxy initializes the dependent consumer proxy with all of the data needed to communicate with Genesys Cloud
 func newDependentConsumerProxy(ClientConfig *platformclientv2.Configuration) *DependentConsumerProxy {
-	if InternalProxy == nil {
-		InternalProxy = &DependentConsumerProxy{
-			GetPooledClientAttr: retrievePooledClientFn,
-		}
+	proxy := &DependentConsumerProxy{
+		GetPooledClientAttr: retrievePooledClientFn,
 	}
 
 	if ClientConfig != nil {
-		api := platformclientv2.NewArchitectApiWithConfig(ClientConfig)
-		InternalProxy.ClientConfig = ClientConfig
-		InternalProxy.ArchitectApi = api
-		InternalProxy.RetrieveDependentConsumersAttr = retrieveDependentConsumersFn
+		proxy.ClientConfig = ClientConfig
+		proxy.ArchitectApi = platformclientv2.NewArchitectApiWithConfig(ClientConfig)
+		proxy.RetrieveDependentConsumersAttr = retrieveDependentConsumersFn
+		proxy.GetDependencyObjectAttr = getDependencyObjectFn
 	}
-	return InternalProxy
+	return proxy
 }
 
 func retrievePooledClientFn(method provider.GetCustomConfigFunc) (resourceExporter.ResourceIDMetaMap, *resourceExporter.DependencyResource, diag.Diagnostics) {
@@ -85,6 +95,26 @@ func retrieveDependentConsumersFn(ctx context.Context, p *DependentConsumerProxy
 	}, nil
 }
 
+// getDependencyObjectFn looks up the dependency tracking object of a resource. The published version is used for flows.
+func getDependencyObjectFn(_ context.Context, p *DependentConsumerProxy, resourceType string, id string) (*platformclientv2.Dependencyobject, *platformclientv2.APIResponse, error) {
+	version := ""
+	objectType, exists := GetObjectType(resourceType)
+	if resourceType == gflow {
+		flow, resp, err := p.ArchitectApi.GetFlow(id, false)
+		if err != nil {
+			return nil, resp, err
+		}
+		objectType, exists = SetFlowTypeObjectMaps()[*flow.VarType]
+		if flow.PublishedVersion != nil && flow.PublishedVersion.Id != nil {
+			version = *flow.PublishedVersion.Id
+		}
+	}
+	if !exists {
+		return nil, nil, fmt.Errorf("dependency tracking is not supported for %s %s", resourceType, id)
+	}
+	return p.ArchitectApi.GetArchitectDependencytrackingObject(id, version, objectType, true, true, nil, nil, false)
+}
+
 func fetchDepConsumers(ctx context.Context,
 	p *DependentConsumerProxy,
 	resType string,
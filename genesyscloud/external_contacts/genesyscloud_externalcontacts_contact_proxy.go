@@ -31,9 +31,11 @@ Each proxy implementation:
 
 */
 
-// internalProxy holds a proxy instance that can be used throughout the package
+// internalProxy holds a proxy instance that unit tests can set to replace the proxy of the package
 var internalProxy *externalContactsContactsProxy
 
+var externalContactsCache = rc.NewResourceCache[platformclientv2.Externalcontact]()
+
 // Type definitions for each func on our proxy so we can easily mock them out later
 type getAllExternalContactsFunc func(ctx context.Context, p *externalContactsContactsProxy) (*[]platformclientv2.Externalcontact, *platformclientv2.APIResponse, error)
 type createExternalContactFunc func(ctx context.Context, p *externalContactsContactsProxy, externalContact platformclientv2.Externalcontact) (*platformclientv2.Externalcontact, *platformclientv2.APIResponse, error)
@@ -58,7 +60,6 @@ type externalContactsContactsProxy struct {
 // newExternalContactsContactsProxy initializes the External Contacts proxy with all of the data needed to communicate with Genesys Cloud
 func newExternalContactsContactsProxy(clientConfig *platformclientv2.Configuration) *externalContactsContactsProxy {
 	api := platformclientv2.NewExternalContactsApiWithConfig(clientConfig)
-	externalContactsCache := rc.NewResourceCache[platformclientv2.Externalcontact]()
 	return &externalContactsContactsProxy{
 		clientConfig:                     clientConfig,
 		externalContactsApi:              api,
@@ -72,13 +73,14 @@ func newExternalContactsContactsProxy(clientConfig *platformclientv2.Configurati
 	}
 }
 
-// getExternalContactsContactsProxy acts as a singleton to for the internalProxy.  It also ensures
-// that we can still proxy our tests by directly setting internalProxy package variable
+// getExternalContactsContactsProxy returns a new proxy for the client config of every call, so provider configurations for
+// several orgs never share a proxy. It also ensures that we can still proxy our tests by directly setting
+// internalProxy package variable
 func getExternalContactsContactsProxy(clientConfig *platformclientv2.Configuration) *externalContactsContactsProxy {
-	if internalProxy == nil {
-		internalProxy = newExternalContactsContactsProxy(clientConfig)
+	if internalProxy != nil {
+		return internalProxy
 	}
-	return internalProxy
+	return newExternalContactsContactsProxy(clientConfig)
 }
 
 // getAllExternalContacts retrieves all Genesys Cloud External Contacts
@@ -98,7 +100,7 @@ func (p *externalContactsContactsProxy) deleteExternalContactId(ctx context.Cont
 
 // getExternalContactById returns a single Genesys Cloud External Contact by Id
 func (p *externalContactsContactsProxy) getExternalContactById(ctx context.Context, externalContactId string) (*platformclientv2.Externalcontact, *platformclientv2.APIResponse, error) {
-	if externalContacts := rc.GetCacheItem(p.externalContactsCache, externalContactId); externalContacts != nil { // Get the Externalcontact from the cache, if not there in the cache then call p.getExternalContactByIdAttr()
+	if externalContacts := rc.GetCacheItem(ctx, p.externalContactsCache, externalContactId); externalContacts != nil { // Get the Externalcontact from the cache, if not there in the cache then call p.getExternalContactByIdAttr()
 		return externalContacts, nil, nil
 	}
 	return p.getExternalContactByIdAttr(ctx, p, externalContactId)
@@ -9,9 +9,11 @@ import (
 	"github.com/mypurecloud/platform-client-sdk-go/v152/platformclientv2"
 )
 
-// internalProxy holds a proxy instance that can be used throughout the package
+// internalProxy holds a proxy instance that unit tests can set to replace the proxy of the package
 var internalProxy *externalContactsExternalSourceProxy
 
+var externalSourcesCache = rc.NewResourceCache[platformclientv2.Externalsource]()
+
 // Type definitions for each func on our proxy so we can easily mock them out later
 type createExternalContactsExternalSourceFunc func(ctx context.Context, p *externalContactsExternalSourceProxy, externalSource *platformclientv2.Externalsource) (*platformclientv2.Externalsource, *platformclientv2.APIResponse, error)
 type getAllExternalContactsExternalSourceFunc func(ctx context.Context, p *externalContactsExternalSourceProxy, query string) (*[]platformclientv2.Externalsource, *platformclientv2.APIResponse, error)
@@ -36,7 +38,6 @@ type externalContactsExternalSourceProxy struct {
 // newExternalContactsExternalSourceProxy initializes the external contacts external source proxy with all of the data needed to communicate with Genesys Cloud
 func newExternalContactsExternalSourceProxy(clientConfig *platformclientv2.Configuration) *externalContactsExternalSourceProxy {
 	api := platformclientv2.NewExternalContactsApiWithConfig(clientConfig)
-	externalSourcesCache := rc.NewResourceCache[platformclientv2.Externalsource]()
 	return &externalContactsExternalSourceProxy{
 		clientConfig:                                  clientConfig,
 		externalContactsApi:                           api,
@@ -50,14 +51,14 @@ func newExternalContactsExternalSourceProxy(clientConfig *platformclientv2.Confi
 	}
 }
 
-// getExternalContactsExternalSourceProxy acts as a singleton to for the internalProxy.  It also ensures
-// that we can still proxy our tests by directly setting internalProxy package variable
+// getExternalContactsExternalSourceProxy returns a new proxy for the client config of every call, so provider configurations for
+// several orgs never share a proxy. It also ensures that we can still proxy our tests by directly setting
+// internalProxy package variable
 func getExternalContactsExternalSourceProxy(clientConfig *platformclientv2.Configuration) *externalContactsExternalSourceProxy {
-	if internalProxy == nil {
-		internalProxy = newExternalContactsExternalSourceProxy(clientConfig)
+	if internalProxy != nil {
+		return internalProxy
 	}
-
-	return internalProxy
+	return newExternalContactsExternalSourceProxy(clientConfig)
 }
 
 // createExternalContactsExternalSource creates a Genesys Cloud external contacts external source
@@ -175,7 +176,7 @@ func getExternalContactsExternalSourceIdByNameFn(ctx context.Context, p *externa
 
 // getExternalContactsExternalSourceByIdFn is an implementation of the function to get a Genesys Cloud external contacts external source by Id
 func getExternalContactsExternalSourceByIdFn(ctx context.Context, p *externalContactsExternalSourceProxy, id string) (externalContactsExternalSource *platformclientv2.Externalsource, apiResponse *platformclientv2.APIResponse, err error) {
-	if externalSource := rc.GetCacheItem(p.externalSourcesCache, id); externalSource != nil {
+	if externalSource := rc.GetCacheItem(ctx, p.externalSourcesCache, id); externalSource != nil {
 		return externalSource, nil, nil
 	}
 	return p.externalContactsApi.GetExternalcontactsExternalsource(id)
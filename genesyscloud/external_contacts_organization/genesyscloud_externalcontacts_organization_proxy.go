@@ -15,9 +15,11 @@ with the Genesys Cloud SDK. We use composition here for each function on the pro
 out during testing.
 */
 
-// internalProxy holds a proxy instance that can be used throughout the package
+// internalProxy holds a proxy instance that unit tests can set to replace the proxy of the package
 var internalProxy *externalContactsOrganizationProxy
 
+var externalOrganizationCache = rc.NewResourceCache[platformclientv2.Externalorganization]()
+
 // Type definitions for each func on our proxy so we can easily mock them out later
 type createExternalContactsOrganizationFunc func(ctx context.Context, p *externalContactsOrganizationProxy, externalOrganization *platformclientv2.Externalorganization) (*platformclientv2.Externalorganization, *platformclientv2.APIResponse, error)
 type getAllExternalContactsOrganizationFunc func(ctx context.Context, p *externalContactsOrganizationProxy) (*[]platformclientv2.Externalorganization, *platformclientv2.APIResponse, error)
@@ -42,7 +44,6 @@ type externalContactsOrganizationProxy struct {
 // newExternalContactsOrganizationProxy initializes the external contacts organization proxy with all of the data needed to communicate with Genesys Cloud
 func newExternalContactsOrganizationProxy(clientConfig *platformclientv2.Configuration) *externalContactsOrganizationProxy {
 	api := platformclientv2.NewExternalContactsApiWithConfig(clientConfig)
-	externalOrganizationCache := rc.NewResourceCache[platformclientv2.Externalorganization]()
 	return &externalContactsOrganizationProxy{
 		clientConfig:                                clientConfig,
 		externalContactsApi:                         api,
@@ -56,14 +57,14 @@ func newExternalContactsOrganizationProxy(clientConfig *platformclientv2.Configu
 	}
 }
 
-// getExternalContactsOrganizationProxy acts as a singleton to for the internalProxy.  It also ensures
-// that we can still proxy our tests by directly setting internalProxy package variable
+// getExternalContactsOrganizationProxy returns a new proxy for the client config of every call, so provider configurations for
+// several orgs never share a proxy. It also ensures that we can still proxy our tests by directly setting
+// internalProxy package variable
 func getExternalContactsOrganizationProxy(clientConfig *platformclientv2.Configuration) *externalContactsOrganizationProxy {
-	if internalProxy == nil {
-		internalProxy = newExternalContactsOrganizationProxy(clientConfig)
+	if internalProxy != nil {
+		return internalProxy
 	}
-
-	return internalProxy
+	return newExternalContactsOrganizationProxy(clientConfig)
 }
 
 // createExternalContactsOrganization creates a Genesys Cloud external contacts organization
@@ -165,7 +166,7 @@ func getExternalContactsOrganizationIdByNameFn(ctx context.Context, p *externalC
 
 // getExternalContactsOrganizationByIdFn is an implementation of the function to get a Genesys Cloud external contacts organization by Id
 func getExternalContactsOrganizationByIdFn(ctx context.Context, p *externalContactsOrganizationProxy, id string) (externalContactsOrganization *platformclientv2.Externalorganization, apiResponse *platformclientv2.APIResponse, err error) {
-	if externalOrganization := rc.GetCacheItem(p.externalOrganizationCache, id); externalOrganization != nil {
+	if externalOrganization := rc.GetCacheItem(ctx, p.externalOrganizationCache, id); externalOrganization != nil {
 		return externalOrganization, nil, nil
 	}
 	return p.externalContactsApi.GetExternalcontactsOrganization(id, []string{}, false)
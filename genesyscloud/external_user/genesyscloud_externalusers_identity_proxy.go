@@ -12,9 +12,11 @@ import (
 	"github.com/mypurecloud/platform-client-sdk-go/v152/platformclientv2"
 )
 
-// internalProxy holds a proxy instance that can be used throughout the package
+// internalProxy holds a proxy instance that unit tests can set to replace the proxy of the package
 var internalProxy *externalUserIdentityProxy
 
+var externalUserIdentityCache = rc.NewResourceCache[platformclientv2.Userexternalidentifier]()
+
 // Type definitions for each func on our proxy so we can easily mock them out later
 type createExternalUserIdentityFunc func(ctx context.Context, p *externalUserIdentityProxy, userId string, externalIdentity platformclientv2.Userexternalidentifier) (*platformclientv2.Userexternalidentifier, *platformclientv2.APIResponse, error)
 type getAllExternalUserIdentityFunc func(ctx context.Context, p *externalUserIdentityProxy, userId string) (*[]platformclientv2.Userexternalidentifier, *platformclientv2.APIResponse, error)
@@ -34,7 +36,6 @@ type externalUserIdentityProxy struct {
 
 func newExternalUserIdentityProxy(clientConfig *platformclientv2.Configuration) *externalUserIdentityProxy {
 	api := platformclientv2.NewUsersApiWithConfig(clientConfig)
-	externalUserIdentityCache := rc.NewResourceCache[platformclientv2.Userexternalidentifier]()
 	return &externalUserIdentityProxy{
 		clientConfig:                    clientConfig,
 		externalUserApi:                 api,
@@ -47,11 +48,10 @@ func newExternalUserIdentityProxy(clientConfig *platformclientv2.Configuration)
 }
 
 func getExternalUserIdentityProxy(clientConfig *platformclientv2.Configuration) *externalUserIdentityProxy {
-	if internalProxy == nil {
-		internalProxy = newExternalUserIdentityProxy(clientConfig)
+	if internalProxy != nil {
+		return internalProxy
 	}
-
-	return internalProxy
+	return newExternalUserIdentityProxy(clientConfig)
 }
 
 func (p *externalUserIdentityProxy) createExternalUserIdentity(ctx context.Context, userId string, externalIdentity platformclientv2.Userexternalidentifier) (*platformclientv2.Userexternalidentifier, *platformclientv2.APIResponse, error) {
@@ -92,7 +92,7 @@ func getAllExternalUserIdentityFn(ctx context.Context, p *externalUserIdentityPr
 }
 
 func getExternalUserIdentityByIdFn(ctx context.Context, p *externalUserIdentityProxy, userId, authorityName, externalKey string) (*platformclientv2.Userexternalidentifier, *platformclientv2.APIResponse, error) {
-	if externalId := rc.GetCacheItem(p.externalUserIdentityCache, createCompoundKey(userId, authorityName, externalKey)); externalId != nil {
+	if externalId := rc.GetCacheItem(ctx, p.externalUserIdentityCache, createCompoundKey(userId, authorityName, externalKey)); externalId != nil {
 		return externalId, nil, nil
 	}
 	externalIdList, response, err := getAllExternalUserIdentityFn(ctx, p, userId)
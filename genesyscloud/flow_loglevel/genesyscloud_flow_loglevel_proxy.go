@@ -28,7 +28,7 @@ Each proxy implementation:
 
 */
 
-// internalProxy holds a proxy instance that can be used throughout the package
+// internalProxy holds a proxy instance that unit tests can set to replace the proxy of the package
 var internalProxy *flowLogLevelProxy
 
 // Type definitions for each func on our proxy so we can easily mock them out later
@@ -63,14 +63,14 @@ func newFlowLogLevelProxy(clientConfig *platformclientv2.Configuration) *flowLog
 	}
 }
 
-// getFlowLogLevelProxy acts as a singleton to for the internalProxy.  It also ensures
-// that we can still proxy our tests by directly setting internalProxy package variable
+// getFlowLogLevelProxy returns a new proxy for the client config of every call, so provider configurations for
+// several orgs never share a proxy. It also ensures that we can still proxy our tests by directly setting
+// internalProxy package variable
 func getFlowLogLevelProxy(clientConfig *platformclientv2.Configuration) *flowLogLevelProxy {
-	if internalProxy == nil {
-		internalProxy = newFlowLogLevelProxy(clientConfig)
+	if internalProxy != nil {
+		return internalProxy
 	}
-
-	return internalProxy
+	return newFlowLogLevelProxy(clientConfig)
 }
 
 // getAllFlowLogLevels retrieves all Genesys Cloud Flow Log Levels
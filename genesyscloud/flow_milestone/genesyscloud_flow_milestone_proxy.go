@@ -14,7 +14,7 @@ with the Genesys Cloud SDK. We use composition here for each function on the pro
 out during testing.
 */
 
-// internalProxy holds a proxy instance that can be used throughout the package
+// internalProxy holds a proxy instance that unit tests can set to replace the proxy of the package
 var internalProxy *flowMilestoneProxy
 
 // Type definitions for each func on our proxy so we can easily mock them out later
@@ -52,13 +52,14 @@ func newFlowMilestoneProxy(clientConfig *platformclientv2.Configuration) *flowMi
 	}
 }
 
-// getFlowMilestoneProxy acts as a singleton to for the internalProxy.  It also ensures
-// that we can still proxy our tests by directly setting internalProxy package variable
+// getFlowMilestoneProxy returns a new proxy for the client config of every call, so provider configurations for
+// several orgs never share a proxy. It also ensures that we can still proxy our tests by directly setting
+// internalProxy package variable
 func getFlowMilestoneProxy(clientConfig *platformclientv2.Configuration) *flowMilestoneProxy {
-	if internalProxy == nil {
-		internalProxy = newFlowMilestoneProxy(clientConfig)
+	if internalProxy != nil {
+		return internalProxy
 	}
-	return internalProxy
+	return newFlowMilestoneProxy(clientConfig)
 }
 
 // createFlowMilestone creates a Genesys Cloud flow milestone
@@ -14,7 +14,7 @@ with the Genesys Cloud SDK. We use composition here for each function on the pro
 out during testing.
 */
 
-// internalProxy holds a proxy instance that can be used throughout the package
+// internalProxy holds a proxy instance that unit tests can set to replace the proxy of the package
 var internalProxy *flowOutcomeProxy
 
 // Type definitions for each func on our proxy so we can easily mock them out later
@@ -49,13 +49,14 @@ func newFlowOutcomeProxy(clientConfig *platformclientv2.Configuration) *flowOutc
 	}
 }
 
-// getFlowOutcomeProxy acts as a singleton to for the internalProxy.  It also ensures
-// that we can still proxy our tests by directly setting internalProxy package variable
+// getFlowOutcomeProxy returns a new proxy for the client config of every call, so provider configurations for
+// several orgs never share a proxy. It also ensures that we can still proxy our tests by directly setting
+// internalProxy package variable
 func getFlowOutcomeProxy(clientConfig *platformclientv2.Configuration) *flowOutcomeProxy {
-	if internalProxy == nil {
-		internalProxy = newFlowOutcomeProxy(clientConfig)
+	if internalProxy != nil {
+		return internalProxy
 	}
-	return internalProxy
+	return newFlowOutcomeProxy(clientConfig)
 }
 
 // createFlowOutcome creates a Genesys Cloud flow outcome
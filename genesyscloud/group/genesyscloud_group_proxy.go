@@ -110,8 +110,12 @@ func deleteGroupFn(_ context.Context, p *groupProxy, id string) (*platformclient
 	return nil, nil
 }
 
-func getGroupByIdFn(_ context.Context, p *groupProxy, id string) (*platformclientv2.Group, *platformclientv2.APIResponse, error) {
-	group := rc.GetCacheItem(p.groupCache, id)
+func getGroupByIdFn(ctx context.Context, p *groupProxy, id string) (*platformclientv2.Group, *platformclientv2.APIResponse, error) {
+	rc.HydrateCache(ctx, p.groupCache, func(ctx context.Context) error {
+		_, _, err := p.getAllGroups(ctx)
+		return err
+	})
+	group := rc.GetCacheItem(ctx, p.groupCache, id)
 	if group != nil {
 		return group, nil, nil
 	}
@@ -13,7 +13,7 @@ with the Genesys Cloud SDK. We use composition here for each function on the pro
 out during testing.
 */
 
-// internalProxy holds a proxy instance that can be used throughout the package
+// internalProxy holds a proxy instance that unit tests can set to replace the proxy of the package
 var internalProxy *idpGsuiteProxy
 
 // Type definitions for each func on our proxy so we can easily mock them out later
@@ -42,14 +42,14 @@ func newIdpGsuiteProxy(clientConfig *platformclientv2.Configuration) *idpGsuiteP
 	}
 }
 
-// getIdpGsuiteProxy acts as a singleton to for the internalProxy.  It also ensures
-// that we can still proxy our tests by directly setting internalProxy package variable
+// getIdpGsuiteProxy returns a new proxy for the client config of every call, so provider configurations for
+// several orgs never share a proxy. It also ensures that we can still proxy our tests by directly setting
+// internalProxy package variable
 func getIdpGsuiteProxy(clientConfig *platformclientv2.Configuration) *idpGsuiteProxy {
-	if internalProxy == nil {
-		internalProxy = newIdpGsuiteProxy(clientConfig)
+	if internalProxy != nil {
+		return internalProxy
 	}
-
-	return internalProxy
+	return newIdpGsuiteProxy(clientConfig)
 }
 
 // getIdpGsuite retrieves all Genesys Cloud idp gsuite
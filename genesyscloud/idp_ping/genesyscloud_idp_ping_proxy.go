@@ -13,7 +13,7 @@ with the Genesys Cloud SDK. We use composition here for each function on the pro
 out during testing.
 */
 
-// internalProxy holds a proxy instance that can be used throughout the package
+// internalProxy holds a proxy instance that unit tests can set to replace the proxy of the package
 var internalProxy *idpPingProxy
 
 // Type definitions for each func on our proxy so we can easily mock them out later
@@ -42,14 +42,14 @@ func newIdpPingProxy(clientConfig *platformclientv2.Configuration) *idpPingProxy
 	}
 }
 
-// getIdpPingProxy acts as a singleton to for the internalProxy.  It also ensures
-// that we can still proxy our tests by directly setting internalProxy package variable
+// getIdpPingProxy returns a new proxy for the client config of every call, so provider configurations for
+// several orgs never share a proxy. It also ensures that we can still proxy our tests by directly setting
+// internalProxy package variable
 func getIdpPingProxy(clientConfig *platformclientv2.Configuration) *idpPingProxy {
-	if internalProxy == nil {
-		internalProxy = newIdpPingProxy(clientConfig)
+	if internalProxy != nil {
+		return internalProxy
 	}
-
-	return internalProxy
+	return newIdpPingProxy(clientConfig)
 }
 
 // getIdpPing retrieves all Genesys Cloud idp ping
@@ -12,7 +12,7 @@ with the Genesys Cloud SDK. We use composition here for each function on the pro
 out during testing.
 */
 
-// internalProxy holds a proxy instance that can be used throughout the package
+// internalProxy holds a proxy instance that unit tests can set to replace the proxy of the package
 var internalProxy *idpSalesforceProxy
 
 // Type definitions for each func on our proxy so we can easily mock them out later
@@ -41,14 +41,14 @@ func newIdpSalesforceProxy(clientConfig *platformclientv2.Configuration) *idpSal
 	}
 }
 
-// getIdpSalesforceProxy acts as a singleton to for the internalProxy.  It also ensures
-// that we can still proxy our tests by directly setting internalProxy package variable
+// getIdpSalesforceProxy returns a new proxy for the client config of every call, so provider configurations for
+// several orgs never share a proxy. It also ensures that we can still proxy our tests by directly setting
+// internalProxy package variable
 func getIdpSalesforceProxy(clientConfig *platformclientv2.Configuration) *idpSalesforceProxy {
-	if internalProxy == nil {
-		internalProxy = newIdpSalesforceProxy(clientConfig)
+	if internalProxy != nil {
+		return internalProxy
 	}
-
-	return internalProxy
+	return newIdpSalesforceProxy(clientConfig)
 }
 
 // getIdpSalesforce returns a single Genesys Cloud idp salesforce
@@ -28,7 +28,7 @@ Each proxy implementation:
 
 */
 
-// internalProxy holds a proxy instance that can be used throughout the package
+// internalProxy holds a proxy instance that unit tests can set to replace the proxy of the package
 var internalProxy *integrationsProxy
 
 // Type definitions for each func on our proxy so we can easily mock them out later
@@ -72,13 +72,14 @@ func newIntegrationsProxy(clientConfig *platformclientv2.Configuration) *integra
 	}
 }
 
-// getIntegrationsProxy acts as a singleton to for the internalProxy.  It also ensures
-// that we can still proxy our tests by directly setting internalProxy package variable
+// getIntegrationsProxy returns a new proxy for the client config of every call, so provider configurations for
+// several orgs never share a proxy. It also ensures that we can still proxy our tests by directly setting
+// internalProxy package variable
 func getIntegrationsProxy(clientConfig *platformclientv2.Configuration) *integrationsProxy {
-	if internalProxy == nil {
-		internalProxy = newIntegrationsProxy(clientConfig)
+	if internalProxy != nil {
+		return internalProxy
 	}
-	return internalProxy
+	return newIntegrationsProxy(clientConfig)
 }
 
 // getAllIntegrations retrieves all Genesys Cloud Integrations
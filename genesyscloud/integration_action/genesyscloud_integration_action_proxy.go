@@ -40,7 +40,7 @@ the 'Items' property to define the 'object' schema it allows. Since it's impossi
 helper methods and types are created to invoke the APIs with Genesys Cloud.
 */
 
-// internalProxy holds a proxy instance that can be used throughout the package
+// internalProxy holds a proxy instance that unit tests can set to replace the proxy of the package
 var internalProxy *integrationActionsProxy
 
 // Type definitions for each func on our proxy so we can easily mock them out later
@@ -81,13 +81,14 @@ func newIntegrationActionsProxy(clientConfig *platformclientv2.Configuration) *i
 	}
 }
 
-// getIntegrationActionsProxy acts as a singleton to for the internalProxy.  It also ensures
-// that we can still proxy our tests by directly setting internalProxy package variable
+// getIntegrationActionsProxy returns a new proxy for the client config of every call, so provider configurations for
+// several orgs never share a proxy. It also ensures that we can still proxy our tests by directly setting
+// internalProxy package variable
 func getIntegrationActionsProxy(clientConfig *platformclientv2.Configuration) *integrationActionsProxy {
-	if internalProxy == nil {
-		internalProxy = newIntegrationActionsProxy(clientConfig)
+	if internalProxy != nil {
+		return internalProxy
 	}
-	return internalProxy
+	return newIntegrationActionsProxy(clientConfig)
 }
 
 // getAllIntegrationActions retrieves all Genesys Cloud Integration Actions
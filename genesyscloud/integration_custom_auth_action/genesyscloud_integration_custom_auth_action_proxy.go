@@ -28,7 +28,7 @@ Each proxy implementation:
 7.  Function implementations for each function type definition.
 */
 
-// internalProxy holds a proxy instance that can be used throughout the package
+// internalProxy holds a proxy instance that unit tests can set to replace the proxy of the package
 var internalProxy *customAuthActionsProxy
 
 // Type definitions for each func on our proxy so we can easily mock them out later
@@ -69,13 +69,14 @@ func newCustomAuthActionsProxy(clientConfig *platformclientv2.Configuration) *cu
 	}
 }
 
-// getCustomAuthActionsProxy acts as a singleton to for the internalProxy.  It also ensures
-// that we can still proxy our tests by directly setting internalProxy package variable
+// getCustomAuthActionsProxy returns a new proxy for the client config of every call, so provider configurations for
+// several orgs never share a proxy. It also ensures that we can still proxy our tests by directly setting
+// internalProxy package variable
 func getCustomAuthActionsProxy(clientConfig *platformclientv2.Configuration) *customAuthActionsProxy {
-	if internalProxy == nil {
-		internalProxy = newCustomAuthActionsProxy(clientConfig)
+	if internalProxy != nil {
+		return internalProxy
 	}
-	return internalProxy
+	return newCustomAuthActionsProxy(clientConfig)
 }
 
 // getAllIntegrationCustomAuthActions retrieves all Genesys Cloud Integration Custom Auth Actions
@@ -15,9 +15,11 @@ with the Genesys Cloud SDK. We use composition here for each function on the pro
 out during testing.
 */
 
-// internalProxy holds a proxy instance that can be used throughout the package
+// internalProxy holds a proxy instance that unit tests can set to replace the proxy of the package
 var internalProxy *integrationFacebookProxy
 
+var facebookCache = rc.NewResourceCache[platformclientv2.Facebookintegration]()
+
 // Type definitions for each func on our proxy so we can easily mock them out later
 type createIntegrationFacebookFunc func(ctx context.Context, p *integrationFacebookProxy, facebookIntegrationRequest *platformclientv2.Facebookintegrationrequest) (*platformclientv2.Facebookintegration, *platformclientv2.APIResponse, error)
 type getAllIntegrationFacebookFunc func(ctx context.Context, p *integrationFacebookProxy) (*[]platformclientv2.Facebookintegration, *platformclientv2.APIResponse, error)
@@ -42,7 +44,6 @@ type integrationFacebookProxy struct {
 // newIntegrationFacebookProxy initializes the integration facebook proxy with all of the data needed to communicate with Genesys Cloud
 func newIntegrationFacebookProxy(clientConfig *platformclientv2.Configuration) *integrationFacebookProxy {
 	api := platformclientv2.NewConversationsApiWithConfig(clientConfig)
-	facebookCache := rc.NewResourceCache[platformclientv2.Facebookintegration]()
 	return &integrationFacebookProxy{
 		clientConfig:                       clientConfig,
 		conversationsApi:                   api,
@@ -56,14 +57,14 @@ func newIntegrationFacebookProxy(clientConfig *platformclientv2.Configuration) *
 	}
 }
 
-// getIntegrationFacebookProxy acts as a singleton to for the internalProxy.  It also ensures
-// that we can still proxy our tests by directly setting internalProxy package variable
+// getIntegrationFacebookProxy returns a new proxy for the client config of every call, so provider configurations for
+// several orgs never share a proxy. It also ensures that we can still proxy our tests by directly setting
+// internalProxy package variable
 func getIntegrationFacebookProxy(clientConfig *platformclientv2.Configuration) *integrationFacebookProxy {
-	if internalProxy == nil {
-		internalProxy = newIntegrationFacebookProxy(clientConfig)
+	if internalProxy != nil {
+		return internalProxy
 	}
-
-	return internalProxy
+	return newIntegrationFacebookProxy(clientConfig)
 }
 
 // createIntegrationFacebook creates a Genesys Cloud integration facebook
@@ -162,7 +163,7 @@ func getIntegrationFacebookIdByNameFn(ctx context.Context, p *integrationFaceboo
 
 // getIntegrationFacebookByIdFn is an implementation of the function to get a Genesys Cloud integration facebook by Id
 func getIntegrationFacebookByIdFn(ctx context.Context, p *integrationFacebookProxy, id string) (integrationFacebook *platformclientv2.Facebookintegration, response *platformclientv2.APIResponse, err error) {
-	facebookReq := rc.GetCacheItem(p.facebookCache, id)
+	facebookReq := rc.GetCacheItem(ctx, p.facebookCache, id)
 	if facebookReq != nil {
 		return facebookReq, nil, nil
 	}
@@ -18,9 +18,11 @@ to isolate and test individual functions more easily. For testing purposes, we c
 simulate these smaller parts, known as stubs, to ensure that each function behaves correctly in different scenarios.
 */
 
-// internalProxy holds a proxy instance that can be used throughout the package
+// internalProxy holds a proxy instance that unit tests can set to replace the proxy of the package
 var internalProxy *journeyActionMapProxy
 
+var actionMapCache = rc.NewResourceCache[platformclientv2.Actionmap]()
+
 // Type definitions for each func on our proxy so we can easily mock them out later
 type createJourneyActionMapFunc func(ctx context.Context, p *journeyActionMapProxy, actionMap *platformclientv2.Actionmap) (*platformclientv2.Actionmap, *platformclientv2.APIResponse, error)
 type getAllJourneyActionMapsFunc func(ctx context.Context, p *journeyActionMapProxy) (*[]platformclientv2.Actionmap, *platformclientv2.APIResponse, error)
@@ -56,7 +58,6 @@ seamlessly with the Genesys Cloud platform.
 */
 func newJourneyActionMapProxy(clientConfig *platformclientv2.Configuration) *journeyActionMapProxy {
 	api := platformclientv2.NewJourneyApiWithConfig(clientConfig)
-	actionMapCache := rc.NewResourceCache[platformclientv2.Actionmap]()
 
 	return &journeyActionMapProxy{
 		clientConfig:                    clientConfig,
@@ -72,17 +73,16 @@ func newJourneyActionMapProxy(clientConfig *platformclientv2.Configuration) *jou
 }
 
 /*
-The function getJourneyActionMapProxy serves a dual purpose: first, it functions as a singleton for
-the internalProxy, meaning it ensures that only one instance of the internalProxy exists. Second,
-it enables us to proxy our tests by allowing us to directly set the internalProxy package variable.
-This ensures consistency and control in managing the internalProxy across our codebase, while also
-facilitating efficient testing by providing a straightforward way to substitute the proxy for testing purposes.
+The function getJourneyActionMapProxy serves a dual purpose: first, it returns a new proxy for the client config of every
+call, so provider configurations for several orgs never share a proxy. Second, it enables us to proxy our tests by
+allowing us to directly set the internalProxy package variable, which facilitates efficient testing by providing a
+straightforward way to substitute the proxy for testing purposes.
 */
 func getJourneyActionMapProxy(clientConfig *platformclientv2.Configuration) *journeyActionMapProxy {
-	if internalProxy == nil {
-		internalProxy = newJourneyActionMapProxy(clientConfig)
+	if internalProxy != nil {
+		return internalProxy
 	}
-	return internalProxy
+	return newJourneyActionMapProxy(clientConfig)
 }
 
 // createJourneyActionMap creates a Genesys Cloud journey action map
@@ -102,7 +102,7 @@ func (p *journeyActionMapProxy) getJourneyActionMapIdByName(ctx context.Context,
 
 // getJourneyActionMapById returns a single Genesys Cloud journey action map by Id
 func (p *journeyActionMapProxy) getJourneyActionMapById(ctx context.Context, id string) (actionMap *platformclientv2.Actionmap, response *platformclientv2.APIResponse, err error) {
-	if actionMap := rc.GetCacheItem(p.actionMapCache, id); actionMap != nil {
+	if actionMap := rc.GetCacheItem(ctx, p.actionMapCache, id); actionMap != nil {
 		return actionMap, nil, nil
 	}
 	return p.getJourneyActionMapByIdAttr(ctx, p, id)
@@ -17,9 +17,11 @@ to isolate and test individual functions more easily. For testing purposes, we c
 simulate these smaller parts, known as stubs, to ensure that each function behaves correctly in different scenarios.
 */
 
-// internalProxy holds a proxy instance that can be used throughout the package
+// internalProxy holds a proxy instance that unit tests can set to replace the proxy of the package
 var internalProxy *journeyActionTemplateProxy
 
+var templateCache = rc.NewResourceCache[platformclientv2.Actiontemplate]()
+
 // Type definitions for each func on our proxy so we can easily mock them out later
 type createJourneyActionTemplateFunc func(ctx context.Context, p *journeyActionTemplateProxy, template *platformclientv2.Actiontemplate) (*platformclientv2.Actiontemplate, *platformclientv2.APIResponse, error)
 type getAllJourneyActionTemplatesFunc func(ctx context.Context, p *journeyActionTemplateProxy) (*[]platformclientv2.Actiontemplate, *platformclientv2.APIResponse, error)
@@ -55,7 +57,6 @@ seamlessly with the Genesys Cloud platform.
 */
 func newJourneyActionTemplateProxy(clientConfig *platformclientv2.Configuration) *journeyActionTemplateProxy {
 	api := platformclientv2.NewJourneyApiWithConfig(clientConfig)
-	templateCache := rc.NewResourceCache[platformclientv2.Actiontemplate]()
 
 	return &journeyActionTemplateProxy{
 		clientConfig:                         clientConfig,
@@ -71,17 +72,16 @@ func newJourneyActionTemplateProxy(clientConfig *platformclientv2.Configuration)
 }
 
 /*
-The function getJourneyActionTemplateProxy serves a dual purpose: first, it functions as a singleton for
-the internalProxy, meaning it ensures that only one instance of the internalProxy exists. Second,
-it enables us to proxy our tests by allowing us to directly set the internalProxy package variable.
-This ensures consistency and control in managing the internalProxy across our codebase, while also
-facilitating efficient testing by providing a straightforward way to substitute the proxy for testing purposes.
+The function getJourneyActionTemplateProxy serves a dual purpose: first, it returns a new proxy for the client config of every
+call, so provider configurations for several orgs never share a proxy. Second, it enables us to proxy our tests by
+allowing us to directly set the internalProxy package variable, which facilitates efficient testing by providing a
+straightforward way to substitute the proxy for testing purposes.
 */
 func getJourneyActionTemplateProxy(clientConfig *platformclientv2.Configuration) *journeyActionTemplateProxy {
-	if internalProxy == nil {
-		internalProxy = newJourneyActionTemplateProxy(clientConfig)
+	if internalProxy != nil {
+		return internalProxy
 	}
-	return internalProxy
+	return newJourneyActionTemplateProxy(clientConfig)
 }
 
 // createJourneyActionTemplate creates a Genesys Cloud journey action template
@@ -101,7 +101,7 @@ func (p *journeyActionTemplateProxy) getJourneyActionTemplateIdByName(ctx contex
 
 // getJourneyActionTemplateById returns a single Genesys Cloud journey action template by Id
 func (p *journeyActionTemplateProxy) getJourneyActionTemplateById(ctx context.Context, id string) (template *platformclientv2.Actiontemplate, response *platformclientv2.APIResponse, err error) {
-	if template := rc.GetCacheItem(p.templateCache, id); template != nil {
+	if template := rc.GetCacheItem(ctx, p.templateCache, id); template != nil {
 		return template, nil, nil
 	}
 	return p.getJourneyActionTemplateByIdAttr(ctx, p, id)
@@ -17,9 +17,11 @@ to isolate and test individual functions more easily. For testing purposes, we c
 simulate these smaller parts, known as stubs, to ensure that each function behaves correctly in different scenarios.
 */
 
-// internalProxy holds a proxy instance that can be used throughout the package
+// internalProxy holds a proxy instance that unit tests can set to replace the proxy of the package
 var internalProxy *journeyOutcomeProxy
 
+var outcomeCache = rc.NewResourceCache[platformclientv2.Outcome]()
+
 // Type definitions for each func on our proxy so we can easily mock them out later
 type createJourneyOutcomeFunc func(ctx context.Context, p *journeyOutcomeProxy, outcome *platformclientv2.Outcomerequest) (*platformclientv2.Outcome, *platformclientv2.APIResponse, error)
 type getAllJourneyOutcomesFunc func(ctx context.Context, p *journeyOutcomeProxy) (*[]platformclientv2.Outcome, *platformclientv2.APIResponse, error)
@@ -55,7 +57,6 @@ seamlessly with the Genesys Cloud platform.
 */
 func newJourneyOutcomeProxy(clientConfig *platformclientv2.Configuration) *journeyOutcomeProxy {
 	api := platformclientv2.NewJourneyApiWithConfig(clientConfig)
-	outcomeCache := rc.NewResourceCache[platformclientv2.Outcome]()
 
 	return &journeyOutcomeProxy{
 		clientConfig:                  clientConfig,
@@ -71,17 +72,16 @@ func newJourneyOutcomeProxy(clientConfig *platformclientv2.Configuration) *journ
 }
 
 /*
-The function getJourneyOutcomeProxy serves a dual purpose: first, it functions as a singleton for
-the internalProxy, meaning it ensures that only one instance of the internalProxy exists. Second,
-it enables us to proxy our tests by allowing us to directly set the internalProxy package variable.
-This ensures consistency and control in managing the internalProxy across our codebase, while also
-facilitating efficient testing by providing a straightforward way to substitute the proxy for testing purposes.
+The function getJourneyOutcomeProxy serves a dual purpose: first, it returns a new proxy for the client config of every
+call, so provider configurations for several orgs never share a proxy. Second, it enables us to proxy our tests by
+allowing us to directly set the internalProxy package variable, which facilitates efficient testing by providing a
+straightforward way to substitute the proxy for testing purposes.
 */
 func getJourneyOutcomeProxy(clientConfig *platformclientv2.Configuration) *journeyOutcomeProxy {
-	if internalProxy == nil {
-		internalProxy = newJourneyOutcomeProxy(clientConfig)
+	if internalProxy != nil {
+		return internalProxy
 	}
-	return internalProxy
+	return newJourneyOutcomeProxy(clientConfig)
 }
 
 // createJourneyOutcome creates a Genesys Cloud journey outcome
@@ -101,7 +101,7 @@ func (p *journeyOutcomeProxy) getJourneyOutcomeIdByName(ctx context.Context, nam
 
 // getJourneyOutcomeById returns a single Genesys Cloud journey outcome by Id
 func (p *journeyOutcomeProxy) getJourneyOutcomeById(ctx context.Context, id string) (outcome *platformclientv2.Outcome, response *platformclientv2.APIResponse, err error) {
-	if outcome := rc.GetCacheItem(p.outcomeCache, id); outcome != nil {
+	if outcome := rc.GetCacheItem(ctx, p.outcomeCache, id); outcome != nil {
 		return outcome, nil, nil
 	}
 	return p.getJourneyOutcomeByIdAttr(ctx, p, id)
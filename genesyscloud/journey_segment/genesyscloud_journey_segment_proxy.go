@@ -17,9 +17,11 @@ to isolate and test individual functions more easily. For testing purposes, we c
 simulate these smaller parts, known as stubs, to ensure that each function behaves correctly in different scenarios.
 */
 
-// internalProxy holds a proxy instance that can be used throughout the package
+// internalProxy holds a proxy instance that unit tests can set to replace the proxy of the package
 var internalProxy *journeySegmentProxy
 
+var segmentCache = rc.NewResourceCache[platformclientv2.Journeysegment]()
+
 // Type definitions for each func on our proxy so we can easily mock them out later
 type createJourneySegmentFunc func(ctx context.Context, p *journeySegmentProxy, segment *platformclientv2.Journeysegmentrequest) (*platformclientv2.Journeysegment, *platformclientv2.APIResponse, error)
 type getAllJourneySegmentsFunc func(ctx context.Context, p *journeySegmentProxy) (*[]platformclientv2.Journeysegment, *platformclientv2.APIResponse, error)
@@ -55,7 +57,6 @@ seamlessly with the Genesys Cloud platform.
 */
 func newJourneySegmentProxy(clientConfig *platformclientv2.Configuration) *journeySegmentProxy {
 	api := platformclientv2.NewJourneyApiWithConfig(clientConfig)
-	segmentCache := rc.NewResourceCache[platformclientv2.Journeysegment]()
 
 	return &journeySegmentProxy{
 		clientConfig:                  clientConfig,
@@ -71,17 +72,16 @@ func newJourneySegmentProxy(clientConfig *platformclientv2.Configuration) *journ
 }
 
 /*
-The function getJourneySegmentProxy serves a dual purpose: first, it functions as a singleton for
-the internalProxy, meaning it ensures that only one instance of the internalProxy exists. Second,
-it enables us to proxy our tests by allowing us to directly set the internalProxy package variable.
-This ensures consistency and control in managing the internalProxy across our codebase, while also
-facilitating efficient testing by providing a straightforward way to substitute the proxy for testing purposes.
+The function getJourneySegmentProxy serves a dual purpose: first, it returns a new proxy for the client config of every
+call, so provider configurations for several orgs never share a proxy. Second, it enables us to proxy our tests by
+allowing us to directly set the internalProxy package variable, which facilitates efficient testing by providing a
+straightforward way to substitute the proxy for testing purposes.
 */
 func getJourneySegmentProxy(clientConfig *platformclientv2.Configuration) *journeySegmentProxy {
-	if internalProxy == nil {
-		internalProxy = newJourneySegmentProxy(clientConfig)
+	if internalProxy != nil {
+		return internalProxy
 	}
-	return internalProxy
+	return newJourneySegmentProxy(clientConfig)
 }
 
 // createJourneySegment creates a Genesys Cloud journey segment
@@ -101,7 +101,7 @@ func (p *journeySegmentProxy) getJourneySegmentIdByName(ctx context.Context, nam
 
 // getJourneySegmentById returns a single Genesys Cloud journey segment by Id
 func (p *journeySegmentProxy) getJourneySegmentById(ctx context.Context, id string) (segment *platformclientv2.Journeysegment, response *platformclientv2.APIResponse, err error) {
-	if segment := rc.GetCacheItem(p.segmentCache, id); segment != nil {
+	if segment := rc.GetCacheItem(ctx, p.segmentCache, id); segment != nil {
 		return segment, nil, nil
 	}
 	return p.getJourneySegmentByIdAttr(ctx, p, id)
@@ -16,9 +16,11 @@ out during testing.
 
 */
 
-// internalProxy holds a proxy instance that can be used throughout the package
+// internalProxy holds a proxy instance that unit tests can set to replace the proxy of the package
 var internalProxy *journeyViewScheduleProxy
 
+var journeyViewScheduleCache = rc.NewResourceCache[platformclientv2.Journeyviewschedule]()
+
 // Type definitions for each func on our proxy so we can easily mock them out later
 type getJourneyViewScheduleByViewIdFunc func(ctx context.Context, p *journeyViewScheduleProxy, viewId string) (*platformclientv2.Journeyviewschedule, *platformclientv2.APIResponse, error)
 type createJourneyViewScheduleFunc func(ctx context.Context, p *journeyViewScheduleProxy, viewId string, journeyViewSchedule *platformclientv2.Journeyviewschedule) (*platformclientv2.Journeyviewschedule, *platformclientv2.APIResponse, error)
@@ -41,7 +43,6 @@ type journeyViewScheduleProxy struct {
 // newJourneyViewScheduleProxy initializes the journey view schedule proxy with all the data needed to communicate with Genesys Cloud
 func newJourneyViewScheduleProxy(clientConfig *platformclientv2.Configuration) *journeyViewScheduleProxy {
 	api := platformclientv2.NewJourneyApiWithConfig(clientConfig)
-	journeyViewScheduleCache := rc.NewResourceCache[platformclientv2.Journeyviewschedule]()
 	return &journeyViewScheduleProxy{
 		clientConfig:                       clientConfig,
 		journeyViewsApi:                    api,
@@ -54,13 +55,14 @@ func newJourneyViewScheduleProxy(clientConfig *platformclientv2.Configuration) *
 	}
 }
 
-// getJourneyViewScheduleProxy acts as a singleton to for the internalProxy.  It also ensures
-// that we can still proxy our tests by directly setting internalProxy package variable
+// getJourneyViewScheduleProxy returns a new proxy for the client config of every call, so provider configurations for
+// several orgs never share a proxy. It also ensures that we can still proxy our tests by directly setting
+// internalProxy package variable
 func getJourneyViewScheduleProxy(clientConfig *platformclientv2.Configuration) *journeyViewScheduleProxy {
-	if internalProxy == nil {
-		internalProxy = newJourneyViewScheduleProxy(clientConfig)
+	if internalProxy != nil {
+		return internalProxy
 	}
-	return internalProxy
+	return newJourneyViewScheduleProxy(clientConfig)
 }
 
 func (p *journeyViewScheduleProxy) getJourneyViewScheduleByViewId(ctx context.Context, viewId string) (*platformclientv2.Journeyviewschedule, *platformclientv2.APIResponse, error) {
@@ -85,7 +87,7 @@ func (p *journeyViewScheduleProxy) getAllJourneyViewSchedule(ctx context.Context
 
 func getJourneyViewScheduleByViewIdFn(ctx context.Context, p *journeyViewScheduleProxy, viewId string) (*platformclientv2.Journeyviewschedule, *platformclientv2.APIResponse, error) {
 	// Check the cache first
-	journeyViewSchedule := rc.GetCacheItem(p.journeyViewScheduleCache, viewId)
+	journeyViewSchedule := rc.GetCacheItem(ctx, p.journeyViewScheduleCache, viewId)
 	if journeyViewSchedule != nil {
 		return journeyViewSchedule, nil, nil
 	}
@@ -12,6 +12,8 @@ import (
 
 var internalProxy *journeyViewsProxy
 
+var journeyViewCache = rc.NewResourceCache[platformclientv2.Journeyview]()
+
 type getAllJourneyViewsFunc func(ctx context.Context, p *journeyViewsProxy, name string) (*[]platformclientv2.Journeyview, *platformclientv2.APIResponse, error)
 type getJourneyViewByNameFunc func(ctx context.Context, p *journeyViewsProxy, name string) (string, *platformclientv2.APIResponse, error, bool)
 type getJourneyViewByViewIdFunc func(ctx context.Context, p *journeyViewsProxy, viewId string) (*platformclientv2.Journeyview, *platformclientv2.APIResponse, error)
@@ -33,7 +35,6 @@ type journeyViewsProxy struct {
 
 func newJourneyViewsProxy(clientConfig *platformclientv2.Configuration) *journeyViewsProxy {
 	api := platformclientv2.NewJourneyApiWithConfig(clientConfig)
-	journeyViewCache := rc.NewResourceCache[platformclientv2.Journeyview]()
 	return &journeyViewsProxy{
 		clientConfig:             clientConfig,
 		journeyViewsApi:          api,
@@ -48,10 +49,10 @@ func newJourneyViewsProxy(clientConfig *platformclientv2.Configuration) *journey
 }
 
 func getJourneyViewProxy(clientConfig *platformclientv2.Configuration) *journeyViewsProxy {
-	if internalProxy == nil {
-		internalProxy = newJourneyViewsProxy(clientConfig)
+	if internalProxy != nil {
+		return internalProxy
 	}
-	return internalProxy
+	return newJourneyViewsProxy(clientConfig)
 }
 
 func (p *journeyViewsProxy) getAllJourneyViews(ctx context.Context, name string) (*[]platformclientv2.Journeyview, *platformclientv2.APIResponse, error) {
@@ -83,9 +84,9 @@ func (p *journeyViewsProxy) deleteJourneyView(ctx context.Context, viewId string
 	return resp, nil
 }
 
-func getJourneyViewByViewIdFn(_ context.Context, p *journeyViewsProxy, viewId string) (*platformclientv2.Journeyview, *platformclientv2.APIResponse, error) {
+func getJourneyViewByViewIdFn(ctx context.Context, p *journeyViewsProxy, viewId string) (*platformclientv2.Journeyview, *platformclientv2.APIResponse, error) {
 	// Check the cache first
-	journeyView := rc.GetCacheItem(p.journeyViewCache, viewId)
+	journeyView := rc.GetCacheItem(ctx, p.journeyViewCache, viewId)
 	if journeyView != nil {
 		return journeyView, nil, nil
 	}
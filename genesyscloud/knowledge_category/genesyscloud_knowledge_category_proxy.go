@@ -11,6 +11,8 @@ import (
 
 var internalProxy *knowledgeCategoryProxy
 
+var knowledgeCategoryCache = rc.NewResourceCache[platformclientv2.Categoryresponse]()
+
 type getAllKnowledgebaseEntitiesFunc func(ctx context.Context, p *knowledgeCategoryProxy, published bool) (*[]platformclientv2.Knowledgebase, *platformclientv2.APIResponse, error)
 type getAllKnowledgeCategoryEntitiesFunc func(ctx context.Context, p *knowledgeCategoryProxy, knowledgeBase *platformclientv2.Knowledgebase, categoryName string) (*[]platformclientv2.Categoryresponse, *platformclientv2.APIResponse, error)
 type getKnowledgeKnowledgebaseCategoryFunc func(ctx context.Context, p *knowledgeCategoryProxy, knowledgeBaseId string, categoryId string) (*platformclientv2.Categoryresponse, *platformclientv2.APIResponse, error)
@@ -33,7 +35,6 @@ type knowledgeCategoryProxy struct {
 
 func newKnowledgeCategoryProxy(clientConfig *platformclientv2.Configuration) *knowledgeCategoryProxy {
 	api := platformclientv2.NewKnowledgeApiWithConfig(clientConfig)
-	knowledgeCategoryCache := rc.NewResourceCache[platformclientv2.Categoryresponse]()
 	return &knowledgeCategoryProxy{
 		clientConfig:                          clientConfig,
 		KnowledgeApi:                          api,
@@ -49,10 +50,10 @@ func newKnowledgeCategoryProxy(clientConfig *platformclientv2.Configuration) *kn
 }
 
 func GetKnowledgeCategoryProxy(clientConfig *platformclientv2.Configuration) *knowledgeCategoryProxy {
-	if internalProxy == nil {
-		internalProxy = newKnowledgeCategoryProxy(clientConfig)
+	if internalProxy != nil {
+		return internalProxy
 	}
-	return internalProxy
+	return newKnowledgeCategoryProxy(clientConfig)
 }
 
 func (p *knowledgeCategoryProxy) getAllKnowledgebaseEntities(ctx context.Context, published bool) (*[]platformclientv2.Knowledgebase, *platformclientv2.APIResponse, error) {
@@ -162,7 +163,7 @@ func getAllKnowledgeCategoryEntitiesFn(ctx context.Context, p *knowledgeCategory
 }
 
 func getKnowledgeKnowledgebaseCategoryFn(ctx context.Context, p *knowledgeCategoryProxy, knowledgeBaseId string, categoryId string) (*platformclientv2.Categoryresponse, *platformclientv2.APIResponse, error) {
-	if knowledgeCategory := rc.GetCacheItem(p.knowledgeCategoryCache, categoryId); knowledgeCategory != nil {
+	if knowledgeCategory := rc.GetCacheItem(ctx, p.knowledgeCategoryCache, categoryId); knowledgeCategory != nil {
 		return knowledgeCategory, nil, nil
 	}
 	return p.KnowledgeApi.GetKnowledgeKnowledgebaseCategory(knowledgeBaseId, categoryId)
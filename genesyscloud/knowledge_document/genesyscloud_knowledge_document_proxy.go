@@ -16,6 +16,12 @@ import (
 
 var internalProxy *knowledgeDocumentProxy
 
+var (
+	knowledgeDocumentCache = rc.NewResourceCache[platformclientv2.Knowledgedocumentresponse]()
+	knowledgeLabelCache    = rc.NewResourceCache[platformclientv2.Labelresponse]()
+	knowledgeCategoryCache = rc.NewResourceCache[platformclientv2.Categoryresponse]()
+)
+
 type getKnowledgeKnowledgebaseCategoryFunc func(ctx context.Context, p *knowledgeDocumentProxy, knowledgeBaseId string, categoryId string) (*platformclientv2.Categoryresponse, *platformclientv2.APIResponse, error)
 type getKnowledgeKnowledgebaseCategoriesFunc func(ctx context.Context, p *knowledgeDocumentProxy, knowledgeBaseId string, categoryName string) (*platformclientv2.Categoryresponselisting, *platformclientv2.APIResponse, error)
 type getKnowledgeKnowledgebaseLabelsFunc func(ctx context.Context, p *knowledgeDocumentProxy, knowledgeBaseId string, labelName string) (*platformclientv2.Labellisting, *platformclientv2.APIResponse, error)
@@ -49,9 +55,6 @@ type knowledgeDocumentProxy struct {
 
 func newKnowledgeDocumentProxy(clientConfig *platformclientv2.Configuration) *knowledgeDocumentProxy {
 	api := platformclientv2.NewKnowledgeApiWithConfig(clientConfig)
-	knowledgeDocumentCache := rc.NewResourceCache[platformclientv2.Knowledgedocumentresponse]()
-	knowledgeLabelCache := rc.NewResourceCache[platformclientv2.Labelresponse]()
-	knowledgeCategoryCache := rc.NewResourceCache[platformclientv2.Categoryresponse]()
 	return &knowledgeDocumentProxy{
 		clientConfig:                             clientConfig,
 		KnowledgeApi:                             api,
@@ -73,11 +76,10 @@ func newKnowledgeDocumentProxy(clientConfig *platformclientv2.Configuration) *kn
 }
 
 func GetKnowledgeDocumentProxy(clientConfig *platformclientv2.Configuration) *knowledgeDocumentProxy {
-	if internalProxy == nil {
-		internalProxy = newKnowledgeDocumentProxy(clientConfig)
+	if internalProxy != nil {
+		return internalProxy
 	}
-
-	return internalProxy
+	return newKnowledgeDocumentProxy(clientConfig)
 }
 
 func (p *knowledgeDocumentProxy) getKnowledgeKnowledgebaseCategory(ctx context.Context, knowledgeBaseId string, categoryId string) (*platformclientv2.Categoryresponse, *platformclientv2.APIResponse, error) {
@@ -126,7 +128,7 @@ func (p *knowledgeDocumentProxy) updateKnowledgeKnowledgebaseDocument(ctx contex
 
 func getKnowledgeKnowledgebaseCategoryFn(ctx context.Context, p *knowledgeDocumentProxy, knowledgeBaseId string, categoryId string) (*platformclientv2.Categoryresponse, *platformclientv2.APIResponse, error) {
 	id := fmt.Sprintf("%s,%s", knowledgeBaseId, categoryId)
-	if knowledgeCategory := rc.GetCacheItem(p.knowledgeCategoryCache, id); knowledgeCategory != nil {
+	if knowledgeCategory := rc.GetCacheItem(ctx, p.knowledgeCategoryCache, id); knowledgeCategory != nil {
 		return knowledgeCategory, nil, nil
 	}
 	return p.KnowledgeApi.GetKnowledgeKnowledgebaseCategory(knowledgeBaseId, categoryId)
@@ -145,7 +147,7 @@ func getKnowledgeKnowledgebaseLabelsFn(ctx context.Context, p *knowledgeDocument
 
 func getKnowledgeKnowledgebaseLabelFn(ctx context.Context, p *knowledgeDocumentProxy, knowledgeBaseId string, labelId string) (*platformclientv2.Labelresponse, *platformclientv2.APIResponse, error) {
 	id := fmt.Sprintf("%s,%s", knowledgeBaseId, labelId)
-	if knowledgeLabel := rc.GetCacheItem(p.knowledgeLabelCache, id); knowledgeLabel != nil {
+	if knowledgeLabel := rc.GetCacheItem(ctx, p.knowledgeLabelCache, id); knowledgeLabel != nil {
 		return knowledgeLabel, nil, nil
 	}
 	return p.KnowledgeApi.GetKnowledgeKnowledgebaseLabel(knowledgeBaseId, labelId)
@@ -153,7 +155,7 @@ func getKnowledgeKnowledgebaseLabelFn(ctx context.Context, p *knowledgeDocumentP
 
 func getKnowledgeKnowledgebaseDocumentFn(ctx context.Context, p *knowledgeDocumentProxy, knowledgeBaseId string, documentId string, expand []string, state string) (*platformclientv2.Knowledgedocumentresponse, *platformclientv2.APIResponse, error) {
 	id := fmt.Sprintf("%s,%s", knowledgeBaseId, documentId)
-	if knowledgeDocument := rc.GetCacheItem(p.knowledgeDocumentCache, id); knowledgeDocument != nil {
+	if knowledgeDocument := rc.GetCacheItem(ctx, p.knowledgeDocumentCache, id); knowledgeDocument != nil {
 		return knowledgeDocument, nil, nil
 	}
 	return p.KnowledgeApi.GetKnowledgeKnowledgebaseDocument(knowledgeBaseId, documentId, expand, state)
@@ -15,6 +15,8 @@ import (
 
 var internalProxy *variationRequestProxy
 
+var variationCache = rc.NewResourceCache[platformclientv2.Documentvariationresponse]()
+
 type createVariationFunc func(ctx context.Context, p *variationRequestProxy, documentVariationRequest *platformclientv2.Documentvariationrequest, knowledgeDocumentId, knowledgeBaseId string) (*platformclientv2.Documentvariationresponse, *platformclientv2.APIResponse, error)
 type getAllVariationsFunc func(ctx context.Context, p *variationRequestProxy, knowledgeBaseId, documentId, documentState string, expand []string) (*[]platformclientv2.Documentvariationresponse, *platformclientv2.APIResponse, error)
 type getVariationRequestByIdFunc func(ctx context.Context, p *variationRequestProxy, documentVariationId string, documentId string, knowledgeBaseId string, documentState string, expand []string) (*platformclientv2.Documentvariationresponse, *platformclientv2.APIResponse, error)
@@ -44,7 +46,6 @@ type variationRequestProxy struct {
 // newVariationRequestProxy initializes the variation request proxy with all of the data needed to communicate with Genesys Cloud
 func newVariationRequestProxy(clientConfig *platformclientv2.Configuration) *variationRequestProxy {
 	api := platformclientv2.NewKnowledgeApiWithConfig(clientConfig)
-	variationCache := rc.NewResourceCache[platformclientv2.Documentvariationresponse]()
 	return &variationRequestProxy{
 		clientConfig:                                     clientConfig,
 		knowledgeApi:                                     api,
@@ -62,10 +63,10 @@ func newVariationRequestProxy(clientConfig *platformclientv2.Configuration) *var
 }
 
 func getVariationRequestProxy(clientConfig *platformclientv2.Configuration) *variationRequestProxy {
-	if internalProxy == nil {
-		internalProxy = newVariationRequestProxy(clientConfig)
+	if internalProxy != nil {
+		return internalProxy
 	}
-	return internalProxy
+	return newVariationRequestProxy(clientConfig)
 }
 
 // CreateVariation creates a Genesys Cloud variation request
@@ -209,8 +210,8 @@ func getAllVariationsFn(_ context.Context, p *variationRequestProxy, knowledgeBa
 }
 
 // getVariationRequestByIdFn is an implementation of the function to get a Genesys Cloud variation request by Id
-func getVariationRequestByIdFn(_ context.Context, p *variationRequestProxy, documentVariationId string, documentId string, knowledgeBaseId string, documentState string, expand []string) (*platformclientv2.Documentvariationresponse, *platformclientv2.APIResponse, error) {
-	variation := rc.GetCacheItem(p.variationCache, documentVariationId)
+func getVariationRequestByIdFn(ctx context.Context, p *variationRequestProxy, documentVariationId string, documentId string, knowledgeBaseId string, documentState string, expand []string) (*platformclientv2.Documentvariationresponse, *platformclientv2.APIResponse, error) {
+	variation := rc.GetCacheItem(ctx, p.variationCache, documentVariationId)
 	if variation != nil {
 		return variation, nil, nil
 	}
@@ -11,6 +11,8 @@ import (
 
 var internalProxy *knowledgebaseProxy
 
+var knowledgebaseCache = rc.NewResourceCache[platformclientv2.Knowledgebase]()
+
 type getAllKnowledgebaseEntitiesFunc func(ctx context.Context, p *knowledgebaseProxy, published bool) (*[]platformclientv2.Knowledgebase, *platformclientv2.APIResponse, error)
 type getKnowledgebaseByIdFunc func(ctx context.Context, p *knowledgebaseProxy, knowledgebaseId string) (*platformclientv2.Knowledgebase, *platformclientv2.APIResponse, error)
 type createKnowledgebaseFunc func(ctx context.Context, p *knowledgebaseProxy, knowledgebaseRequest *platformclientv2.Knowledgebasecreaterequest) (*platformclientv2.Knowledgebase, *platformclientv2.APIResponse, error)
@@ -30,7 +32,6 @@ type knowledgebaseProxy struct {
 
 func newKnowledgebaseProxy(clientConfig *platformclientv2.Configuration) *knowledgebaseProxy {
 	api := platformclientv2.NewKnowledgeApiWithConfig(clientConfig)
-	knowledgebaseCache := rc.NewResourceCache[platformclientv2.Knowledgebase]()
 	return &knowledgebaseProxy{
 		clientConfig:                    clientConfig,
 		KnowledgeApi:                    api,
@@ -44,11 +45,10 @@ func newKnowledgebaseProxy(clientConfig *platformclientv2.Configuration) *knowle
 }
 
 func GetKnowledgebaseProxy(clientConfig *platformclientv2.Configuration) *knowledgebaseProxy {
-	if internalProxy == nil {
-		internalProxy = newKnowledgebaseProxy(clientConfig)
+	if internalProxy != nil {
+		return internalProxy
 	}
-
-	return internalProxy
+	return newKnowledgebaseProxy(clientConfig)
 }
 
 // getAllKnowledgebaseEntities retrieves all Genesys Cloud knowledgebases
@@ -11,6 +11,8 @@ import (
 
 var internalProxy *knowledgeLabelProxy
 
+var knowledgeLabelCache = rc.NewResourceCache[platformclientv2.Labelresponse]()
+
 type GetAllKnowledgebaseEntitiesFunc func(ctx context.Context, p *knowledgeLabelProxy, published bool) (*[]platformclientv2.Knowledgebase, *platformclientv2.APIResponse, error)
 type GetAllKnowledgeLabelEntitiesFunc func(ctx context.Context, p *knowledgeLabelProxy, knowledgeBase *platformclientv2.Knowledgebase) (*[]platformclientv2.Labelresponse, *platformclientv2.APIResponse, error)
 type getKnowledgeLabelFunc func(ctx context.Context, p *knowledgeLabelProxy, knowledgeBaseId string, labelId string) (*platformclientv2.Labelresponse, *platformclientv2.APIResponse, error)
@@ -32,7 +34,6 @@ type knowledgeLabelProxy struct {
 
 func newKnowledgeLabelProxy(clientConfig *platformclientv2.Configuration) *knowledgeLabelProxy {
 	api := platformclientv2.NewKnowledgeApiWithConfig(clientConfig)
-	knowledgeLabelCache := rc.NewResourceCache[platformclientv2.Labelresponse]()
 	return &knowledgeLabelProxy{
 		clientConfig:                     clientConfig,
 		KnowledgeApi:                     api,
@@ -47,11 +48,10 @@ func newKnowledgeLabelProxy(clientConfig *platformclientv2.Configuration) *knowl
 }
 
 func GetKnowledgeLabelProxy(clientConfig *platformclientv2.Configuration) *knowledgeLabelProxy {
-	if internalProxy == nil {
-		internalProxy = newKnowledgeLabelProxy(clientConfig)
+	if internalProxy != nil {
+		return internalProxy
 	}
-
-	return internalProxy
+	return newKnowledgeLabelProxy(clientConfig)
 }
 
 func (p *knowledgeLabelProxy) getKnowledgeLabel(ctx context.Context, knowledgeBaseId string, labelId string) (*platformclientv2.Labelresponse, *platformclientv2.APIResponse, error) {
@@ -160,7 +160,7 @@ func GetAllKnowledgeLabelEntitiesFn(ctx context.Context, p *knowledgeLabelProxy,
 
 func getKnowledgeLabelFn(ctx context.Context, p *knowledgeLabelProxy, knowledgeBaseId string, labelId string) (*platformclientv2.Labelresponse, *platformclientv2.APIResponse, error) {
 	id := fmt.Sprintf("%s,%s", knowledgeBaseId, labelId)
-	if knowledgeLabel := rc.GetCacheItem(p.knowledgeLabelCache, id); knowledgeLabel != nil {
+	if knowledgeLabel := rc.GetCacheItem(ctx, p.knowledgeLabelCache, id); knowledgeLabel != nil {
 		return knowledgeLabel, nil, nil
 	}
 	return p.KnowledgeApi.GetKnowledgeKnowledgebaseLabel(knowledgeBaseId, labelId)
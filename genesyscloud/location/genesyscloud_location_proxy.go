@@ -10,6 +10,8 @@ import (
 
 var internalProxy *locationProxy
 
+var locationCache = rc.NewResourceCache[platformclientv2.Locationdefinition]()
+
 type getAllLocationFunc func(ctx context.Context, p *locationProxy) (*[]platformclientv2.Locationdefinition, *platformclientv2.APIResponse, error)
 type createLocationFunc func(ctx context.Context, p *locationProxy, locationCreateDefinition *platformclientv2.Locationcreatedefinition) (*platformclientv2.Locationdefinition, *platformclientv2.APIResponse, error)
 type getLocationByIdFunc func(ctx context.Context, p *locationProxy, id string, expand []string) (*platformclientv2.Locationdefinition, *platformclientv2.APIResponse, error)
@@ -32,7 +34,6 @@ type locationProxy struct {
 // newLocationProxy initializes the location proxy with all of the data needed to communicate with Genesys Cloud
 func newLocationProxy(clientConfig *platformclientv2.Configuration) *locationProxy {
 	api := platformclientv2.NewLocationsApiWithConfig(clientConfig)
-	locationCache := rc.NewResourceCache[platformclientv2.Locationdefinition]()
 	return &locationProxy{
 		clientConfig:            clientConfig,
 		locationsApi:            api,
@@ -46,14 +47,14 @@ func newLocationProxy(clientConfig *platformclientv2.Configuration) *locationPro
 	}
 }
 
-// getLocationProxy acts as a singleton to for the internalProxy.  It also ensures
-// that we can still proxy our tests by directly setting internalProxy package variable
+// getLocationProxy returns a new proxy for the client config of every call, so provider configurations for
+// several orgs never share a proxy. It also ensures that we can still proxy our tests by directly setting
+// internalProxy package variable
 func getLocationProxy(clientConfig *platformclientv2.Configuration) *locationProxy {
-	if internalProxy == nil {
-		internalProxy = newLocationProxy(clientConfig)
+	if internalProxy != nil {
+		return internalProxy
 	}
-
-	return internalProxy
+	return newLocationProxy(clientConfig)
 }
 
 func (p *locationProxy) getAllLocation(ctx context.Context) (*[]platformclientv2.Locationdefinition, *platformclientv2.APIResponse, error) {
@@ -118,7 +119,7 @@ func createLocationFn(ctx context.Context, p *locationProxy, location *platformc
 }
 
 func getLocationByIdFn(ctx context.Context, p *locationProxy, id string, expand []string) (*platformclientv2.Locationdefinition, *platformclientv2.APIResponse, error) {
-	if location := rc.GetCacheItem(p.locationCache, id); location != nil {
+	if location := rc.GetCacheItem(ctx, p.locationCache, id); location != nil {
 		return location, nil, nil
 	}
 	return p.locationsApi.GetLocation(id, expand)
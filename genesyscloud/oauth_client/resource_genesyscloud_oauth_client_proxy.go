@@ -25,14 +25,18 @@ type deleteIntegrationCredentialFunc func(context.Context, *oauthClientProxy, st
 type updateIntegrationClientFunc func(context.Context, *oauthClientProxy, string, platformclientv2.Credential) (*platformclientv2.Credentialinfo, *platformclientv2.APIResponse, error)
 type getAllIntegrationCredentialFunc func(ctx context.Context, o *oauthClientProxy) (*[]platformclientv2.Credentialinfo, *platformclientv2.APIResponse, error)
 
+// Clients created by any proxy, so the integration credential resource can read their secrets. Being added for DEVTOOLING-448
+var (
+	createdClientCache     = make(map[string]platformclientv2.Oauthclient)
+	createdClientCacheLock sync.Mutex
+)
+
 type oauthClientProxy struct {
 	clientConfig                    *platformclientv2.Configuration
 	oAuthApi                        *platformclientv2.OAuthApi
 	integrationApi                  *platformclientv2.IntegrationsApi
 	tokenApi                        *platformclientv2.TokensApi
 	usersApi                        *platformclientv2.UsersApi
-	createdClientCache              map[string]platformclientv2.Oauthclient //Being added for DEVTOOLING-448
-	createdClientCacheLock          sync.Mutex
 	createOAuthClientAttr           createOAuthClientFunc
 	createIntegrationCredentialAttr createIntegrationClientFunc
 	updateIntegrationCredentialAttr updateIntegrationClientFunc
@@ -54,16 +58,14 @@ func newOAuthClientProxy(clientConfig *platformclientv2.Configuration) *oauthCli
 	oAuthApi := platformclientv2.NewOAuthApiWithConfig(clientConfig)
 	intApi := platformclientv2.NewIntegrationsApiWithConfig(clientConfig)
 	usersApi := platformclientv2.NewUsersApiWithConfig(clientConfig)
-	createdClientCache := make(map[string]platformclientv2.Oauthclient)
 	tokenApi := platformclientv2.NewTokensApiWithConfig(clientConfig)
 
 	return &oauthClientProxy{
-		clientConfig:       clientConfig,
-		oAuthApi:           oAuthApi,
-		integrationApi:     intApi,
-		usersApi:           usersApi,
-		tokenApi:           tokenApi,
-		createdClientCache: createdClientCache,
+		clientConfig:   clientConfig,
+		oAuthApi:       oAuthApi,
+		integrationApi: intApi,
+		usersApi:       usersApi,
+		tokenApi:       tokenApi,
 
 		createOAuthClientAttr:           createOAuthClientFn,
 		createIntegrationCredentialAttr: createIntegrationCredentialFn,
@@ -94,10 +96,10 @@ into the integration credential object.  Today the integration credential object
 without because once the oauth client is created, we dont want to expose the secret.
 */
 func GetOAuthClientProxy(clientConfig *platformclientv2.Configuration) *oauthClientProxy {
-	if internalProxy == nil {
-		internalProxy = newOAuthClientProxy(clientConfig)
+	if internalProxy != nil {
+		return internalProxy
 	}
-	return internalProxy
+	return newOAuthClientProxy(clientConfig)
 }
 
 func (o *oauthClientProxy) deleteOAuthClient(ctx context.Context, id string) (*platformclientv2.APIResponse, error) {
@@ -133,9 +135,9 @@ func (o *oauthClientProxy) getIntegrationCredential(ctx context.Context, id stri
 }
 
 func (o *oauthClientProxy) GetCachedOAuthClient(clientId string) platformclientv2.Oauthclient {
-	o.createdClientCacheLock.Lock()
-	defer o.createdClientCacheLock.Unlock()
-	return o.createdClientCache[clientId]
+	createdClientCacheLock.Lock()
+	defer createdClientCacheLock.Unlock()
+	return createdClientCache[clientId]
 }
 
 func (o *oauthClientProxy) createOAuthClient(ctx context.Context, oauthClient platformclientv2.Oauthclientrequest) (*platformclientv2.Oauthclient, *platformclientv2.APIResponse, error) {
@@ -145,9 +147,9 @@ func (o *oauthClientProxy) createOAuthClient(ctx context.Context, oauthClient pl
 	}
 
 	//Being added for DEVTOOLING-448.  This is one of the few places where we want to use a cache outside the export
-	o.createdClientCacheLock.Lock()
-	defer o.createdClientCacheLock.Unlock()
-	o.createdClientCache[*oauthClientResult.Id] = *oauthClientResult
+	createdClientCacheLock.Lock()
+	defer createdClientCacheLock.Unlock()
+	createdClientCache[*oauthClientResult.Id] = *oauthClientResult
 	log.Printf("Successfully added oauth client %s to cache", *oauthClientResult.Id)
 	return oauthClientResult, response, err
 }
@@ -34,7 +34,7 @@ func TestUnitCreateOAuthClientWithCache(t *testing.T) {
 		State:                      &tState,
 	}
 
-	ocproxy := &oauthClientProxy{createdClientCache: make(map[string]platformclientv2.Oauthclient)}
+	ocproxy := &oauthClientProxy{}
 
 	ocproxy.createOAuthClientAttr = func(ctx context.Context, p *oauthClientProxy, request platformclientv2.Oauthclientrequest) (*platformclientv2.Oauthclient, *platformclientv2.APIResponse, error) {
 		assert.Equal(t, tName, *request.Name)
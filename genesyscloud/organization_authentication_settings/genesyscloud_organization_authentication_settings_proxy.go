@@ -13,7 +13,7 @@ with the Genesys Cloud SDK. We use composition here for each function on the pro
 out during testing.
 */
 
-// internalProxy holds a proxy instance that can be used throughout the package
+// internalProxy holds a proxy instance that unit tests can set to replace the proxy of the package
 var internalProxy *orgAuthSettingsProxy
 
 // Type definitions for each func on our proxy so we can easily mock them out later
@@ -48,13 +48,14 @@ func newOrgAuthSettingsProxy(clientConfig *platformclientv2.Configuration) *orgA
 	}
 }
 
-// getOrgAuthSettingsProxy acts as a singleton to for the internalProxy.  It also ensures
-// that we can still proxy our tests by directly setting internalProxy package variable
+// getOrgAuthSettingsProxy returns a new proxy for the client config of every call, so provider configurations for
+// several orgs never share a proxy. It also ensures that we can still proxy our tests by directly setting
+// internalProxy package variable
 func getOrgAuthSettingsProxy(clientConfig *platformclientv2.Configuration) *orgAuthSettingsProxy {
-	if internalProxy == nil {
-		internalProxy = newOrgAuthSettingsProxy(clientConfig)
+	if internalProxy != nil {
+		return internalProxy
 	}
-	return internalProxy
+	return newOrgAuthSettingsProxy(clientConfig)
 }
 
 func (p *orgAuthSettingsProxy) getTokensTimeOutSettings(ctx context.Context) (*platformclientv2.Idletokentimeout, *platformclientv2.APIResponse, error) {
@@ -32,13 +32,14 @@ func newOrgauthorizationPairingProxy(clientConfig *platformclientv2.Configuratio
 	}
 }
 
-// getOrgauthorizationPairingProxy acts as a singleton to for the internalProxy.  It also ensures
-// that we can still proxy our tests by directly setting internalProxy package variable
+// getOrgauthorizationPairingProxy returns a new proxy for the client config of every call, so provider configurations for
+// several orgs never share a proxy. It also ensures that we can still proxy our tests by directly setting
+// internalProxy package variable
 func getOrgauthorizationPairingProxy(clientConfig *platformclientv2.Configuration) *orgauthorizationPairingProxy {
-	if internalProxy == nil {
-		internalProxy = newOrgauthorizationPairingProxy(clientConfig)
+	if internalProxy != nil {
+		return internalProxy
 	}
-	return internalProxy
+	return newOrgauthorizationPairingProxy(clientConfig)
 }
 
 // createOrgauthorizationPairing creates a Genesys Cloud orgauthorization pairing
@@ -14,7 +14,7 @@ The genesyscloud_outbound_callabletimeset_proxy.go file contains the proxy struc
 with the Genesys Cloud SDK
 */
 
-// internalProxy holds a proxy instance that can be used throughout the package
+// internalProxy holds a proxy instance that unit tests can set to replace the proxy of the package
 var internalProxy *outboundCallableTimesetProxy
 
 // type definitions for each func on our proxy
@@ -53,11 +53,10 @@ func newOutboundCallableTimesetProxy(clientConfig *platformclientv2.Configuratio
 }
 
 func getOutboundCallabletimesetProxy(clientConfig *platformclientv2.Configuration) *outboundCallableTimesetProxy {
-	if internalProxy == nil {
-		internalProxy = newOutboundCallableTimesetProxy(clientConfig)
+	if internalProxy != nil {
+		return internalProxy
 	}
-
-	return internalProxy
+	return newOutboundCallableTimesetProxy(clientConfig)
 }
 
 // createOutboundCallabletimeset creates a Genesys Cloud Outbound Callable Timeset
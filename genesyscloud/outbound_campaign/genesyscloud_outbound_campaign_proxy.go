@@ -20,9 +20,11 @@ with the Genesys Cloud SDK. We use composition here for each function on the pro
 out during testing.
 */
 
-// internalProxy holds a proxy instance that can be used throughout the package
+// internalProxy holds a proxy instance that unit tests can set to replace the proxy of the package
 var internalProxy *outboundCampaignProxy
 
+var campaignCache = rc.NewResourceCache[platformclientv2.Campaign]()
+
 // Type definitions for each func on our proxy so we can easily mock them out later
 type createOutboundCampaignFunc func(ctx context.Context, p *outboundCampaignProxy, campaign *platformclientv2.Campaign) (*platformclientv2.Campaign, *platformclientv2.APIResponse, error)
 type getAllOutboundCampaignFunc func(ctx context.Context, p *outboundCampaignProxy) (*[]platformclientv2.Campaign, *platformclientv2.APIResponse, error)
@@ -47,7 +49,6 @@ type outboundCampaignProxy struct {
 // newOutboundCampaignProxy initializes the outbound campaign proxy with all of the data needed to communicate with Genesys Cloud
 func newOutboundCampaignProxy(clientConfig *platformclientv2.Configuration) *outboundCampaignProxy {
 	api := platformclientv2.NewOutboundApiWithConfig(clientConfig)
-	campaignCache := rc.NewResourceCache[platformclientv2.Campaign]()
 	return &outboundCampaignProxy{
 		clientConfig:                    clientConfig,
 		outboundApi:                     api,
@@ -61,13 +62,14 @@ func newOutboundCampaignProxy(clientConfig *platformclientv2.Configuration) *out
 	}
 }
 
-// getOutboundCampaignProxy acts as a singleton to for the internalProxy.  It also ensures
-// that we can still proxy our tests by directly setting internalProxy package variable
+// getOutboundCampaignProxy returns a new proxy for the client config of every call, so provider configurations for
+// several orgs never share a proxy. It also ensures that we can still proxy our tests by directly setting
+// internalProxy package variable
 func getOutboundCampaignProxy(clientConfig *platformclientv2.Configuration) *outboundCampaignProxy {
-	if internalProxy == nil {
-		internalProxy = newOutboundCampaignProxy(clientConfig)
+	if internalProxy != nil {
+		return internalProxy
 	}
-	return internalProxy
+	return newOutboundCampaignProxy(clientConfig)
 }
 
 // createOutboundCampaign creates a Genesys Cloud outbound campaign
@@ -87,7 +89,7 @@ func (p *outboundCampaignProxy) getOutboundCampaignIdByName(ctx context.Context,
 
 // getOutboundCampaignById returns a single Genesys Cloud outbound campaign by Id
 func (p *outboundCampaignProxy) getOutboundCampaignById(ctx context.Context, id string) (outboundCampaign *platformclientv2.Campaign, response *platformclientv2.APIResponse, err error) {
-	if campaign := rc.GetCacheItem(p.campaignCache, id); campaign != nil {
+	if campaign := rc.GetCacheItem(ctx, p.campaignCache, id); campaign != nil {
 		return campaign, nil, nil
 	}
 	return p.getOutboundCampaignByIdAttr(ctx, p, id)
@@ -100,10 +100,10 @@ func newOutboundContactlistProxy(clientConfig *platformclientv2.Configuration) *
 //	proxy := GetOutboundContactlistProxy(sdkConfig)
 //	contactList, err := proxy.GetOutboundContactList(contactListId)
 func GetOutboundContactlistProxy(clientConfig *platformclientv2.Configuration) *OutboundContactlistProxy {
-	if internalProxy == nil {
-		internalProxy = newOutboundContactlistProxy(clientConfig)
+	if internalProxy != nil {
+		return internalProxy
 	}
-	return internalProxy
+	return newOutboundContactlistProxy(clientConfig)
 }
 
 // createOutboundContactlist creates a Genesys Cloud outbound contactlist
@@ -221,7 +221,7 @@ func getOutboundContactlistIdByNameFn(ctx context.Context, p *OutboundContactlis
 
 // getOutboundContactlistByIdFn is an implementation of the function to get a Genesys Cloud outbound contactlist by Id
 func getOutboundContactlistByIdFn(ctx context.Context, p *OutboundContactlistProxy, id string) (outboundContactlist *platformclientv2.Contactlist, response *platformclientv2.APIResponse, err error) {
-	if contactList := rc.GetCacheItem(p.contactListCache, id); contactList != nil {
+	if contactList := rc.GetCacheItem(ctx, p.contactListCache, id); contactList != nil {
 		return contactList, nil, nil
 	}
 	if tfexporter_state.IsExporterActive() {
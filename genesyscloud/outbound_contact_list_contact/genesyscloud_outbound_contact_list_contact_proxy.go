@@ -78,8 +78,8 @@ func createContactFn(_ context.Context, p *contactProxy, contactListId string, c
 	return p.outboundApi.PostOutboundContactlistContacts(contactListId, []platformclientv2.Writabledialercontact{contact}, priority, clearSystemData, doNotQueue)
 }
 
-func readContactByIdFn(_ context.Context, p *contactProxy, contactListId, contactId string) (*platformclientv2.Dialercontact, *platformclientv2.APIResponse, error) {
-	if contact := rc.GetCacheItem(p.contactCache, buildComplexContactId(contactListId, contactId)); contact != nil {
+func readContactByIdFn(ctx context.Context, p *contactProxy, contactListId, contactId string) (*platformclientv2.Dialercontact, *platformclientv2.APIResponse, error) {
+	if contact := rc.GetCacheItem(ctx, p.contactCache, buildComplexContactId(contactListId, contactId)); contact != nil {
 		return contact, nil, nil
 	}
 	if tfexporter_state.IsExporterActive() {
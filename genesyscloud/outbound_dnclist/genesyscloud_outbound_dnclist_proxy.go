@@ -51,10 +51,10 @@ func newOutboundDnclistProxy(clientConfig *platformclientv2.Configuration) *outb
 }
 
 func getOutboundDnclistProxy(clientConfig *platformclientv2.Configuration) *outboundDnclistProxy {
-	if internalProxy == nil {
-		internalProxy = newOutboundDnclistProxy(clientConfig)
+	if internalProxy != nil {
+		return internalProxy
 	}
-	return internalProxy
+	return newOutboundDnclistProxy(clientConfig)
 }
 
 // createOutboundDnclist creates a Genesys Cloud Outbound Dnclist
@@ -14,7 +14,7 @@ with the Genesys Cloud SDK. We use composition here for each function on the pro
 out during testing.
 */
 
-// internalProxy holds a proxy instance that can be used throughout the package
+// internalProxy holds a proxy instance that unit tests can set to replace the proxy of the package
 var internalProxy *outboundFilespecificationtemplateProxy
 
 // Type definitions for each func on our proxy, so we can easily mock them out later
@@ -53,14 +53,14 @@ func newOutboundFilespecificationtemplateProxy(clientConfig *platformclientv2.Co
 	}
 }
 
-// getOutboundFilespecificationtemplateProxy acts as a singleton to for the internalProxy. It also ensures
-// that we can still proxy our tests by directly setting internalProxy package variable
+// getOutboundFilespecificationtemplateProxy returns a new proxy for the client config of every call, so provider configurations for
+// several orgs never share a proxy. It also ensures that we can still proxy our tests by directly setting
+// internalProxy package variable
 func getOutboundFilespecificationtemplateProxy(clientConfig *platformclientv2.Configuration) *outboundFilespecificationtemplateProxy {
-	if internalProxy == nil {
-		internalProxy = newOutboundFilespecificationtemplateProxy(clientConfig)
+	if internalProxy != nil {
+		return internalProxy
 	}
-
-	return internalProxy
+	return newOutboundFilespecificationtemplateProxy(clientConfig)
 }
 
 // createOutboundFilespecificationtemplate creates a Genesys Cloud outbound filespecificationtemplate
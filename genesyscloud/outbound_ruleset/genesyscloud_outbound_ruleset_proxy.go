@@ -15,7 +15,7 @@ with the Genesys Cloud SDK. We use composition here for each function on the pro
 out during testing.
 */
 
-// internalProxy holds a proxy instance that can be used throughout the package
+// internalProxy holds a proxy instance that unit tests can set to replace the proxy of the package
 var internalProxy *outboundRulesetProxy
 
 // Type definitions for each func on our proxy so we can easily mock them out later
@@ -53,13 +53,14 @@ func newOutboundRulesetProxy(clientConfig *platformclientv2.Configuration) *outb
 	}
 }
 
-// getOutboundRulesetProxy acts as a singleton to for the internalProxy.  It also ensures
-// that we can still proxy our tests by directly setting internalProxy package variable
+// getOutboundRulesetProxy returns a new proxy for the client config of every call, so provider configurations for
+// several orgs never share a proxy. It also ensures that we can still proxy our tests by directly setting
+// internalProxy package variable
 func getOutboundRulesetProxy(clientConfig *platformclientv2.Configuration) *outboundRulesetProxy {
-	if internalProxy == nil {
-		internalProxy = newOutboundRulesetProxy(clientConfig)
+	if internalProxy != nil {
+		return internalProxy
 	}
-	return internalProxy
+	return newOutboundRulesetProxy(clientConfig)
 }
 
 // createOutboundRuleset creates a Genesys Cloud Outbound Ruleset
@@ -13,7 +13,7 @@ with the Genesys Cloud SDK. We use composition here for each function on the pro
 out during testing.
 */
 
-// internalProxy holds a proxy instance that can be used throughout the package
+// internalProxy holds a proxy instance that unit tests can set to replace the proxy of the package
 var internalProxy *outboundSettingsProxy
 
 // Type definitions for each func on our proxy so we can easily mock them out later
@@ -39,13 +39,14 @@ func newOutboundSettingsProxy(clientConfig *platformclientv2.Configuration) *out
 	}
 }
 
-// getOutboundSettingsProxy acts as a singleton to for the internalProxy.  It also ensures
-// that we can still proxy our tests by directly setting internalProxy package variable
+// getOutboundSettingsProxy returns a new proxy for the client config of every call, so provider configurations for
+// several orgs never share a proxy. It also ensures that we can still proxy our tests by directly setting
+// internalProxy package variable
 func getOutboundSettingsProxy(clientConfig *platformclientv2.Configuration) *outboundSettingsProxy {
-	if internalProxy == nil {
-		internalProxy = newOutboundSettingsProxy(clientConfig)
+	if internalProxy != nil {
+		return internalProxy
 	}
-	return internalProxy
+	return newOutboundSettingsProxy(clientConfig)
 }
 
 // getOutboundSettings returns a single Genesys Cloud outbound settings by Id
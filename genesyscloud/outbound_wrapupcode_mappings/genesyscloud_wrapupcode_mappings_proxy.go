@@ -36,12 +36,12 @@ func newOutboundWrapupCodeMappingsProxy(clientConfig *platformclientv2.Configura
 	}
 }
 
-// etOutboundWrapupCodeMappingsProxy is a singleton method to return a single instance outboundWrapupCodeMappingsProxy
+// getOutboundWrapupCodeMappingsProxy returns a new outboundWrapupCodeMappingsProxy for the client config of every call
 func getOutboundWrapupCodeMappingsProxy(clientConfig *platformclientv2.Configuration) *outboundWrapupCodeMappingsProxy {
-	if internalProxy == nil {
-		internalProxy = newOutboundWrapupCodeMappingsProxy(clientConfig)
+	if internalProxy != nil {
+		return internalProxy
 	}
-	return internalProxy
+	return newOutboundWrapupCodeMappingsProxy(clientConfig)
 }
 
 // getAllOutboundWrapupCodeMapping returns all of the outbound mapping.  This is the struct implementation that should be consumed by everypne.
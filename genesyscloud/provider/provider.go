@@ -9,7 +9,10 @@ import (
 	"path/filepath"
 	"regexp"
 	"strings"
+	rc "terraform-provider-genesyscloud/genesyscloud/resource_cache"
+	"terraform-provider-genesyscloud/genesyscloud/util/httprecorder"
 	prl "terraform-provider-genesyscloud/genesyscloud/util/panic_recovery_logger"
+	"terraform-provider-genesyscloud/genesyscloud/util/tracing"
 	"time"
 
 	"terraform-provider-genesyscloud/genesyscloud/platform"
@@ -72,6 +75,30 @@ func New(version string, providerResources map[string]*schema.Resource, provider
 					DefaultFunc: schema.EnvDefaultFunc("GENESYSCLOUD_ACCESS_TOKEN", nil),
 					Description: "A string that the OAuth client uses to make requests. Can be set with the `GENESYSCLOUD_ACCESS_TOKEN` environment variable.",
 				},
+				"access_token_file": {
+					Type:        schema.TypeString,
+					Optional:    true,
+					DefaultFunc: schema.EnvDefaultFunc("GENESYSCLOUD_ACCESS_TOKEN_FILE", nil),
+					Description: "Path of a file that contains an access token. The file is read again whenever the token is rejected, so it can be replaced while the provider runs. Can be set with the `GENESYSCLOUD_ACCESS_TOKEN_FILE` environment variable.",
+				},
+				"access_token_command": {
+					Type:        schema.TypeString,
+					Optional:    true,
+					DefaultFunc: schema.EnvDefaultFunc("GENESYSCLOUD_ACCESS_TOKEN_COMMAND", nil),
+					Description: "Command that prints an access token, or a JSON object with an `access_token` and its `expires_in` seconds. The command is run again whenever the token is rejected or about to expire. Can be set with the `GENESYSCLOUD_ACCESS_TOKEN_COMMAND` environment variable.",
+				},
+				"saml2_assertion_file": {
+					Type:        schema.TypeString,
+					Optional:    true,
+					DefaultFunc: schema.EnvDefaultFunc("GENESYSCLOUD_SAML2_ASSERTION_FILE", nil),
+					Description: "Path of a file that contains a base64 encoded SAML2 assertion, which is exchanged for an access token with a SAML2 bearer grant of the `oauthclient_id`. The file is read again whenever the token is renewed. Requires `saml2_org_name`. Can be set with the `GENESYSCLOUD_SAML2_ASSERTION_FILE` environment variable.",
+				},
+				"saml2_org_name": {
+					Type:        schema.TypeString,
+					Optional:    true,
+					DefaultFunc: schema.EnvDefaultFunc("GENESYSCLOUD_SAML2_ORG_NAME", nil),
+					Description: "Short name of the org the SAML2 assertion of `saml2_assertion_file` is granted for. Can be set with the `GENESYSCLOUD_SAML2_ORG_NAME` environment variable.",
+				},
 				"oauthclient_id": {
 					Type:        schema.TypeString,
 					Optional:    true,
@@ -116,7 +143,27 @@ func New(version string, providerResources map[string]*schema.Resource, provider
 					Type:         schema.TypeInt,
 					Optional:     true,
 					DefaultFunc:  schema.EnvDefaultFunc("GENESYSCLOUD_TOKEN_POOL_SIZE", 10),
-					Description:  "Max number of OAuth tokens in the token pool. Can be set with the `GENESYSCLOUD_TOKEN_POOL_SIZE` environment variable.",
+					Description:  "Max number of OAuth tokens in the token pool. Operations wait for a token to be released once all tokens are in use. Can be set with the `GENESYSCLOUD_TOKEN_POOL_SIZE` environment variable.",
+					ValidateFunc: validation.IntBetween(1, 20),
+				},
+				"tracing_endpoint": {
+					Type:        schema.TypeString,
+					Optional:    true,
+					DefaultFunc: schema.EnvDefaultFunc("GENESYSCLOUD_TRACING_ENDPOINT", nil),
+					Description: "URL of an OpenTelemetry collector that receives traces of the provider operations with OTLP over HTTP, e.g. `http://localhost:4318`. Every create, read, update and delete, the wait for an SDK client, the API requests and their retries, and the phases of exports are traced. Tracing is disabled if not set. Can be set with the `GENESYSCLOUD_TRACING_ENDPOINT` environment variable.",
+				},
+				"api_requests_per_second": {
+					Type:         schema.TypeInt,
+					Optional:     true,
+					DefaultFunc:  schema.EnvDefaultFunc("GENESYSCLOUD_API_REQUESTS_PER_SECOND", 0),
+					Description:  "Max number of API requests per second shared by all tokens in the token pool, e.g. to leave room in the rate limits of the org for other integrations. A value of 0 means unlimited. Whenever the API responds with a 429, all requests of the provider configuration are paused for the duration of its `Retry-After` header. Can be set with the `GENESYSCLOUD_API_REQUESTS_PER_SECOND` environment variable.",
+					ValidateFunc: validation.IntAtLeast(0),
+				},
+				"token_pool_min_size": {
+					Type:         schema.TypeInt,
+					Optional:     true,
+					DefaultFunc:  schema.EnvDefaultFunc("GENESYSCLOUD_TOKEN_POOL_MIN_SIZE", 1),
+					Description:  "Min number of OAuth tokens in the token pool. The pool grows up to `token_pool_size` tokens when needed, and shrinks back when tokens have been idle for 5 minutes. Can be set with the `GENESYSCLOUD_TOKEN_POOL_MIN_SIZE` environment variable.",
 					ValidateFunc: validation.IntBetween(1, 20),
 				},
 				"log_stack_traces": {
@@ -135,6 +182,74 @@ If you encounter any stack traces, please report them so we can address the unde
 					DefaultFunc:      schema.EnvDefaultFunc(logStackTracesFilePathEnvVar, "genesyscloud_stack_traces.log"),
 					ValidateDiagFunc: validateLogFilePath,
 				},
+				"enable_resource_cache": {
+					Type:        schema.TypeBool,
+					Optional:    true,
+					DefaultFunc: schema.EnvDefaultFunc("GENESYSCLOUD_ENABLE_RESOURCE_CACHE", false),
+					Description: "If true, the first read of a resource type during a plan or refresh lists all resources of that type in bulk and the following reads are served from an in-memory cache. Resources are removed from the cache whenever they are created, updated or deleted. This reduces the number of API calls made for large configurations. Can be set with the `GENESYSCLOUD_ENABLE_RESOURCE_CACHE` environment variable.",
+				},
+				"delete_protection": {
+					Type:        schema.TypeBool,
+					Optional:    true,
+					DefaultFunc: schema.EnvDefaultFunc("GENESYSCLOUD_DELETE_PROTECTION", false),
+					Description: "If true, `genesyscloud_routing_queue`, `genesyscloud_architect_user_prompt` and `genesyscloud_architect_datatable` resources that are used by flows are not deleted. The flows are found with Architect dependency tracking and listed in the error. Can be set with the `GENESYSCLOUD_DELETE_PROTECTION` environment variable.",
+				},
+				"consistency_checker": {
+					Type:        schema.TypeList,
+					Optional:    true,
+					Description: "Overrides the consistency checker settings of the `BYPASS_CONSISTENCY_CHECKER` and `CONSISTENCY_CHECKS` environment variables for a resource type.",
+					Elem: &schema.Resource{
+						Schema: map[string]*schema.Schema{
+							"resource_type": {
+								Type:        schema.TypeString,
+								Required:    true,
+								Description: "The resource type the settings apply to, e.g. `genesyscloud_routing_queue`.",
+							},
+							"max_checks": {
+								Type:         schema.TypeInt,
+								Optional:     true,
+								Description:  "Number of times the consistency checker retries before it gives up. With `bypass`, mismatches are then written to the consistency report. Without `bypass`, the read then fails with the mismatches instead of retrying until it times out. If not set, the `CONSISTENCY_CHECKS` environment variable is used for bypassed resource types and other resource types retry until the read times out. Setting 0 with `bypass` disables the consistency checker for the resource type.",
+								ValidateFunc: validation.IntAtLeast(0),
+							},
+							"backoff": {
+								Type:             schema.TypeString,
+								Optional:         true,
+								Description:      "Time to wait before the state is checked again after a mismatch, e.g. `5s`.",
+								ValidateDiagFunc: validateDuration,
+							},
+							"bypass": {
+								Type:        schema.TypeBool,
+								Optional:    true,
+								Description: "If true, mismatches are written to the consistency report instead of failing once all checks are done. If false, mismatches fail once the `max_checks` checks are done, or when the read times out if `max_checks` is not set. If not set, the `BYPASS_CONSISTENCY_CHECKER` environment variable is used.",
+							},
+							"ignore_attributes": {
+								Type:        schema.TypeList,
+								Optional:    true,
+								Description: "Attribute paths that are never checked for consistency, e.g. `members`. Nested attributes of the paths are ignored as well.",
+								Elem:        &schema.Schema{Type: schema.TypeString},
+							},
+						},
+					},
+				},
+				"data_source_cache_dir": {
+					Type:        schema.TypeString,
+					Optional:    true,
+					DefaultFunc: schema.EnvDefaultFunc("GENESYSCLOUD_DATA_SOURCE_CACHE_DIR", ""),
+					Description: "Directory of a cache that persists the name to ID lookups of data sources between runs, e.g. to share them between many workspaces using the same org. Lookups are stored per org ID and region. The cache is disabled if not set. Can be set with the `GENESYSCLOUD_DATA_SOURCE_CACHE_DIR` environment variable.",
+				},
+				"data_source_cache_ttl": {
+					Type:             schema.TypeString,
+					Optional:         true,
+					DefaultFunc:      schema.EnvDefaultFunc("GENESYSCLOUD_DATA_SOURCE_CACHE_TTL", "1h"),
+					Description:      "How long the lookups in `data_source_cache_dir` are used before they are retrieved again, e.g. `30m` or `24h`. Can be set with the `GENESYSCLOUD_DATA_SOURCE_CACHE_TTL` environment variable. Default value is 1h.",
+					ValidateDiagFunc: validateDuration,
+				},
+				"invalidate_data_source_cache": {
+					Type:        schema.TypeBool,
+					Optional:    true,
+					DefaultFunc: schema.EnvDefaultFunc("GENESYSCLOUD_INVALIDATE_DATA_SOURCE_CACHE", false),
+					Description: "If true, all lookups of the org in `data_source_cache_dir` are removed when the provider is configured. Can be set with the `GENESYSCLOUD_INVALIDATE_DATA_SOURCE_CACHE` environment variable.",
+				},
 				"gateway": {
 					Type:     schema.TypeSet,
 					Optional: true,
@@ -266,6 +381,18 @@ type ProviderMeta struct {
 	Domain             string
 	Organization       *platformclientv2.Organization
 	DefaultCountryCode string
+	ClientPool         *SDKClientPool
+	OrgKey             string
+	DeleteProtection   bool
+}
+
+// GetOrgKey returns the key of the org of the provider configuration, so packages that cannot depend on the provider
+// package can keep settings per org
+func (p *ProviderMeta) GetOrgKey() string {
+	if p == nil {
+		return ""
+	}
+	return p.OrgKey
 }
 
 func configure(version string) schema.ConfigureContextFunc {
@@ -279,18 +406,36 @@ func configure(version string) schema.ConfigureContextFunc {
 
 		providerSourceRegistry := getRegistry(&platform, version)
 
-		err := InitSDKClientPool(data.Get("token_pool_size").(int), version, data)
+		minPoolSize := data.Get("token_pool_min_size").(int)
+		maxPoolSize := data.Get("token_pool_size").(int)
+		if minPoolSize > maxPoolSize {
+			return nil, diag.Errorf("token_pool_min_size (%d) must not be greater than token_pool_size (%d)", minPoolSize, maxPoolSize)
+		}
+		if err := tracing.Configure(context, data.Get("tracing_endpoint").(string), version); err != nil {
+			return nil, diag.FromErr(err)
+		}
+		clientPool, err := InitSDKClientPool(minPoolSize, maxPoolSize, version, data)
 		if err != nil {
 			return nil, err
 		}
 
-		defaultConfig := platformclientv2.GetDefaultConfiguration()
+		defaultConfig := clientPool.DefaultConfig
 
 		currentOrg, err := getOrganizationMe(defaultConfig)
 		if err != nil {
 			return nil, err
 		}
 
+		// Caches and consistency checker settings are kept per org, so the provider configurations of other orgs keep theirs
+		orgKey := rc.OrgKey(*currentOrg.Id, data.Get("aws_region").(string))
+		rc.SetReadThroughCacheEnabled(orgKey, data.Get("enable_resource_cache").(bool))
+		if err := configureDataSourceDiskCache(data, currentOrg); err != nil {
+			return nil, err
+		}
+		if err := configureConsistencyChecker(data, orgKey); err != nil {
+			return nil, err
+		}
+
 		prl.InitPanicRecoveryLoggerInstance(data.Get("log_stack_traces").(bool), data.Get("log_stack_traces_file_path").(string))
 
 		meta := &ProviderMeta{
@@ -301,6 +446,9 @@ func configure(version string) schema.ConfigureContextFunc {
 			Domain:             getRegionDomain(data.Get("aws_region").(string)),
 			Organization:       currentOrg,
 			DefaultCountryCode: *currentOrg.DefaultCountryCode,
+			ClientPool:         clientPool,
+			OrgKey:             orgKey,
+			DeleteProtection:   data.Get("delete_protection").(bool),
 		}
 
 		setProviderMeta(meta)
@@ -397,14 +545,24 @@ func GetRegionBasePath(region string) string {
 	return "https://api." + getRegionDomain(region)
 }
 
+// InitClientConfig initializes and authorizes a client that is not part of a Pool, so its requests are neither rate
+// limited nor observed
 func InitClientConfig(data *schema.ResourceData, version string, config *platformclientv2.Configuration) diag.Diagnostics {
-	accessToken := data.Get("access_token").(string)
-	oauthclientID := data.Get("oauthclient_id").(string)
-	oauthclientSecret := data.Get("oauthclient_secret").(string)
+	return initClientConfig(data, version, config, true, nil, nil)
+}
+
+// initClientConfig initializes and authorizes a client. Tokens of clients with automaticTokenRefresh set are renewed by the
+// Go SDK before they expire. Requests wait for the rate limiter and responses are passed to the observers of the Pool
+// of the client.
+func initClientConfig(data *schema.ResourceData, version string, config *platformclientv2.Configuration, automaticTokenRefresh bool, rateLimiter *apiRateLimiter, observers *responseObservers) diag.Diagnostics {
+	authorizer, diagErr := newClientAuthorizer(data)
+	if diagErr != nil {
+		return diagErr
+	}
 	basePath := GetRegionBasePath(data.Get("aws_region").(string))
-	config.BasePath = basePath
+	config.BasePath = httprecorder.Target(basePath)
 
-	diagErr := setUpSDKLogging(data, config)
+	diagErr = setUpSDKLogging(data, config)
 	if diagErr != nil {
 		return diagErr
 	}
@@ -418,8 +576,11 @@ func InitClientConfig(data *schema.ResourceData, version string, config *platfor
 		RetryWaitMax: time.Second * 30,
 		RetryMax:     20,
 		RequestLogHook: func(request *http.Request, count int) {
+			rateLimiter.wait(request)
+
 			sdkDebugRequest := newSDKDebugRequest(request, count)
 			request.Header.Set("TF-Correlation-Id", sdkDebugRequest.TransactionId)
+			tracing.StartRequestSpan(config, sdkDebugRequest.TransactionId, request.Method, request.URL.Path, count)
 			err, jsonStr := sdkDebugRequest.ToJSON()
 
 			if err != nil {
@@ -428,6 +589,10 @@ func InitClientConfig(data *schema.ResourceData, version string, config *platfor
 			log.Println(jsonStr)
 		},
 		ResponseLogHook: func(response *http.Response) {
+			rateLimiter.observeResponse(response)
+			observers.notify(response)
+			tracing.EndRequestSpan(config, response.Request.Header.Get("TF-Correlation-Id"), response.StatusCode)
+
 			sdkDebugResponse := newSDKDebugResponse(response)
 			err, jsonStr := sdkDebugResponse.ToJSON()
 
@@ -438,29 +603,32 @@ func InitClientConfig(data *schema.ResourceData, version string, config *platfor
 		},
 	}
 
-	if accessToken != "" {
-		log.Print("Setting access token set on configuration instance.")
-		config.AccessToken = accessToken
-	} else {
-		config.AutomaticTokenRefresh = true // Enable automatic token refreshing
+	// The Go SDK only renews tokens of client credentials by itself
+	config.AutomaticTokenRefresh = automaticTokenRefresh && authorizer.mode == "client credentials"
 
-		return withRetries(context.Background(), time.Minute, func() *retry.RetryError {
-			err := config.AuthorizeClientCredentials(oauthclientID, oauthclientSecret)
-			if err != nil {
-				if !strings.Contains(err.Error(), "Auth Error: 400 - invalid_request (rate limit exceeded;") {
-					return retry.NonRetryableError(fmt.Errorf("failed to authorize Genesys Cloud client credentials: %v", err))
-				}
-				return retry.RetryableError(fmt.Errorf("exhausted retries on Genesys Cloud client credentials. %v", err))
-			}
-
-			return nil
-		})
+	log.Printf("Authorizing Go SDK Client with %s.", authorizer.mode)
+	if diagErr := authorizer.authorize(config); diagErr != nil {
+		return diagErr
 	}
 
 	log.Printf("Initialized Go SDK Client. Debug=%t", data.Get("sdk_debug").(bool))
 	return nil
 }
 
+func authorizeClientCredentials(config *platformclientv2.Configuration, oauthclientID string, oauthclientSecret string) diag.Diagnostics {
+	return withRetries(context.Background(), time.Minute, func() *retry.RetryError {
+		err := config.AuthorizeClientCredentials(oauthclientID, oauthclientSecret)
+		if err != nil {
+			if !strings.Contains(err.Error(), "Auth Error: 400 - invalid_request (rate limit exceeded;") {
+				return retry.NonRetryableError(fmt.Errorf("failed to authorize Genesys Cloud client credentials: %v", err))
+			}
+			return retry.RetryableError(fmt.Errorf("exhausted retries on Genesys Cloud client credentials. %v", err))
+		}
+
+		return nil
+	})
+}
+
 func withRetries(ctx context.Context, timeout time.Duration, method func() *retry.RetryError) diag.Diagnostics {
 	err := diag.FromErr(retry.RetryContext(ctx, timeout, method))
 	if err != nil && strings.Contains(fmt.Sprintf("%v", err), "timeout while waiting for state to become") {
@@ -582,7 +750,7 @@ func AuthorizeSdk() (*platformclientv2.Configuration, error) {
 		return sdkConfig, nil
 	}
 
-	sdkConfig.BasePath = GetRegionBasePath(os.Getenv("GENESYSCLOUD_REGION"))
+	sdkConfig.BasePath = httprecorder.Target(GetRegionBasePath(os.Getenv("GENESYSCLOUD_REGION")))
 
 	diagErr := withRetries(context.Background(), time.Minute, func() *retry.RetryError {
 		err := sdkConfig.AuthorizeClientCredentials(os.Getenv("GENESYSCLOUD_OAUTHCLIENT_ID"), os.Getenv("GENESYSCLOUD_OAUTHCLIENT_SECRET"))
@@ -0,0 +1,219 @@
+package provider
+
+import (
+	"context"
+	"encoding/base64"
+	"encoding/json"
+	"fmt"
+	"log"
+	"net/http"
+	"net/url"
+	"os"
+	"os/exec"
+	"regexp"
+	"runtime"
+	"strings"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/retry"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/mypurecloud/platform-client-sdk-go/v152/platformclientv2"
+)
+
+/*
+This file contains the ways an SDK client can be authorized. Besides client credentials and a static access token, a token
+can be read from a file or printed by an external command, like the credential_process of the AWS CLI. Both are read again
+whenever a token is rejected. A token can also be granted for a SAML2 assertion of an identity provider, so no long-lived
+client secret has to be issued to CI.
+*/
+
+const (
+	saml2BearerGrantType = "urn:ietf:params:oauth:grant-type:saml2-bearer"
+	tokenCommandTimeout  = time.Minute
+)
+
+// clientAuthorizer authorizes SDK clients with the authentication mode of a provider configuration
+type clientAuthorizer struct {
+	mode      string
+	authorize func(config *platformclientv2.Configuration) diag.Diagnostics
+
+	// renewable is false if the provider cannot get a new token once it expires, e.g. for a static access token
+	renewable bool
+}
+
+// tokenCommandOutput is the JSON an access token command can print instead of a plain token
+type tokenCommandOutput struct {
+	AccessToken string `json:"access_token"`
+	ExpiresIn   int    `json:"expires_in"`
+}
+
+// newClientAuthorizer returns the authorizer of the authentication mode set in the provider configuration. Client
+// credentials are used if no other mode is set.
+func newClientAuthorizer(data *schema.ResourceData) (*clientAuthorizer, diag.Diagnostics) {
+	accessToken := data.Get("access_token").(string)
+	accessTokenFile := data.Get("access_token_file").(string)
+	accessTokenCommand := data.Get("access_token_command").(string)
+	saml2AssertionFile := data.Get("saml2_assertion_file").(string)
+	oauthclientID := data.Get("oauthclient_id").(string)
+	oauthclientSecret := data.Get("oauthclient_secret").(string)
+
+	var modes []string
+	for _, mode := range []string{"access_token", "access_token_file", "access_token_command", "saml2_assertion_file"} {
+		if data.Get(mode).(string) != "" {
+			modes = append(modes, mode)
+		}
+	}
+	if len(modes) > 1 {
+		return nil, diag.Errorf("only one of access_token, access_token_file, access_token_command and saml2_assertion_file can be set, got %s", strings.Join(modes, ", "))
+	}
+
+	switch {
+	case accessToken != "":
+		return &clientAuthorizer{
+			mode: "access token",
+			authorize: func(config *platformclientv2.Configuration) diag.Diagnostics {
+				config.AccessToken = accessToken
+				return nil
+			},
+		}, nil
+	case accessTokenFile != "":
+		return &clientAuthorizer{
+			mode: "access token file",
+			authorize: func(config *platformclientv2.Configuration) diag.Diagnostics {
+				return authorizeWithTokenFile(config, accessTokenFile)
+			},
+			renewable: true,
+		}, nil
+	case accessTokenCommand != "":
+		return &clientAuthorizer{
+			mode: "access token command",
+			authorize: func(config *platformclientv2.Configuration) diag.Diagnostics {
+				return authorizeWithTokenCommand(config, accessTokenCommand)
+			},
+			renewable: true,
+		}, nil
+	case saml2AssertionFile != "":
+		orgName := data.Get("saml2_org_name").(string)
+		if orgName == "" || oauthclientID == "" || oauthclientSecret == "" {
+			return nil, diag.Errorf("saml2_assertion_file requires saml2_org_name, oauthclient_id and oauthclient_secret to be set")
+		}
+		return &clientAuthorizer{
+			mode: "SAML2 bearer",
+			authorize: func(config *platformclientv2.Configuration) diag.Diagnostics {
+				return authorizeSaml2Bearer(config, oauthclientID, oauthclientSecret, orgName, saml2AssertionFile)
+			},
+			renewable: true,
+		}, nil
+	default:
+		return &clientAuthorizer{
+			mode: "client credentials",
+			authorize: func(config *platformclientv2.Configuration) diag.Diagnostics {
+				return authorizeClientCredentials(config, oauthclientID, oauthclientSecret)
+			},
+			renewable: true,
+		}, nil
+	}
+}
+
+// authorizeWithTokenFile sets the access token stored in a file. The file is read again whenever the token is renewed,
+// so it can be replaced by another process.
+func authorizeWithTokenFile(config *platformclientv2.Configuration, path string) diag.Diagnostics {
+	data, err := os.ReadFile(path)
+	if err != nil {
+		return diag.Errorf("failed to read access token file %s: %v", path, err)
+	}
+	token := strings.TrimSpace(string(data))
+	if token == "" {
+		return diag.Errorf("access token file %s is empty", path)
+	}
+	config.AccessToken = token
+	config.AccessTokenExpiresIn = 0
+	return nil
+}
+
+// authorizeWithTokenCommand sets the access token printed by a command. The command prints either the token or a JSON
+// object with an access_token and optionally the expires_in seconds of the token.
+func authorizeWithTokenCommand(config *platformclientv2.Configuration, command string) diag.Diagnostics {
+	ctx, cancel := context.WithTimeout(context.Background(), tokenCommandTimeout)
+	defer cancel()
+
+	var cmd *exec.Cmd
+	if runtime.GOOS == "windows" {
+		cmd = exec.CommandContext(ctx, "cmd", "/C", command)
+	} else {
+		cmd = exec.CommandContext(ctx, "sh", "-c", command)
+	}
+	cmd.Stderr = os.Stderr
+	output, err := cmd.Output()
+	if err != nil {
+		return diag.Errorf("failed to run access token command: %v", err)
+	}
+
+	var tokenOutput tokenCommandOutput
+	trimmedOutput := strings.TrimSpace(string(output))
+	if strings.HasPrefix(trimmedOutput, "{") {
+		if err := json.Unmarshal([]byte(trimmedOutput), &tokenOutput); err != nil {
+			return diag.Errorf("failed to parse the output of the access token command: %v", err)
+		}
+	} else {
+		tokenOutput.AccessToken = trimmedOutput
+	}
+	if tokenOutput.AccessToken == "" {
+		return diag.Errorf("access token command did not print an access token")
+	}
+
+	config.AccessToken = tokenOutput.AccessToken
+	config.AccessTokenExpiresIn = tokenOutput.ExpiresIn
+	return nil
+}
+
+// authorizeSaml2Bearer exchanges the SAML2 assertion stored in a file for an access token. The file is read again whenever
+// the token is renewed, so the identity provider tooling can refresh the assertion.
+func authorizeSaml2Bearer(config *platformclientv2.Configuration, oauthclientID string, oauthclientSecret string, orgName string, assertionFile string) diag.Diagnostics {
+	data, err := os.ReadFile(assertionFile)
+	if err != nil {
+		return diag.Errorf("failed to read SAML2 assertion file %s: %v", assertionFile, err)
+	}
+	assertion := strings.TrimSpace(string(data))
+	if assertion == "" {
+		return diag.Errorf("SAML2 assertion file %s is empty", assertionFile)
+	}
+
+	authHost := regexp.MustCompile(`(?i)//api\.`).ReplaceAllString(config.BasePath, "//login.")
+	headerParams := map[string]string{
+		"Authorization": "Basic " + base64.StdEncoding.EncodeToString([]byte(oauthclientID+":"+oauthclientSecret)),
+	}
+	formParams := url.Values{
+		"grant_type": []string{saml2BearerGrantType},
+		"orgName":    []string{orgName},
+		"assertion":  []string{assertion},
+	}
+
+	return withRetries(context.Background(), time.Minute, func() *retry.RetryError {
+		response, err := config.APIClient.CallAPI(authHost+"/oauth/token", "POST", nil, headerParams, nil, formParams, "", nil, "login")
+		if err != nil && response == nil {
+			return retry.NonRetryableError(fmt.Errorf("failed to authorize Genesys Cloud SAML2 bearer grant: %v", err))
+		}
+		if response.StatusCode == http.StatusTooManyRequests {
+			return retry.RetryableError(fmt.Errorf("exhausted retries on Genesys Cloud SAML2 bearer grant. %s", response.RawBody))
+		}
+		if response.StatusCode != http.StatusOK {
+			var authErrorResponse platformclientv2.AuthErrorResponse
+			_ = json.Unmarshal([]byte(response.RawBody), &authErrorResponse)
+			return retry.NonRetryableError(fmt.Errorf("failed to authorize Genesys Cloud SAML2 bearer grant: %d - %s (%s)", response.StatusCode, authErrorResponse.Error, authErrorResponse.ErrorDescription))
+		}
+
+		var authResponse platformclientv2.AuthResponse
+		if err := json.Unmarshal([]byte(response.RawBody), &authResponse); err != nil {
+			return retry.NonRetryableError(fmt.Errorf("failed to parse Genesys Cloud SAML2 bearer grant: %v", err))
+		}
+		if authResponse.AccessToken == "" {
+			return retry.NonRetryableError(fmt.Errorf("no access token found in Genesys Cloud SAML2 bearer grant"))
+		}
+		config.AccessToken = authResponse.AccessToken
+		config.AccessTokenExpiresIn = authResponse.ExpiresIn
+		log.Printf("Authorized SAML2 bearer grant for org %s", orgName)
+		return nil
+	})
+}
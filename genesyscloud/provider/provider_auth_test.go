@@ -0,0 +1,94 @@
+package provider
+
+import (
+	"os"
+	"path/filepath"
+	"runtime"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/mypurecloud/platform-client-sdk-go/v152/platformclientv2"
+)
+
+func newTestProviderConfig(t *testing.T, raw map[string]interface{}) *schema.ResourceData {
+	for _, envVar := range []string{"GENESYSCLOUD_ACCESS_TOKEN", "GENESYSCLOUD_ACCESS_TOKEN_FILE", "GENESYSCLOUD_ACCESS_TOKEN_COMMAND", "GENESYSCLOUD_SAML2_ASSERTION_FILE", "GENESYSCLOUD_SAML2_ORG_NAME", "GENESYSCLOUD_OAUTHCLIENT_ID", "GENESYSCLOUD_OAUTHCLIENT_SECRET"} {
+		t.Setenv(envVar, "")
+	}
+	providerSchema := New("0.1.0", make(map[string]*schema.Resource), make(map[string]*schema.Resource))().Schema
+	return schema.TestResourceDataRaw(t, providerSchema, raw)
+}
+
+func TestUnitClientAuthorizerTokenFile(t *testing.T) {
+	tokenFile := filepath.Join(t.TempDir(), "token")
+	if err := os.WriteFile(tokenFile, []byte("first-token\n"), 0600); err != nil {
+		t.Fatal(err)
+	}
+	authorizer, diagErr := newClientAuthorizer(newTestProviderConfig(t, map[string]interface{}{"access_token_file": tokenFile}))
+	if diagErr != nil {
+		t.Fatalf("Unexpected error: %v", diagErr)
+	}
+	if !authorizer.renewable {
+		t.Errorf("Expected tokens of a file to be renewable")
+	}
+
+	config := platformclientv2.NewConfiguration()
+	if diagErr := authorizer.authorize(config); diagErr != nil || config.AccessToken != "first-token" {
+		t.Errorf("Expected first-token, got %s: %v", config.AccessToken, diagErr)
+	}
+
+	// The file is read again when the token is renewed
+	if err := os.WriteFile(tokenFile, []byte("second-token"), 0600); err != nil {
+		t.Fatal(err)
+	}
+	if diagErr := authorizer.authorize(config); diagErr != nil || config.AccessToken != "second-token" {
+		t.Errorf("Expected second-token, got %s: %v", config.AccessToken, diagErr)
+	}
+}
+
+func TestUnitClientAuthorizerTokenCommand(t *testing.T) {
+	if runtime.GOOS == "windows" {
+		t.Skip("The test commands require a POSIX shell")
+	}
+	testCases := map[string]struct {
+		command   string
+		token     string
+		expiresIn int
+	}{
+		"plain token": {command: "echo plain-token", token: "plain-token"},
+		"json token":  {command: `echo '{"access_token": "json-token", "expires_in": 3600}'`, token: "json-token", expiresIn: 3600},
+	}
+	for name, testCase := range testCases {
+		t.Run(name, func(t *testing.T) {
+			authorizer, diagErr := newClientAuthorizer(newTestProviderConfig(t, map[string]interface{}{"access_token_command": testCase.command}))
+			if diagErr != nil {
+				t.Fatalf("Unexpected error: %v", diagErr)
+			}
+			config := platformclientv2.NewConfiguration()
+			if diagErr := authorizer.authorize(config); diagErr != nil {
+				t.Fatalf("Unexpected error: %v", diagErr)
+			}
+			if config.AccessToken != testCase.token || config.AccessTokenExpiresIn != testCase.expiresIn {
+				t.Errorf("Expected %s expiring in %d, got %s expiring in %d", testCase.token, testCase.expiresIn, config.AccessToken, config.AccessTokenExpiresIn)
+			}
+		})
+	}
+}
+
+func TestUnitClientAuthorizerModes(t *testing.T) {
+	authorizer, diagErr := newClientAuthorizer(newTestProviderConfig(t, map[string]interface{}{"access_token": "token"}))
+	if diagErr != nil || authorizer.renewable {
+		t.Errorf("Expected a static access token not to be renewable: %v", diagErr)
+	}
+
+	authorizer, diagErr = newClientAuthorizer(newTestProviderConfig(t, map[string]interface{}{"oauthclient_id": "id", "oauthclient_secret": "secret"}))
+	if diagErr != nil || authorizer.mode != "client credentials" {
+		t.Errorf("Expected client credentials by default: %v", diagErr)
+	}
+
+	if _, diagErr := newClientAuthorizer(newTestProviderConfig(t, map[string]interface{}{"access_token": "token", "access_token_command": "echo token"})); diagErr == nil {
+		t.Errorf("Expected an error when several authentication modes are set")
+	}
+	if _, diagErr := newClientAuthorizer(newTestProviderConfig(t, map[string]interface{}{"saml2_assertion_file": "assertion"})); diagErr == nil {
+		t.Errorf("Expected an error when saml2_assertion_file is set without saml2_org_name and client credentials")
+	}
+}
@@ -0,0 +1,80 @@
+package provider
+
+import (
+	"log"
+	"net/http"
+	"sync"
+	"terraform-provider-genesyscloud/genesyscloud/util/ratelimit"
+	"time"
+)
+
+/*
+This file contains the rate limiter shared by every SDK client of a provider configuration. All requests wait for the
+limiter before they are sent, so the pooled clients stay within one requests per second budget of the org. Whenever the
+API responds with a 429, all clients of the configuration pause for the duration of the Retry-After header instead of
+retrying independently. Every client pool has a limiter of its own, so provider configurations for other orgs are
+neither limited nor paused by it.
+*/
+
+// Pause used when a 429 response has no Retry-After header
+const defaultRetryAfter = 3 * time.Second
+
+type apiRateLimiter struct {
+	mutex             sync.RWMutex
+	bucket            *ratelimit.TokenBucket
+	requestsPerSecond int
+}
+
+func newAPIRateLimiter(requestsPerSecond int) *apiRateLimiter {
+	limiter := &apiRateLimiter{bucket: ratelimit.NewTokenBucket(0, 0)}
+	limiter.configure(requestsPerSecond)
+	return limiter
+}
+
+// configure limits the requests of the SDK clients to requestsPerSecond. A value of 0 means unlimited.
+func (l *apiRateLimiter) configure(requestsPerSecond int) {
+	l.mutex.Lock()
+	defer l.mutex.Unlock()
+	if l.requestsPerSecond == requestsPerSecond {
+		return
+	}
+	l.requestsPerSecond = requestsPerSecond
+	l.bucket = ratelimit.NewTokenBucket(float64(requestsPerSecond), requestsPerSecond)
+	if requestsPerSecond > 0 {
+		log.Printf("Limiting API requests to %d per second.", requestsPerSecond)
+	}
+}
+
+func (l *apiRateLimiter) getBucket() *ratelimit.TokenBucket {
+	l.mutex.RLock()
+	defer l.mutex.RUnlock()
+	return l.bucket
+}
+
+// wait blocks until the request may be sent or its context is done
+func (l *apiRateLimiter) wait(request *http.Request) {
+	if l == nil {
+		return
+	}
+	start := time.Now()
+	if err := l.getBucket().Wait(request.Context()); err != nil {
+		log.Printf("Stopped waiting for the API rate limiter: %v", err)
+		return
+	}
+	if waited := time.Since(start); waited > time.Second {
+		log.Printf("Waited %v for the API rate limiter before %s %s", waited.Round(time.Millisecond), request.Method, request.URL.Path)
+	}
+}
+
+// observeResponse pauses all requests when the API responds with a 429
+func (l *apiRateLimiter) observeResponse(response *http.Response) {
+	if l == nil || response == nil || response.StatusCode != http.StatusTooManyRequests {
+		return
+	}
+	retryAfter, ok := ratelimit.ParseRetryAfter(response.Header.Get("Retry-After"))
+	if !ok {
+		retryAfter = defaultRetryAfter
+	}
+	log.Printf("API rate limit exceeded. Pausing all requests for %v.", retryAfter)
+	l.getBucket().PauseFor(retryAfter)
+}
@@ -0,0 +1,39 @@
+package provider
+
+import (
+	"context"
+	"net/http"
+	"testing"
+	"time"
+)
+
+func TestUnitAPIRateLimiterPausesOnRateLimit(t *testing.T) {
+	limiter := newAPIRateLimiter(0)
+
+	limiter.observeResponse(&http.Response{StatusCode: http.StatusOK, Header: http.Header{"Retry-After": []string{"5"}}})
+	ctx, cancel := context.WithTimeout(context.Background(), 50*time.Millisecond)
+	defer cancel()
+	if err := limiter.getBucket().Wait(ctx); err != nil {
+		t.Errorf("Expected requests not to be paused after a 200 response, got %v", err)
+	}
+
+	// A 429 without a Retry-After header pauses all requests for the default duration
+	limiter.observeResponse(&http.Response{StatusCode: http.StatusTooManyRequests, Header: http.Header{}})
+	if err := limiter.getBucket().Wait(ctx); err == nil {
+		t.Errorf("Expected requests to be paused after a 429 response")
+	}
+}
+
+func TestUnitAPIRateLimiterLimitsRequestsPerSecond(t *testing.T) {
+	limiter := newAPIRateLimiter(20)
+
+	request, _ := http.NewRequest(http.MethodGet, "https://api.mypurecloud.com/api/v2/users", nil)
+	start := time.Now()
+	for i := 0; i < 25; i++ {
+		limiter.wait(request)
+	}
+	// The first 20 requests are sent immediately and the next 5 take 50ms each
+	if elapsed := time.Since(start); elapsed < 200*time.Millisecond {
+		t.Errorf("Expected 25 requests to take at least 200ms, took %v", elapsed)
+	}
+}
@@ -0,0 +1,56 @@
+package provider
+
+import (
+	"net/http"
+	"sync"
+)
+
+// responseObservers are functions that are called with every response received by the SDK clients of a provider
+// configuration, e.g. to react to rate limiting
+type responseObservers struct {
+	mutex     sync.RWMutex
+	observers map[int]func(*http.Response)
+	nextId    int
+}
+
+func newResponseObservers() *responseObservers {
+	return &responseObservers{observers: make(map[int]func(*http.Response))}
+}
+
+// register adds an observer. The returned function unregisters it.
+func (o *responseObservers) register(observer func(*http.Response)) func() {
+	o.mutex.Lock()
+	defer o.mutex.Unlock()
+
+	id := o.nextId
+	o.nextId++
+	o.observers[id] = observer
+
+	return func() {
+		o.mutex.Lock()
+		defer o.mutex.Unlock()
+		delete(o.observers, id)
+	}
+}
+
+func (o *responseObservers) notify(response *http.Response) {
+	if o == nil {
+		return
+	}
+	o.mutex.RLock()
+	defer o.mutex.RUnlock()
+	for _, observer := range o.observers {
+		observer(response)
+	}
+}
+
+// RegisterResponseObserver registers a function that is called with every response received by the SDK clients of the
+// provider configuration. Responses of other provider configurations are not observed. The returned function
+// unregisters the observer.
+func (p *ProviderMeta) RegisterResponseObserver(observer func(*http.Response)) func() {
+	pool := p.getClientPool()
+	if pool == nil || pool.responseObservers == nil {
+		return func() {}
+	}
+	return pool.responseObservers.register(observer)
+}
@@ -0,0 +1,48 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+	"reflect"
+	"runtime"
+	"strings"
+	"terraform-provider-genesyscloud/genesyscloud/util/constants"
+	"terraform-provider-genesyscloud/genesyscloud/util/tracing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"go.opentelemetry.io/otel/attribute"
+)
+
+// traceOperation wraps a resource operation in a span named after the operation and the package of the resource method,
+// e.g. "Create routing_queue"
+func traceOperation(wrapped resContextFunc, operation constants.CRUDOperation, method any) resContextFunc {
+	packageName := getPackageName(method)
+	spanName := fmt.Sprintf("%s %s", operation, packageName)
+	return func(ctx context.Context, r *schema.ResourceData, meta interface{}) diag.Diagnostics {
+		ctx, span := tracing.StartSpan(ctx, spanName,
+			attribute.String("genesyscloud.operation", operation.String()),
+			attribute.String("genesyscloud.package", packageName),
+			attribute.String("genesyscloud.resource_id", r.Id()),
+		)
+		diagErr := wrapped(ctx, r, meta)
+		span.SetAttributes(attribute.String("genesyscloud.resource_id", r.Id()))
+		tracing.EndSpan(span, tracing.DiagnosticsError(diagErr))
+		return diagErr
+	}
+}
+
+// getPackageName returns the name of the package of a function, which is named after the resource type it belongs to
+func getPackageName(method any) string {
+	function := runtime.FuncForPC(reflect.ValueOf(method).Pointer())
+	if function == nil {
+		return "unknown"
+	}
+	// Function names look like terraform-provider-genesyscloud/genesyscloud/routing_queue.createQueue
+	name := function.Name()
+	name = name[strings.LastIndex(name, "/")+1:]
+	if index := strings.Index(name, "."); index != -1 {
+		name = name[:index]
+	}
+	return name
+}
@@ -4,6 +4,9 @@ import (
 	"fmt"
 	"strings"
 	"sync"
+	cc "terraform-provider-genesyscloud/genesyscloud/consistency_checker"
+	rc "terraform-provider-genesyscloud/genesyscloud/resource_cache"
+	"time"
 
 	"github.com/hashicorp/go-cty/cty"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
@@ -11,6 +14,7 @@ import (
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+	"github.com/mypurecloud/platform-client-sdk-go/v152/platformclientv2"
 )
 
 // ProviderFactories are used to instantiate a provider during acceptance testing.
@@ -77,6 +81,94 @@ func validateLogFilePath(filepath any, _ cty.Path) (err diag.Diagnostics) {
 	return err
 }
 
+// validateDuration validates that a value can be parsed as a positive duration, e.g. "30m"
+// (Keeping this inside validators causes import cycle)
+func validateDuration(duration any, _ cty.Path) diag.Diagnostics {
+	val, ok := duration.(string)
+	if !ok {
+		return diag.Errorf("expected type of %v to be string, got %T", duration, duration)
+	}
+	parsed, err := time.ParseDuration(val)
+	if err != nil {
+		return diag.Errorf("%s is not a valid duration: %v", val, err)
+	}
+	if parsed <= 0 {
+		return diag.Errorf("duration must be positive, got: %s", val)
+	}
+	return nil
+}
+
+// configureDataSourceDiskCache enables the disk cache of data source lookups for the org of the provider
+func configureDataSourceDiskCache(data *schema.ResourceData, org *platformclientv2.Organization) diag.Diagnostics {
+	ttl, err := time.ParseDuration(data.Get("data_source_cache_ttl").(string))
+	if err != nil {
+		return diag.Errorf("invalid data_source_cache_ttl: %v", err)
+	}
+	if err := rc.ConfigureDataSourceDiskCache(data.Get("data_source_cache_dir").(string), *org.Id, data.Get("aws_region").(string), ttl); err != nil {
+		return diag.FromErr(err)
+	}
+	if data.Get("invalidate_data_source_cache").(bool) {
+		if err := rc.InvalidateDataSourceDiskCache(*org.Id, data.Get("aws_region").(string)); err != nil {
+			return diag.FromErr(err)
+		}
+	}
+	return nil
+}
+
+// configureConsistencyChecker sets the consistency checker settings of every resource type in the provider block for the
+// org of the provider configuration
+func configureConsistencyChecker(data *schema.ResourceData, orgKey string) diag.Diagnostics {
+	configs := make(map[string]cc.ResourceTypeConfig)
+
+	// The raw config tells whether max_checks and bypass were set, as unset values fall back to the global settings
+	rawConfigs := cty.NilVal
+	if rawConfig := data.GetRawConfig(); rawConfig.IsKnown() && !rawConfig.IsNull() {
+		rawConfigs = rawConfig.GetAttr("consistency_checker")
+	}
+	for i, configItem := range data.Get("consistency_checker").([]interface{}) {
+		configMap := configItem.(map[string]interface{})
+		resourceType := configMap["resource_type"].(string)
+		if _, exists := configs[resourceType]; exists {
+			return diag.Errorf("consistency_checker is configured more than once for %s", resourceType)
+		}
+
+		var config cc.ResourceTypeConfig
+		var rawConfig cty.Value
+		if rawConfigs != cty.NilVal && rawConfigs.IsKnown() && !rawConfigs.IsNull() && i < rawConfigs.LengthInt() {
+			rawConfig = rawConfigs.Index(cty.NumberIntVal(int64(i)))
+		}
+		if isRawAttributeSet(rawConfig, "max_checks") {
+			maxChecks := configMap["max_checks"].(int)
+			config.MaxChecks = &maxChecks
+		}
+		if isRawAttributeSet(rawConfig, "bypass") {
+			bypass := configMap["bypass"].(bool)
+			config.Bypass = &bypass
+		}
+		if backoff := configMap["backoff"].(string); backoff != "" {
+			duration, err := time.ParseDuration(backoff)
+			if err != nil {
+				return diag.Errorf("invalid consistency_checker backoff for %s: %v", resourceType, err)
+			}
+			config.Backoff = duration
+		}
+		for _, attribute := range configMap["ignore_attributes"].([]interface{}) {
+			config.IgnoreAttributes = append(config.IgnoreAttributes, attribute.(string))
+		}
+		configs[resourceType] = config
+	}
+
+	cc.SetResourceTypeConfigs(orgKey, configs)
+	return nil
+}
+
+func isRawAttributeSet(rawConfig cty.Value, attribute string) bool {
+	if rawConfig == cty.NilVal || !rawConfig.IsKnown() || rawConfig.IsNull() {
+		return false
+	}
+	return !rawConfig.GetAttr(attribute).IsNull()
+}
+
 // Ensure the Meta (with ClientCredentials) is accessible throughout the provider, especially
 // within acceptance testing
 var (
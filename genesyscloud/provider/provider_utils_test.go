@@ -1,7 +1,15 @@
 package provider
 
 import (
+	"context"
+	cc "terraform-provider-genesyscloud/genesyscloud/consistency_checker"
 	"testing"
+	"time"
+
+	ctyjson "github.com/hashicorp/go-cty/cty/json"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
 )
 
 func TestUnitValidateLogFilePath(t *testing.T) {
@@ -64,3 +72,100 @@ func TestUnitValidateLogFilePath(t *testing.T) {
 		})
 	}
 }
+
+func TestUnitValidateDuration(t *testing.T) {
+	testCases := []struct {
+		name        string
+		duration    interface{}
+		expectError bool
+	}{
+		{
+			name:        "Valid duration",
+			duration:    "30m",
+			expectError: false,
+		},
+		{
+			name:        "Compound duration",
+			duration:    "1h30m",
+			expectError: false,
+		},
+		{
+			name:        "Missing unit",
+			duration:    "30",
+			expectError: true,
+		},
+		{
+			name:        "Zero duration",
+			duration:    "0s",
+			expectError: true,
+		},
+		{
+			name:        "Non-string value",
+			duration:    123,
+			expectError: true,
+		},
+	}
+
+	for _, currentTestCase := range testCases {
+		t.Run(currentTestCase.name, func(t *testing.T) {
+			diagErr := validateDuration(currentTestCase.duration, nil)
+			if currentTestCase.expectError && diagErr == nil {
+				t.Fatalf("Expected an error, but got none")
+			}
+			if !currentTestCase.expectError && diagErr != nil {
+				t.Fatalf("Unexpected error: %v", diagErr)
+			}
+		})
+	}
+}
+
+func TestUnitConfigureConsistencyChecker(t *testing.T) {
+	providerSchema := New("0.1.0", make(map[string]*schema.Resource), make(map[string]*schema.Resource))().Schema
+	configSchema := schema.InternalMap(providerSchema).CoreConfigSchema()
+	configJson := `{
+		"consistency_checker": [
+			{"resource_type": "genesyscloud_routing_queue", "max_checks": 10, "backoff": "5s", "bypass": true, "ignore_attributes": ["members"]},
+			{"resource_type": "genesyscloud_user", "bypass": false}
+		]
+	}`
+	configValue, err := ctyjson.Unmarshal([]byte(configJson), configSchema.ImpliedType())
+	if err != nil {
+		t.Fatalf("Failed to build provider config: %v", err)
+	}
+
+	// The provider is configured the way Terraform does it, so the raw config is available
+	testProvider := &schema.Provider{
+		Schema: providerSchema,
+		ConfigureContextFunc: func(ctx context.Context, data *schema.ResourceData) (interface{}, diag.Diagnostics) {
+			return nil, configureConsistencyChecker(data, "test-org")
+		},
+	}
+	config := terraform.NewResourceConfigShimmed(configValue, configSchema)
+	config.CtyValue = configValue
+	if diagErr := testProvider.Configure(context.Background(), config); diagErr != nil {
+		t.Fatalf("Unexpected error: %v", diagErr)
+	}
+	defer cc.SetResourceTypeConfigs("test-org", make(map[string]cc.ResourceTypeConfig))
+
+	queueConfig := cc.GetResourceTypeConfig("test-org", "genesyscloud_routing_queue")
+	if queueConfig.MaxChecks == nil || *queueConfig.MaxChecks != 10 {
+		t.Errorf("Expected max_checks 10, got %v", queueConfig.MaxChecks)
+	}
+	if queueConfig.Bypass == nil || !*queueConfig.Bypass {
+		t.Errorf("Expected bypass true, got %v", queueConfig.Bypass)
+	}
+	if queueConfig.Backoff != 5*time.Second {
+		t.Errorf("Expected backoff 5s, got %v", queueConfig.Backoff)
+	}
+	if len(queueConfig.IgnoreAttributes) != 1 || queueConfig.IgnoreAttributes[0] != "members" {
+		t.Errorf("Expected ignore_attributes [members], got %v", queueConfig.IgnoreAttributes)
+	}
+
+	userConfig := cc.GetResourceTypeConfig("test-org", "genesyscloud_user")
+	if userConfig.MaxChecks != nil {
+		t.Errorf("Expected max_checks not to be set, got %d", *userConfig.MaxChecks)
+	}
+	if userConfig.Bypass == nil || *userConfig.Bypass {
+		t.Errorf("Expected bypass false, got %v", userConfig.Bypass)
+	}
+}
@@ -2,11 +2,19 @@ package provider
 
 import (
 	"context"
+	"crypto/sha256"
+	"encoding/hex"
 	"log"
+	"net/http"
+	"strings"
 	"sync"
+	"sync/atomic"
+	rc "terraform-provider-genesyscloud/genesyscloud/resource_cache"
 	resourceExporter "terraform-provider-genesyscloud/genesyscloud/resource_exporter"
 	"terraform-provider-genesyscloud/genesyscloud/util/constants"
 	prl "terraform-provider-genesyscloud/genesyscloud/util/panic_recovery_logger"
+	"terraform-provider-genesyscloud/genesyscloud/util/tracing"
+	"time"
 
 	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
@@ -17,78 +25,340 @@ import (
 // acquired at the beginning of any resource operation and released on completion.
 // This has the benefit of ensuring we don't issue too many concurrent requests and also
 // increases throughput as each token will have its own rate limit.
+// The Pool grows on demand up to its max size and shrinks back to its min size when clients are idle.
+// Clients are health checked whenever they are acquired, so expired or rejected tokens are renewed.
 type SDKClientPool struct {
-	Pool chan *platformclientv2.Configuration
+	Pool    chan *platformclientv2.Configuration
+	minSize int
+
+	// DefaultConfig is the client of the provider configuration that is used outside of resource operations
+	DefaultConfig *platformclientv2.Configuration
+
+	// initClientFunc initializes and authorizes a new client. authorizeFunc renews the token of a client, and is nil
+	// if tokens cannot be renewed, e.g. when an access token is set.
+	initClientFunc func(*platformclientv2.Configuration) diag.Diagnostics
+	authorizeFunc  func(*platformclientv2.Configuration) diag.Diagnostics
+
+	mutex     sync.Mutex
+	size      int
+	clients   map[*platformclientv2.Configuration]*pooledClient
+	discarded chan struct{}
+	metrics   sdkClientPoolMetrics
+
+	// The rate limiter and response observers of the clients of the Pool. They are not shared with other Pools, so
+	// the requests of one org never wait for, or are paused by, another org.
+	rateLimiter       *apiRateLimiter
+	responseObservers *responseObservers
+}
+
+type pooledClient struct {
+	authorizedAt time.Time
+	lastUsed     time.Time
+	unauthorized atomic.Bool
+}
+
+type sdkClientPoolMetrics struct {
+	mutex        sync.Mutex
+	acquisitions int
+	waits        int
+	totalWait    time.Duration
+	maxWait      time.Duration
 }
 
+const (
+	// Tokens are renewed before they expire, so they never expire while an operation is running
+	tokenRenewalMargin = 10 * time.Minute
+
+	sdkClientIdleTimeout      = 5 * time.Minute
+	sdkClientPoolShrinkPeriod = time.Minute
+	sdkClientWaitLogInterval  = 30 * time.Second
+)
+
+// Pools by the org credentials they were created for. Every provider configuration has its own Pool, but configurations
+// with the same credentials in one process, e.g. the steps of an acceptance test, share it.
+var (
+	sdkClientPools      = make(map[string]*SDKClientPool)
+	sdkClientPoolsMutex sync.Mutex
+)
+
+// SdkClientPool is the Pool of the first provider configuration. It is used by code that does not know its provider
+// configuration.
 var SdkClientPool *SDKClientPool
-var SdkClientPoolErr diag.Diagnostics
-var Once sync.Once
 
-// InitSDKClientPool creates a new Pool of Clients with the given provider config
+// InitSDKClientPool returns the Pool of Clients for the given provider config, creating it if needed
 // This must be called during provider initialization before the Pool is used
-func InitSDKClientPool(max int, version string, providerConfig *schema.ResourceData) diag.Diagnostics {
-	Once.Do(func() {
-		log.Print("Initializing default SDK client.")
-		// Initialize the default config for tests and anything else that doesn't use the Pool
-		err := InitClientConfig(providerConfig, version, platformclientv2.GetDefaultConfiguration())
-		if err != nil {
-			SdkClientPoolErr = err
-			return
-		}
+func InitSDKClientPool(min int, max int, version string, providerConfig *schema.ResourceData) (*SDKClientPool, diag.Diagnostics) {
+	sdkClientPoolsMutex.Lock()
+	defer sdkClientPoolsMutex.Unlock()
 
-		log.Printf("Initializing %d SDK clients in the Pool.", max)
-		SdkClientPool = &SDKClientPool{
-			Pool: make(chan *platformclientv2.Configuration, max),
-		}
-		SdkClientPoolErr = SdkClientPool.preFill(providerConfig, version)
-	})
-	return SdkClientPoolErr
+	requestsPerSecond := providerConfig.Get("api_requests_per_second").(int)
+	poolKey := getSDKClientPoolKey(providerConfig)
+	if pool, ok := sdkClientPools[poolKey]; ok {
+		pool.rateLimiter.configure(requestsPerSecond)
+		return pool, nil
+	}
+
+	rateLimiter := newAPIRateLimiter(requestsPerSecond)
+	observers := newResponseObservers()
+
+	// Initialize the default config for tests and anything else that doesn't use the Pool. Only the first provider
+	// configuration uses the default config of the Go SDK, so the configurations of other orgs do not replace it.
+	log.Print("Initializing default SDK client.")
+	defaultConfig := platformclientv2.NewConfiguration()
+	if SdkClientPool == nil {
+		defaultConfig = platformclientv2.GetDefaultConfiguration()
+	}
+	if err := initClientConfig(providerConfig, version, defaultConfig, true, rateLimiter, observers); err != nil {
+		return nil, err
+	}
+
+	initClientFunc := func(config *platformclientv2.Configuration) diag.Diagnostics {
+		// The Pool renews tokens itself when clients are acquired
+		return initClientConfig(providerConfig, version, config, false, rateLimiter, observers)
+	}
+	authorizer, err := newClientAuthorizer(providerConfig)
+	if err != nil {
+		return nil, err
+	}
+	var authorizeFunc func(*platformclientv2.Configuration) diag.Diagnostics
+	if authorizer.renewable {
+		authorizeFunc = authorizer.authorize
+	}
+
+	log.Printf("Initializing %d SDK clients in the Pool. The Pool can grow up to %d clients.", min, max)
+	pool := newSDKClientPool(min, max, initClientFunc, authorizeFunc)
+	pool.DefaultConfig = defaultConfig
+	pool.rateLimiter = rateLimiter
+	pool.responseObservers = observers
+	if err := pool.preFill(); err != nil {
+		return nil, err
+	}
+	go pool.shrinkPeriodically(sdkClientPoolShrinkPeriod)
+
+	sdkClientPools[poolKey] = pool
+	if SdkClientPool == nil {
+		SdkClientPool = pool
+	}
+	return pool, nil
 }
 
-func (p *SDKClientPool) preFill(providerConfig *schema.ResourceData, version string) diag.Diagnostics {
-	errorChan := make(chan diag.Diagnostics)
-	wgDone := make(chan bool)
+// getSDKClientPoolKey identifies the org and credentials of a provider configuration. Secrets are hashed, so they are
+// not kept in memory any longer than needed.
+func getSDKClientPoolKey(providerConfig *schema.ResourceData) string {
+	hash := sha256.Sum256([]byte(strings.Join([]string{
+		strings.ToLower(providerConfig.Get("aws_region").(string)),
+		providerConfig.Get("oauthclient_id").(string),
+		providerConfig.Get("oauthclient_secret").(string),
+		providerConfig.Get("access_token").(string),
+		providerConfig.Get("access_token_file").(string),
+		providerConfig.Get("access_token_command").(string),
+		providerConfig.Get("saml2_assertion_file").(string),
+	}, "\x00")))
+	return hex.EncodeToString(hash[:])
+}
+
+func newSDKClientPool(min int, max int, initClientFunc func(*platformclientv2.Configuration) diag.Diagnostics, authorizeFunc func(*platformclientv2.Configuration) diag.Diagnostics) *SDKClientPool {
+	return &SDKClientPool{
+		Pool:           make(chan *platformclientv2.Configuration, max),
+		minSize:        min,
+		initClientFunc: initClientFunc,
+		authorizeFunc:  authorizeFunc,
+		clients:        make(map[*platformclientv2.Configuration]*pooledClient),
+		discarded:      make(chan struct{}, max),
+	}
+}
+
+// preFill creates the min number of clients of the Pool concurrently
+func (p *SDKClientPool) preFill() diag.Diagnostics {
+	errorChan := make(chan diag.Diagnostics, p.minSize)
 	var wg sync.WaitGroup
 
-	ctx, cancel := context.WithCancel(context.Background())
-	defer cancel()
-	for i := 0; i < cap(p.Pool); i++ {
-		sdkConfig := platformclientv2.NewConfiguration()
+	for i := 0; i < p.minSize && p.reserve(); i++ {
 		wg.Add(1)
 		go func() {
 			defer wg.Done()
-			err := InitClientConfig(providerConfig, version, sdkConfig)
+			sdkConfig, err := p.createClient()
 			if err != nil {
-				select {
-				case <-ctx.Done():
-				case errorChan <- err:
-				}
-				cancel()
+				errorChan <- err
 				return
 			}
+			p.Pool <- sdkConfig
 		}()
-		p.Pool <- sdkConfig
 	}
-	go func() {
-		wg.Wait()
-		close(wgDone)
+	wg.Wait()
+	close(errorChan)
+
+	return <-errorChan
+}
+
+// reserve claims a place in the Pool for a new client. It returns false if the Pool is at its max size.
+func (p *SDKClientPool) reserve() bool {
+	p.mutex.Lock()
+	defer p.mutex.Unlock()
+	if p.size >= cap(p.Pool) {
+		return false
+	}
+	p.size++
+	return true
+}
+
+// createClient initializes a new client in a place claimed with reserve
+func (p *SDKClientPool) createClient() (*platformclientv2.Configuration, diag.Diagnostics) {
+	sdkConfig := platformclientv2.NewConfiguration()
+	if err := p.initClientFunc(sdkConfig); err != nil {
+		p.discard(sdkConfig)
+		return nil, err
+	}
+
+	client := &pooledClient{authorizedAt: time.Now(), lastUsed: time.Now()}
+	p.watchUnauthorized(sdkConfig, client)
+
+	p.mutex.Lock()
+	defer p.mutex.Unlock()
+	p.clients[sdkConfig] = client
+	log.Printf("Created SDK client. The Pool has %d clients.", p.size)
+	return sdkConfig, nil
+}
+
+// watchUnauthorized flags a client when a request is rejected with a 401, so its token is renewed before it is used again
+func (p *SDKClientPool) watchUnauthorized(sdkConfig *platformclientv2.Configuration, client *pooledClient) {
+	if sdkConfig.RetryConfiguration == nil {
+		return
+	}
+	responseLogHook := sdkConfig.RetryConfiguration.ResponseLogHook
+	sdkConfig.RetryConfiguration.ResponseLogHook = func(response *http.Response) {
+		if response.StatusCode == http.StatusUnauthorized {
+			client.unauthorized.Store(true)
+		}
+		if responseLogHook != nil {
+			responseLogHook(response)
+		}
+	}
+}
+
+// discard removes a client from the Pool, so a new one can be created in its place
+func (p *SDKClientPool) discard(sdkConfig *platformclientv2.Configuration) {
+	p.mutex.Lock()
+	defer p.mutex.Unlock()
+	delete(p.clients, sdkConfig)
+	p.size--
+
+	// Wake up one caller waiting for a client, so it can create a new one
+	select {
+	case p.discarded <- struct{}{}:
+	default:
+	}
+}
+
+func (p *SDKClientPool) getClient(sdkConfig *platformclientv2.Configuration) *pooledClient {
+	p.mutex.Lock()
+	defer p.mutex.Unlock()
+	return p.clients[sdkConfig]
+}
+
+// acquire returns a healthy client of the Pool. If all clients are in use, a new one is created unless the Pool is at its
+// max size, in which case it waits for a client to be released or for the context to be cancelled.
+func (p *SDKClientPool) acquire(ctx context.Context) (sdkConfig *platformclientv2.Configuration, diagErr diag.Diagnostics) {
+	_, span := tracing.StartSpan(ctx, "Acquire SDK client")
+	defer func() {
+		tracing.EndSpan(span, tracing.DiagnosticsError(diagErr))
 	}()
 
-	// Wait until either WaitGroup is done or an error is received
+	start := time.Now()
+	sdkConfig, diagErr = p.acquireClient(ctx)
+	p.metrics.record(time.Since(start))
+	if diagErr != nil {
+		return nil, diagErr
+	}
+
+	if diagErr := p.checkHealth(sdkConfig); diagErr != nil {
+		p.discard(sdkConfig)
+		return nil, diagErr
+	}
+	return sdkConfig, nil
+}
+
+func (p *SDKClientPool) acquireClient(ctx context.Context) (*platformclientv2.Configuration, diag.Diagnostics) {
 	select {
-	case <-wgDone:
+	case sdkConfig := <-p.Pool:
+		return sdkConfig, nil
+	default:
+	}
+
+	for {
+		if p.reserve() {
+			return p.createClient()
+		}
+
+		waitStart := time.Now()
+		logTicker := time.NewTicker(sdkClientWaitLogInterval)
+		select {
+		case sdkConfig := <-p.Pool:
+			logTicker.Stop()
+			return sdkConfig, nil
+		case <-p.discarded:
+			// A client was removed from the Pool, so there is room for a new one
+			logTicker.Stop()
+		case <-ctx.Done():
+			logTicker.Stop()
+			return nil, diag.Errorf("cancelled while waiting %v for an SDK client from the Pool: %v", time.Since(waitStart).Round(time.Second), ctx.Err())
+		case <-logTicker.C:
+			logTicker.Stop()
+			log.Printf("Waiting %v for an SDK client. All %d clients of the Pool are in use.", time.Since(waitStart).Round(time.Second), cap(p.Pool))
+		}
+	}
+}
+
+// checkHealth renews the token of a client if it was rejected or is about to expire
+func (p *SDKClientPool) checkHealth(sdkConfig *platformclientv2.Configuration) diag.Diagnostics {
+	client := p.getClient(sdkConfig)
+	if client == nil {
+		return nil
+	}
+
+	expiresIn := time.Duration(sdkConfig.AccessTokenExpiresIn) * time.Second
+	isExpiring := expiresIn > 0 && time.Since(client.authorizedAt) > expiresIn-tokenRenewalMargin
+	if !client.unauthorized.Load() && !isExpiring {
 		return nil
-	case err := <-errorChan:
-		return err
 	}
+	if p.authorizeFunc == nil {
+		if client.unauthorized.CompareAndSwap(true, false) {
+			log.Print("WARNING: A request was rejected with a 401. The access token may have expired and cannot be renewed by the provider.")
+		}
+		return nil
+	}
+	return p.reauthorize(sdkConfig, client)
 }
 
-func (p *SDKClientPool) acquire() *platformclientv2.Configuration {
-	return <-p.Pool
+// reauthorize renews the token of a client
+func (p *SDKClientPool) reauthorize(sdkConfig *platformclientv2.Configuration, client *pooledClient) diag.Diagnostics {
+	log.Print("Renewing the token of an SDK client.")
+	if diagErr := p.authorizeFunc(sdkConfig); diagErr != nil {
+		return diagErr
+	}
+	client.authorizedAt = time.Now()
+	client.unauthorized.Store(false)
+	return nil
+}
+
+// renewIfUnauthorized renews the token of a client if one of its requests was rejected with a 401. It returns true if
+// the token was renewed, so the operation can be retried.
+func (p *SDKClientPool) renewIfUnauthorized(sdkConfig *platformclientv2.Configuration) bool {
+	client := p.getClient(sdkConfig)
+	if client == nil || p.authorizeFunc == nil || !client.unauthorized.Load() {
+		return false
+	}
+	if diagErr := p.reauthorize(sdkConfig, client); diagErr != nil {
+		log.Printf("Failed to renew the token of an SDK client: %v", diagErr)
+		return false
+	}
+	return true
 }
 
 func (p *SDKClientPool) release(c *platformclientv2.Configuration) {
+	if client := p.getClient(c); client != nil {
+		client.lastUsed = time.Now()
+	}
 	select {
 	case p.Pool <- c:
 	default:
@@ -96,28 +366,92 @@ func (p *SDKClientPool) release(c *platformclientv2.Configuration) {
 	}
 }
 
+// shrink removes clients that have been idle longer than the idle timeout, as long as the Pool stays at its min size
+func (p *SDKClientPool) shrink(idleTimeout time.Duration) {
+	var idleClients []*platformclientv2.Configuration
+	for len(idleClients) < cap(p.Pool) {
+		select {
+		case sdkConfig := <-p.Pool:
+			idleClients = append(idleClients, sdkConfig)
+			continue
+		default:
+		}
+		break
+	}
+
+	for _, sdkConfig := range idleClients {
+		client := p.getClient(sdkConfig)
+		p.mutex.Lock()
+		canShrink := p.size > p.minSize
+		p.mutex.Unlock()
+		if canShrink && client != nil && time.Since(client.lastUsed) > idleTimeout {
+			p.discard(sdkConfig)
+			continue
+		}
+		p.Pool <- sdkConfig
+	}
+}
+
+func (p *SDKClientPool) shrinkPeriodically(period time.Duration) {
+	ticker := time.NewTicker(period)
+	defer ticker.Stop()
+	for range ticker.C {
+		p.shrink(sdkClientIdleTimeout)
+		p.logMetrics()
+	}
+}
+
+func (p *SDKClientPool) logMetrics() {
+	p.mutex.Lock()
+	size := p.size
+	p.mutex.Unlock()
+
+	p.metrics.mutex.Lock()
+	defer p.metrics.mutex.Unlock()
+	if p.metrics.acquisitions == 0 {
+		return
+	}
+	log.Printf("SDK client Pool: %d of max %d clients, %d idle. %d acquisitions, %d had to wait. Average wait %v, max wait %v.",
+		size, cap(p.Pool), len(p.Pool), p.metrics.acquisitions, p.metrics.waits,
+		(p.metrics.totalWait / time.Duration(p.metrics.acquisitions)).Round(time.Millisecond), p.metrics.maxWait.Round(time.Millisecond))
+}
+
+// record adds the time an acquisition took to the metrics. Acquisitions that took longer than a millisecond count as waits.
+func (m *sdkClientPoolMetrics) record(wait time.Duration) {
+	m.mutex.Lock()
+	defer m.mutex.Unlock()
+	m.acquisitions++
+	m.totalWait += wait
+	if wait > time.Millisecond {
+		m.waits++
+	}
+	if wait > m.maxWait {
+		m.maxWait = wait
+	}
+}
+
 type resContextFunc func(context.Context, *schema.ResourceData, interface{}) diag.Diagnostics
 type GetAllConfigFunc func(context.Context, *platformclientv2.Configuration) (resourceExporter.ResourceIDMetaMap, diag.Diagnostics)
 type GetCustomConfigFunc func(context.Context, *platformclientv2.Configuration) (resourceExporter.ResourceIDMetaMap, *resourceExporter.DependencyResource, diag.Diagnostics)
 
 func CreateWithPooledClient(method resContextFunc) schema.CreateContextFunc {
-	methodWrappedWithRecover := wrapWithRecover(method, constants.Create)
-	return schema.CreateContextFunc(runWithPooledClient(methodWrappedWithRecover))
+	methodWrappedWithRecover := wrapWithRecover(invalidateCacheItem(method), constants.Create)
+	return schema.CreateContextFunc(traceOperation(runWithPooledClient(methodWrappedWithRecover), constants.Create, method))
 }
 
 func ReadWithPooledClient(method resContextFunc) schema.ReadContextFunc {
 	methodWrappedWithRecover := wrapWithRecover(method, constants.Read)
-	return schema.ReadContextFunc(runWithPooledClient(methodWrappedWithRecover))
+	return schema.ReadContextFunc(traceOperation(runWithPooledClient(retryIfUnauthorized(methodWrappedWithRecover)), constants.Read, method))
 }
 
 func UpdateWithPooledClient(method resContextFunc) schema.UpdateContextFunc {
-	methodWrappedWithRecover := wrapWithRecover(method, constants.Update)
-	return schema.UpdateContextFunc(runWithPooledClient(methodWrappedWithRecover))
+	methodWrappedWithRecover := wrapWithRecover(invalidateDataSourceCacheValue(invalidateCacheItem(method)), constants.Update)
+	return schema.UpdateContextFunc(traceOperation(runWithPooledClient(methodWrappedWithRecover), constants.Update, method))
 }
 
 func DeleteWithPooledClient(method resContextFunc) schema.DeleteContextFunc {
-	methodWrappedWithRecover := wrapWithRecover(method, constants.Delete)
-	return schema.DeleteContextFunc(runWithPooledClient(methodWrappedWithRecover))
+	methodWrappedWithRecover := wrapWithRecover(invalidateDataSourceCacheValue(invalidateCacheItem(method)), constants.Delete)
+	return schema.DeleteContextFunc(traceOperation(runWithPooledClient(methodWrappedWithRecover), constants.Delete, method))
 }
 
 func wrapWithRecover(method resContextFunc, operation constants.CRUDOperation) resContextFunc {
@@ -140,12 +474,56 @@ func wrapWithRecover(method resContextFunc, operation constants.CRUDOperation) r
 	}
 }
 
+// Remove the resource from the read-through cache before and after it is changed, so a following read
+// never returns the object as it was before the change
+func invalidateCacheItem(method resContextFunc) resContextFunc {
+	return func(ctx context.Context, r *schema.ResourceData, meta interface{}) diag.Diagnostics {
+		rc.InvalidateCacheItem(r.Id())
+		defer func() {
+			rc.InvalidateCacheItem(r.Id())
+		}()
+		return method(ctx, r, meta)
+	}
+}
+
+// Remove the lookups that resolve to the resource from the data source caches once it was renamed or deleted
+func invalidateDataSourceCacheValue(method resContextFunc) resContextFunc {
+	return func(ctx context.Context, r *schema.ResourceData, meta interface{}) diag.Diagnostics {
+		id := r.Id()
+		diagErr := method(ctx, r, meta)
+		if !diagErr.HasError() {
+			rc.InvalidateDataSourceCacheValue(id)
+		}
+		return diagErr
+	}
+}
+
+// Retry a read once with a renewed token if the token of its client was rejected. Only reads are retried, because
+// other operations may have changed an object before their token was rejected.
+func retryIfUnauthorized(method resContextFunc) resContextFunc {
+	return func(ctx context.Context, r *schema.ResourceData, meta interface{}) diag.Diagnostics {
+		diagErr := method(ctx, r, meta)
+		providerMeta := meta.(*ProviderMeta)
+		if diagErr.HasError() && providerMeta.getClientPool().renewIfUnauthorized(providerMeta.ClientConfig) {
+			log.Printf("Retrying read of %s with a renewed token", r.Id())
+			return method(ctx, r, meta)
+		}
+		return diagErr
+	}
+}
+
 // Inject a pooled SDK client connection into a resource method's meta argument
 // and automatically return it to the Pool on completion
 func runWithPooledClient(method resContextFunc) resContextFunc {
 	return func(ctx context.Context, r *schema.ResourceData, meta interface{}) diag.Diagnostics {
-		clientConfig := SdkClientPool.acquire()
-		defer SdkClientPool.release(clientConfig)
+		providerMeta := meta.(*ProviderMeta)
+		pool := providerMeta.getClientPool()
+		clientConfig, diagErr := pool.acquire(ctx)
+		if diagErr != nil {
+			return diagErr
+		}
+		defer pool.release(clientConfig)
+		defer tracing.BindClient(clientConfig, ctx)()
 
 		// Check if the request has been cancelled
 		select {
@@ -155,17 +533,28 @@ func runWithPooledClient(method resContextFunc) resContextFunc {
 		}
 
 		// Copy to a new providerMeta object and set the sdk config
-		newMeta := *meta.(*ProviderMeta)
+		newMeta := *providerMeta
 		newMeta.ClientConfig = clientConfig
-		return method(ctx, r, &newMeta)
+		return method(ContextWithProviderMeta(ctx, &newMeta), r, &newMeta)
 	}
 }
 
 // Inject a pooled SDK client connection into an exporter's getAll* method
 func GetAllWithPooledClient(method GetAllConfigFunc) resourceExporter.GetAllResourcesFunc {
-	return func(ctx context.Context) (resourceExporter.ResourceIDMetaMap, diag.Diagnostics) {
-		clientConfig := SdkClientPool.acquire()
-		defer SdkClientPool.release(clientConfig)
+	spanName := "Get all " + getPackageName(method)
+	return func(ctx context.Context) (resources resourceExporter.ResourceIDMetaMap, diagErr diag.Diagnostics) {
+		ctx, span := tracing.StartSpan(ctx, spanName)
+		defer func() {
+			tracing.EndSpan(span, tracing.DiagnosticsError(diagErr))
+		}()
+
+		pool := getClientPoolFromContext(ctx)
+		clientConfig, diagErr := pool.acquire(ctx)
+		if diagErr != nil {
+			return nil, diagErr
+		}
+		defer pool.release(clientConfig)
+		defer tracing.BindClient(clientConfig, ctx)()
 
 		// Check if the request has been cancelled
 		select {
@@ -174,14 +563,30 @@ func GetAllWithPooledClient(method GetAllConfigFunc) resourceExporter.GetAllReso
 		default:
 		}
 
-		return method(ctx, clientConfig)
+		resources, diagErr = method(ctx, clientConfig)
+		if diagErr.HasError() && pool.renewIfUnauthorized(clientConfig) {
+			log.Print("Retrying to get all resources with a renewed token")
+			return method(ctx, clientConfig)
+		}
+		return resources, diagErr
 	}
 }
 
 func GetAllWithPooledClientCustom(method GetCustomConfigFunc) resourceExporter.GetAllCustomResourcesFunc {
-	return func(ctx context.Context) (resourceExporter.ResourceIDMetaMap, *resourceExporter.DependencyResource, diag.Diagnostics) {
-		clientConfig := SdkClientPool.acquire()
-		defer SdkClientPool.release(clientConfig)
+	spanName := "Get all " + getPackageName(method)
+	return func(ctx context.Context) (resources resourceExporter.ResourceIDMetaMap, dependencies *resourceExporter.DependencyResource, diagErr diag.Diagnostics) {
+		ctx, span := tracing.StartSpan(ctx, spanName)
+		defer func() {
+			tracing.EndSpan(span, tracing.DiagnosticsError(diagErr))
+		}()
+
+		pool := getClientPoolFromContext(ctx)
+		clientConfig, diagErr := pool.acquire(ctx)
+		if diagErr != nil {
+			return nil, nil, diagErr
+		}
+		defer pool.release(clientConfig)
+		defer tracing.BindClient(clientConfig, ctx)()
 
 		// Check if the request has been cancelled
 		select {
@@ -190,6 +595,36 @@ func GetAllWithPooledClientCustom(method GetCustomConfigFunc) resourceExporter.G
 		default:
 		}
 
-		return method(ctx, clientConfig)
+		resources, dependencies, diagErr = method(ctx, clientConfig)
+		if diagErr.HasError() && pool.renewIfUnauthorized(clientConfig) {
+			log.Print("Retrying to get all resources with a renewed token")
+			return method(ctx, clientConfig)
+		}
+		return resources, dependencies, diagErr
+	}
+}
+
+type providerMetaContextKey struct{}
+
+// ContextWithProviderMeta returns a context for the operations of a provider configuration, so its Pool and the caches
+// of its org are used by code that is only given the context, e.g. the getAll* methods of exporters
+func ContextWithProviderMeta(ctx context.Context, meta *ProviderMeta) context.Context {
+	ctx = context.WithValue(ctx, providerMetaContextKey{}, meta)
+	if meta.OrgKey != "" {
+		ctx = rc.ContextWithOrg(ctx, meta.OrgKey, meta.ClientConfig)
 	}
+	return ctx
+}
+
+// getClientPool returns the Pool of the provider configuration
+func (p *ProviderMeta) getClientPool() *SDKClientPool {
+	if p != nil && p.ClientPool != nil {
+		return p.ClientPool
+	}
+	return SdkClientPool
+}
+
+func getClientPoolFromContext(ctx context.Context) *SDKClientPool {
+	meta, _ := ctx.Value(providerMetaContextKey{}).(*ProviderMeta)
+	return meta.getClientPool()
 }
@@ -0,0 +1,242 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"sync/atomic"
+	cc "terraform-provider-genesyscloud/genesyscloud/consistency_checker"
+	rc "terraform-provider-genesyscloud/genesyscloud/resource_cache"
+	"testing"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/mypurecloud/platform-client-sdk-go/v152/platformclientv2"
+)
+
+func TestUnitSDKClientPoolGrowsAndShrinks(t *testing.T) {
+	var initCount atomic.Int32
+	pool := newSDKClientPool(1, 3, func(config *platformclientv2.Configuration) diag.Diagnostics {
+		initCount.Add(1)
+		return nil
+	}, nil)
+	if diagErr := pool.preFill(); diagErr != nil {
+		t.Fatalf("Failed to prefill the Pool: %v", diagErr)
+	}
+	if initCount.Load() != 1 || len(pool.Pool) != 1 {
+		t.Fatalf("Expected the Pool to be prefilled with 1 client, got %d clients created and %d idle", initCount.Load(), len(pool.Pool))
+	}
+
+	// The Pool grows up to its max size while all clients are in use
+	var clients []*platformclientv2.Configuration
+	for i := 0; i < 3; i++ {
+		client, diagErr := pool.acquire(context.Background())
+		if diagErr != nil {
+			t.Fatalf("Failed to acquire client %d: %v", i, diagErr)
+		}
+		clients = append(clients, client)
+	}
+	if initCount.Load() != 3 {
+		t.Errorf("Expected 3 clients to be created, got %d", initCount.Load())
+	}
+
+	// Once the Pool is at its max size, callers wait until their context is cancelled
+	ctx, cancel := context.WithTimeout(context.Background(), 50*time.Millisecond)
+	defer cancel()
+	if _, diagErr := pool.acquire(ctx); diagErr == nil {
+		t.Errorf("Expected acquiring a client from an exhausted Pool to be cancelled")
+	}
+
+	for _, client := range clients {
+		pool.release(client)
+	}
+
+	// Idle clients are removed down to the min size
+	pool.shrink(0)
+	if pool.size != 1 || len(pool.Pool) != 1 {
+		t.Errorf("Expected the Pool to shrink to 1 client, got %d clients and %d idle", pool.size, len(pool.Pool))
+	}
+}
+
+func TestUnitSDKClientPoolWaiterCreatesClientAfterDiscard(t *testing.T) {
+	pool := newSDKClientPool(1, 1, func(config *platformclientv2.Configuration) diag.Diagnostics {
+		return nil
+	}, nil)
+	if diagErr := pool.preFill(); diagErr != nil {
+		t.Fatalf("Failed to prefill the Pool: %v", diagErr)
+	}
+	client, diagErr := pool.acquire(context.Background())
+	if diagErr != nil {
+		t.Fatalf("Failed to acquire client: %v", diagErr)
+	}
+
+	acquired := make(chan diag.Diagnostics)
+	go func() {
+		ctx, cancel := context.WithTimeout(context.Background(), 5*time.Second)
+		defer cancel()
+		_, diagErr := pool.acquire(ctx)
+		acquired <- diagErr
+	}()
+
+	// A discarded client makes room for the waiting caller to create a new one
+	time.Sleep(10 * time.Millisecond)
+	pool.discard(client)
+	if diagErr := <-acquired; diagErr != nil {
+		t.Errorf("Expected the waiting caller to get a new client, got %v", diagErr)
+	}
+}
+
+func TestUnitSDKClientPoolRenewsRejectedTokens(t *testing.T) {
+	var authorizeCount atomic.Int32
+	pool := newSDKClientPool(1, 1, func(config *platformclientv2.Configuration) diag.Diagnostics {
+		return nil
+	}, func(config *platformclientv2.Configuration) diag.Diagnostics {
+		authorizeCount.Add(1)
+		return nil
+	})
+	if diagErr := pool.preFill(); diagErr != nil {
+		t.Fatalf("Failed to prefill the Pool: %v", diagErr)
+	}
+
+	client, _ := pool.acquire(context.Background())
+	if pool.renewIfUnauthorized(client) {
+		t.Errorf("Expected a token that was not rejected to be kept")
+	}
+
+	// A rejected token is renewed for a retry
+	pool.getClient(client).unauthorized.Store(true)
+	if !pool.renewIfUnauthorized(client) || authorizeCount.Load() != 1 {
+		t.Errorf("Expected the rejected token to be renewed, got %d renewals", authorizeCount.Load())
+	}
+
+	// A rejected token is renewed before the client is used again
+	pool.getClient(client).unauthorized.Store(true)
+	pool.release(client)
+	client, diagErr := pool.acquire(context.Background())
+	if diagErr != nil {
+		t.Fatalf("Failed to acquire client: %v", diagErr)
+	}
+	if authorizeCount.Load() != 2 || pool.getClient(client).unauthorized.Load() {
+		t.Errorf("Expected the rejected token to be renewed on acquire, got %d renewals", authorizeCount.Load())
+	}
+
+	// Tokens that are about to expire are renewed as well
+	client.AccessTokenExpiresIn = int((tokenRenewalMargin + time.Minute).Seconds())
+	pool.getClient(client).authorizedAt = time.Now().Add(-2 * time.Minute)
+	pool.release(client)
+	if _, diagErr := pool.acquire(context.Background()); diagErr != nil {
+		t.Fatalf("Failed to acquire client: %v", diagErr)
+	}
+	if authorizeCount.Load() != 3 {
+		t.Errorf("Expected the expiring token to be renewed, got %d renewals", authorizeCount.Load())
+	}
+}
+
+func TestUnitSDKClientPoolPerProviderConfiguration(t *testing.T) {
+	devPool := newSDKClientPool(1, 1, nil, nil)
+	prodPool := newSDKClientPool(1, 1, nil, nil)
+
+	// Operations use the Pool of their provider configuration
+	ctx := ContextWithProviderMeta(context.Background(), &ProviderMeta{ClientPool: devPool, OrgKey: "dev"})
+	if getClientPoolFromContext(ctx) != devPool {
+		t.Errorf("Expected the Pool of the dev provider configuration")
+	}
+	ctx = ContextWithProviderMeta(context.Background(), &ProviderMeta{ClientPool: prodPool, OrgKey: "prod"})
+	if getClientPoolFromContext(ctx) != prodPool {
+		t.Errorf("Expected the Pool of the prod provider configuration")
+	}
+
+	// Code that does not know its provider configuration uses the Pool of the first one
+	originalPool := SdkClientPool
+	defer func() { SdkClientPool = originalPool }()
+	SdkClientPool = devPool
+	if getClientPoolFromContext(context.Background()) != devPool {
+		t.Errorf("Expected the Pool of the first provider configuration")
+	}
+}
+
+func TestUnitGetPackageName(t *testing.T) {
+	if name := getPackageName(newSDKClientPool); name != "provider" {
+		t.Errorf("Expected provider, got %s", name)
+	}
+}
+
+func TestUnitProviderSettingsPerAlias(t *testing.T) {
+	// Two aliased provider configurations for different orgs, each with its own Pool
+	newAlias := func(orgKey string, requestsPerSecond int) (*ProviderMeta, *platformclientv2.Configuration) {
+		data := newTestProviderConfig(t, map[string]interface{}{
+			"access_token":            "token-" + orgKey,
+			"api_requests_per_second": requestsPerSecond,
+			"consistency_checker": []interface{}{
+				map[string]interface{}{"resource_type": "genesyscloud_routing_queue", "backoff": fmt.Sprintf("%ds", requestsPerSecond)},
+			},
+		})
+		pool := newSDKClientPool(1, 1, nil, nil)
+		pool.rateLimiter = newAPIRateLimiter(requestsPerSecond)
+		pool.responseObservers = newResponseObservers()
+		config := platformclientv2.NewConfiguration()
+		if diagErr := initClientConfig(data, "0.1.0", config, false, pool.rateLimiter, pool.responseObservers); diagErr != nil {
+			t.Fatalf("Failed to initialize the client of %s: %v", orgKey, diagErr)
+		}
+		if diagErr := configureConsistencyChecker(data, orgKey); diagErr != nil {
+			t.Fatalf("Failed to configure the consistency checker of %s: %v", orgKey, diagErr)
+		}
+		rc.SetReadThroughCacheEnabled(orgKey, requestsPerSecond > 10)
+		t.Cleanup(func() {
+			cc.SetResourceTypeConfigs(orgKey, make(map[string]cc.ResourceTypeConfig))
+			rc.SetReadThroughCacheEnabled(orgKey, false)
+		})
+		return &ProviderMeta{ClientPool: pool, OrgKey: orgKey}, config
+	}
+	devMeta, devConfig := newAlias("dev", 5)
+	prodMeta, prodConfig := newAlias("prod", 20)
+
+	// Each alias keeps its own requests per second budget
+	if rps := devMeta.ClientPool.rateLimiter.requestsPerSecond; rps != 5 {
+		t.Errorf("Expected 5 requests per second for dev, got %d", rps)
+	}
+	if rps := prodMeta.ClientPool.rateLimiter.requestsPerSecond; rps != 20 {
+		t.Errorf("Expected 20 requests per second for prod, got %d", rps)
+	}
+
+	// Observers only see the responses of their own alias
+	var devResponses, prodResponses atomic.Int32
+	defer devMeta.RegisterResponseObserver(func(*http.Response) { devResponses.Add(1) })()
+	defer prodMeta.RegisterResponseObserver(func(*http.Response) { prodResponses.Add(1) })()
+
+	// A 429 for dev pauses the requests of dev, but not the ones of prod
+	request, _ := http.NewRequest(http.MethodGet, "https://api.mypurecloud.com/api/v2/users", nil)
+	devConfig.RetryConfiguration.ResponseLogHook(&http.Response{
+		StatusCode: http.StatusTooManyRequests,
+		Header:     http.Header{"Retry-After": []string{"5"}},
+		Request:    request,
+	})
+	ctx, cancel := context.WithTimeout(context.Background(), 50*time.Millisecond)
+	defer cancel()
+	if err := devMeta.ClientPool.rateLimiter.getBucket().Wait(ctx); err == nil {
+		t.Errorf("Expected the requests of dev to be paused after a 429 response")
+	}
+	if err := prodMeta.ClientPool.rateLimiter.getBucket().Wait(ctx); err != nil {
+		t.Errorf("Expected the requests of prod not to be paused by a 429 response of dev, got %v", err)
+	}
+	prodConfig.RetryConfiguration.ResponseLogHook(&http.Response{StatusCode: http.StatusOK, Header: http.Header{}, Request: request})
+	if devResponses.Load() != 1 || prodResponses.Load() != 1 {
+		t.Errorf("Expected each alias to observe one response, got %d for dev and %d for prod", devResponses.Load(), prodResponses.Load())
+	}
+
+	// Consistency checker blocks only apply to the org of their alias
+	if backoff := cc.GetResourceTypeConfig(devMeta.GetOrgKey(), "genesyscloud_routing_queue").Backoff; backoff != 5*time.Second {
+		t.Errorf("Expected a backoff of 5s for dev, got %v", backoff)
+	}
+	if backoff := cc.GetResourceTypeConfig(prodMeta.GetOrgKey(), "genesyscloud_routing_queue").Backoff; backoff != 20*time.Second {
+		t.Errorf("Expected a backoff of 20s for prod, got %v", backoff)
+	}
+
+	// The read-through cache is only used by the alias that enabled it
+	if rc.IsReadThroughCacheEnabled(ContextWithProviderMeta(context.Background(), devMeta)) {
+		t.Errorf("Expected the read-through cache to be disabled for dev")
+	}
+	if !rc.IsReadThroughCacheEnabled(ContextWithProviderMeta(context.Background(), prodMeta)) {
+		t.Errorf("Expected the read-through cache to be enabled for prod")
+	}
+}
@@ -6,6 +6,8 @@ import (
 	gcloud "terraform-provider-genesyscloud/genesyscloud"
 	dt "terraform-provider-genesyscloud/genesyscloud/architect_datatable"
 	dtr "terraform-provider-genesyscloud/genesyscloud/architect_datatable_row"
+	dtrs "terraform-provider-genesyscloud/genesyscloud/architect_datatable_rows"
+	architectDependencies "terraform-provider-genesyscloud/genesyscloud/architect_dependencies"
 	emergencyGroup "terraform-provider-genesyscloud/genesyscloud/architect_emergencygroup"
 	flow "terraform-provider-genesyscloud/genesyscloud/architect_flow"
 	grammar "terraform-provider-genesyscloud/genesyscloud/architect_grammar"
@@ -191,6 +193,8 @@ func registerResources() {
 	oauth.SetRegistrar(regInstance)                                        //Registering oauth_client
 	dt.SetRegistrar(regInstance)                                           //Registering architect data table
 	dtr.SetRegistrar(regInstance)                                          //Registering architect data table row
+	dtrs.SetRegistrar(regInstance)                                         //Registering architect data table rows
+	architectDependencies.SetRegistrar(regInstance)                        //Registering architect dependencies
 	emergencyGroup.SetRegistrar(regInstance)                               //Registering architect emergency group
 	architectSchedulegroups.SetRegistrar(regInstance)                      //Registering architect schedule groups
 	architectSchedules.SetRegistrar(regInstance)                           //Registering architect schedules
@@ -31,7 +31,7 @@ Each proxy implementation:
 
 */
 
-// internalProxy holds a proxy instance that can be used throughout the package
+// internalProxy holds a proxy instance that unit tests can set to replace the proxy of the package
 var internalProxy *policyProxy
 
 // Type definitions for each func on our proxy so we can easily mock them out later
@@ -81,13 +81,14 @@ func newPolicyProxy(clientConfig *platformclientv2.Configuration) *policyProxy {
 	}
 }
 
-// getPolicyProxy acts as a singleton to for the internalProxy.  It also ensures
-// that we can still proxy our tests by directly setting internalProxy package variable
+// getPolicyProxy returns a new proxy for the client config of every call, so provider configurations for
+// several orgs never share a proxy. It also ensures that we can still proxy our tests by directly setting
+// internalProxy package variable
 func getPolicyProxy(clientConfig *platformclientv2.Configuration) *policyProxy {
-	if internalProxy == nil {
-		internalProxy = newPolicyProxy(clientConfig)
+	if internalProxy != nil {
+		return internalProxy
 	}
-	return internalProxy
+	return newPolicyProxy(clientConfig)
 }
 
 // getAllPolicies retrieves all Genesys Cloud Recording Media Retention Policies
@@ -5,7 +5,7 @@ import (
 	"fmt"
 	"log"
 	"sync"
-	"terraform-provider-genesyscloud/genesyscloud/util"
+	"time"
 
 	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/mypurecloud/platform-client-sdk-go/v152/platformclientv2"
@@ -18,6 +18,12 @@ type DataSourceCache struct {
 	ClientConfig     *platformclientv2.Configuration
 	HydrateCacheFunc func(*DataSourceCache, context.Context) error
 	getApiFunc       func(*DataSourceCache, string, context.Context) (string, diag.Diagnostics)
+	resourceType     string
+	hydratedAt       time.Time
+
+	// The cache is used for the first org that looks up a value. Each other org gets a cache of its own.
+	orgKey    string
+	orgCaches map[string]*DataSourceCache
 }
 
 // NewDataSourceCache creates a new data source cache
@@ -25,25 +31,103 @@ func NewDataSourceCache(clientConfig *platformclientv2.Configuration,
 	hydrateFn func(*DataSourceCache, context.Context) error,
 	getFn func(*DataSourceCache, string, context.Context) (string, diag.Diagnostics)) *DataSourceCache {
 
-	return &DataSourceCache{
+	cache := &DataSourceCache{
 		Cache:            make(map[string]string),
 		ClientConfig:     clientConfig,
 		HydrateCacheFunc: hydrateFn,
 		getApiFunc:       getFn,
 	}
+
+	registeredDataSourceCachesMutex.Lock()
+	defer registeredDataSourceCachesMutex.Unlock()
+	registeredDataSourceCaches = append(registeredDataSourceCaches, cache)
+	return cache
 }
 
 func (c *DataSourceCache) HydrateCacheIfEmpty(ctx context.Context) error {
 	c.mutex.Lock()
 	defer c.mutex.Unlock()
 	if c.isEmpty() {
+		if c.loadFromDisk() {
+			return nil
+		}
 		if err := c.hydrateCache(ctx); err != nil {
 			return err
 		}
+		c.hydratedAt = time.Now()
+		c.saveToDisk()
 	}
 	return nil
 }
 
+// loadFromDisk fills the cache from the disk cache, if it is enabled and holds unexpired lookups for the resource type
+func (c *DataSourceCache) loadFromDisk() bool {
+	diskCache := getDataSourceDiskCache(c.orgKey)
+	if diskCache == nil || c.resourceType == "" {
+		return false
+	}
+	entries, writtenAt := diskCache.load(c.resourceType)
+	if len(entries) == 0 {
+		return false
+	}
+	c.hydratedAt = writtenAt
+	for key, val := range entries {
+		c.Cache[key] = val
+	}
+	log.Printf("loaded %d entries of data source %s from the disk cache", len(entries), c.resourceType)
+	return true
+}
+
+// saveToDisk writes the cache to the disk cache, if it is enabled
+func (c *DataSourceCache) saveToDisk() {
+	diskCache := getDataSourceDiskCache(c.orgKey)
+	if diskCache == nil || c.resourceType == "" {
+		return
+	}
+	entries := make(map[string]string, len(c.Cache))
+	for key, val := range c.Cache {
+		entries[key] = val
+	}
+	diskCache.save(c.resourceType, entries, c.hydratedAt)
+}
+
+// deleteCacheValue removes every entry that resolves to the given value
+func (c *DataSourceCache) deleteCacheValue(val string) {
+	c.mutex.Lock()
+	defer c.mutex.Unlock()
+	for key, cachedVal := range c.Cache {
+		if cachedVal == val {
+			delete(c.Cache, key)
+		}
+	}
+}
+
+// forOrg returns the cache of the org of the context
+func (c *DataSourceCache) forOrg(ctx context.Context) *DataSourceCache {
+	org, ok := orgFromContext(ctx)
+	if !ok {
+		return c
+	}
+
+	c.mutex.Lock()
+	defer c.mutex.Unlock()
+	if c.orgKey == "" || c.orgKey == org.key {
+		c.orgKey = org.key
+		return c
+	}
+	if orgCache, ok := c.orgCaches[org.key]; ok {
+		return orgCache
+	}
+
+	orgCache := NewDataSourceCache(org.clientConfig, c.HydrateCacheFunc, c.getApiFunc)
+	orgCache.orgKey = org.key
+	if c.orgCaches == nil {
+		c.orgCaches = make(map[string]*DataSourceCache)
+	}
+	c.orgCaches[org.key] = orgCache
+	return orgCache
+}
+
 // Hydrate the cache with updated values.
 func (c *DataSourceCache) hydrateCache(ctx context.Context) error {
 	return c.HydrateCacheFunc(c, ctx)
@@ -58,6 +142,7 @@ func (c *DataSourceCache) UpdateCacheEntry(key string, val string) error {
 		return fmt.Errorf("cache is not initialized")
 	}
 	c.Cache[key] = val
+	c.saveToDisk()
 
 	log.Printf("updated cache entry [%v] to value: %v", key, val)
 
@@ -93,6 +178,13 @@ func (c *DataSourceCache) Get(key string) (val string, isFound bool) {
 func RetrieveId(cache *DataSourceCache,
 	resourceType, key string, ctx context.Context) (string, diag.Diagnostics) {
 
+	cache = cache.forOrg(ctx)
+
+	// The resource type names the file of the cache on disk
+	cache.mutex.Lock()
+	cache.resourceType = resourceType
+	cache.mutex.Unlock()
+
 	if err := cache.HydrateCacheIfEmpty(ctx); err != nil {
 		return "", diag.FromErr(err)
 	}
@@ -108,7 +200,8 @@ func RetrieveId(cache *DataSourceCache,
 		}
 
 		if err := cache.UpdateCacheEntry(key, idFromApi); err != nil {
-			return "", util.BuildDiagnosticError(resourceType, fmt.Sprintf("error updating cache"), err)
+			// The util package cannot be used here, because the provider package depends on this package
+			return "", diag.Errorf("%s: error updating cache: %v", resourceType, err)
 		}
 		// id gets reset to empty string at the updateCacheEntry method.
 		id = idFromApi
@@ -0,0 +1,205 @@
+package resource_cache
+
+import (
+	"encoding/json"
+	"fmt"
+	"log"
+	"os"
+	"path/filepath"
+	"strings"
+	"sync"
+	"time"
+)
+
+/*
+The data source disk cache persists the name to ID lookups of the data source caches between runs of the provider, so
+every plan of many small workspaces against the same org does not repeat them. Entries are stored per org ID and region,
+with a file for every resource type. Files older than the TTL are ignored, which hydrates the cache from the API again.
+*/
+
+type dataSourceDiskCacheFile struct {
+	ResourceType string            `json:"resource_type"`
+	WrittenAt    time.Time         `json:"written_at"`
+	Entries      map[string]string `json:"entries"`
+}
+
+type dataSourceDiskCache struct {
+	mutex   sync.Mutex
+	dirPath string
+	ttl     time.Duration
+}
+
+var (
+	// Disk caches by org key. Caches without an org are not written to disk, so lookups never mix orgs.
+	diskCaches     = make(map[string]*dataSourceDiskCache)
+	diskCacheMutex sync.RWMutex
+
+	// Every data source cache is registered so a deleted resource can be removed from all of them
+	registeredDataSourceCaches      []*DataSourceCache
+	registeredDataSourceCachesMutex sync.Mutex
+)
+
+// ConfigureDataSourceDiskCache enables the disk cache of data source lookups for an org. Passing an empty directory
+// disables it.
+func ConfigureDataSourceDiskCache(dir string, orgId string, region string, ttl time.Duration) error {
+	diskCacheMutex.Lock()
+	defer diskCacheMutex.Unlock()
+
+	orgKey := OrgKey(orgId, region)
+	if dir == "" {
+		delete(diskCaches, orgKey)
+		return nil
+	}
+	dirPath := filepath.Join(dir, orgKey)
+	if err := os.MkdirAll(dirPath, os.ModePerm); err != nil {
+		return fmt.Errorf("failed to create data source cache directory %s: %v", dirPath, err)
+	}
+	log.Printf("Data source lookups are cached in %s for %v", dirPath, ttl)
+	diskCaches[orgKey] = &dataSourceDiskCache{dirPath: dirPath, ttl: ttl}
+	return nil
+}
+
+// InvalidateDataSourceDiskCache removes every data source lookup cached on disk for an org
+func InvalidateDataSourceDiskCache(orgId string, region string) error {
+	c := getDataSourceDiskCache(OrgKey(orgId, region))
+	if c == nil {
+		return nil
+	}
+	c.mutex.Lock()
+	defer c.mutex.Unlock()
+
+	entries, err := os.ReadDir(c.dirPath)
+	if err != nil {
+		return fmt.Errorf("failed to read data source cache directory %s: %v", c.dirPath, err)
+	}
+	for _, entry := range entries {
+		if err := os.Remove(filepath.Join(c.dirPath, entry.Name())); err != nil {
+			return fmt.Errorf("failed to remove data source cache file %s: %v", entry.Name(), err)
+		}
+	}
+	log.Printf("Invalidated the data source cache in %s", c.dirPath)
+	return nil
+}
+
+// InvalidateDataSourceCacheValue removes every lookup that resolves to the given ID from the data source caches in
+// memory and on disk. It is called whenever a resource is deleted, so a data source never returns the ID of a deleted resource.
+func InvalidateDataSourceCacheValue(id string) {
+	if id == "" {
+		return
+	}
+	registeredDataSourceCachesMutex.Lock()
+	caches := append([]*DataSourceCache{}, registeredDataSourceCaches...)
+	registeredDataSourceCachesMutex.Unlock()
+
+	for _, cache := range caches {
+		cache.deleteCacheValue(id)
+	}
+
+	diskCacheMutex.RLock()
+	diskCacheList := make([]*dataSourceDiskCache, 0, len(diskCaches))
+	for _, c := range diskCaches {
+		diskCacheList = append(diskCacheList, c)
+	}
+	diskCacheMutex.RUnlock()
+	for _, c := range diskCacheList {
+		c.removeValue(id)
+	}
+}
+
+// getDataSourceDiskCache returns the disk cache of an org, or nil if it is disabled or the org is unknown
+func getDataSourceDiskCache(orgKey string) *dataSourceDiskCache {
+	diskCacheMutex.RLock()
+	defer diskCacheMutex.RUnlock()
+	return diskCaches[orgKey]
+}
+
+func (c *dataSourceDiskCache) filePath(resourceType string) string {
+	return filepath.Join(c.dirPath, resourceType+".json")
+}
+
+// load returns the cached lookups of a resource type and when they were retrieved, or nil if there are none or they expired
+func (c *dataSourceDiskCache) load(resourceType string) (map[string]string, time.Time) {
+	c.mutex.Lock()
+	defer c.mutex.Unlock()
+
+	path := c.filePath(resourceType)
+	data, err := os.ReadFile(path)
+	if os.IsNotExist(err) {
+		return nil, time.Time{}
+	}
+	if err != nil {
+		log.Printf("Failed to read data source cache file %s: %v", path, err)
+		return nil, time.Time{}
+	}
+	var cacheFile dataSourceDiskCacheFile
+	if err := json.Unmarshal(data, &cacheFile); err != nil {
+		log.Printf("Failed to parse data source cache file %s: %v", path, err)
+		return nil, time.Time{}
+	}
+	if time.Since(cacheFile.WrittenAt) > c.ttl {
+		log.Printf("Data source cache of %s expired at %v", resourceType, cacheFile.WrittenAt.Add(c.ttl))
+		return nil, time.Time{}
+	}
+	return cacheFile.Entries, cacheFile.WrittenAt
+}
+
+// save writes the lookups of a resource type. The time the lookups were hydrated is kept, so adding single lookups
+// never extends the TTL. Failures are only logged, because lookups fall back to the API.
+func (c *dataSourceDiskCache) save(resourceType string, entries map[string]string, writtenAt time.Time) {
+	data, err := json.Marshal(dataSourceDiskCacheFile{
+		ResourceType: resourceType,
+		WrittenAt:    writtenAt.UTC(),
+		Entries:      entries,
+	})
+	if err != nil {
+		log.Printf("Failed to marshal data source cache of %s: %v", resourceType, err)
+		return
+	}
+
+	c.mutex.Lock()
+	defer c.mutex.Unlock()
+
+	// Write to a temporary file first, because several provider processes may share the same directory
+	tmpFile, err := os.CreateTemp(c.dirPath, resourceType+".*.tmp")
+	if err != nil {
+		log.Printf("Failed to create data source cache file of %s: %v", resourceType, err)
+		return
+	}
+	_, writeErr := tmpFile.Write(data)
+	closeErr := tmpFile.Close()
+	if writeErr != nil || closeErr != nil {
+		log.Printf("Failed to write data source cache file of %s: %v %v", resourceType, writeErr, closeErr)
+		_ = os.Remove(tmpFile.Name())
+		return
+	}
+	if err := os.Rename(tmpFile.Name(), c.filePath(resourceType)); err != nil {
+		log.Printf("Failed to write data source cache file of %s: %v", resourceType, err)
+		_ = os.Remove(tmpFile.Name())
+	}
+}
+
+// removeValue removes every lookup that resolves to the given ID from the files of all resource types
+func (c *dataSourceDiskCache) removeValue(id string) {
+	entries, err := os.ReadDir(c.dirPath)
+	if err != nil {
+		log.Printf("Failed to read data source cache directory %s: %v", c.dirPath, err)
+		return
+	}
+	for _, entry := range entries {
+		if entry.IsDir() || filepath.Ext(entry.Name()) != ".json" {
+			continue
+		}
+		resourceType := strings.TrimSuffix(entry.Name(), ".json")
+		cached, writtenAt := c.load(resourceType)
+		removed := false
+		for key, val := range cached {
+			if val == id {
+				delete(cached, key)
+				removed = true
+			}
+		}
+		if removed {
+			c.save(resourceType, cached, writtenAt)
+		}
+	}
+}
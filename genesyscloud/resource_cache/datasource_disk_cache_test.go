@@ -0,0 +1,124 @@
+package resource_cache
+
+import (
+	"context"
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+)
+
+func TestUnitDataSourceDiskCache(t *testing.T) {
+	const resourceType = "genesyscloud_routing_skill"
+	dir := t.TempDir()
+	if err := ConfigureDataSourceDiskCache(dir, "org-id", "US-EAST-1", time.Hour); err != nil {
+		t.Fatalf("Failed to configure the data source disk cache: %v", err)
+	}
+	defer ConfigureDataSourceDiskCache("", "org-id", "us-east-1", 0)
+	ctx := ContextWithOrg(context.Background(), OrgKey("org-id", "us-east-1"), nil)
+
+	hydrateCount := 0
+	hydrate := func(c *DataSourceCache, ctx context.Context) error {
+		hydrateCount++
+		c.Cache["skill"] = "skill-id"
+		c.Cache["other skill"] = "other-skill-id"
+		return nil
+	}
+	getFromApi := func(c *DataSourceCache, key string, ctx context.Context) (string, diag.Diagnostics) {
+		return "new-skill-id", nil
+	}
+
+	// The first lookup hydrates the cache and writes it to disk
+	id, diagErr := RetrieveId(NewDataSourceCache(nil, hydrate, getFromApi), resourceType, "skill", ctx)
+	if diagErr != nil {
+		t.Fatalf("Failed to retrieve id: %v", diagErr)
+	}
+	if id != "skill-id" || hydrateCount != 1 {
+		t.Errorf("Expected skill-id from one hydration, got %s from %d", id, hydrateCount)
+	}
+	if _, err := os.Stat(filepath.Join(dir, "org-id_us-east-1", resourceType+".json")); err != nil {
+		t.Fatalf("Expected the cache file to be written: %v", err)
+	}
+
+	// A new provider process reads the lookups from disk, including the ones retrieved from the API
+	cache := NewDataSourceCache(nil, hydrate, getFromApi)
+	if id, _ := RetrieveId(cache, resourceType, "new skill", ctx); id != "new-skill-id" {
+		t.Errorf("Expected new-skill-id from the API, got %s", id)
+	}
+	if hydrateCount != 1 {
+		t.Errorf("Expected the cache to be loaded from disk, but it was hydrated %d times", hydrateCount)
+	}
+	cache = NewDataSourceCache(nil, hydrate, func(c *DataSourceCache, key string, ctx context.Context) (string, diag.Diagnostics) {
+		return "", diag.Errorf("unexpected API call for %s", key)
+	})
+	if id, diagErr := RetrieveId(cache, resourceType, "new skill", ctx); diagErr != nil || id != "new-skill-id" {
+		t.Errorf("Expected new-skill-id from disk, got %s: %v", id, diagErr)
+	}
+
+	// Deleted resources are removed from memory and disk
+	InvalidateDataSourceCacheValue("skill-id")
+	if _, ok := cache.Get("skill"); ok {
+		t.Errorf("Expected skill to be removed from memory")
+	}
+	entries, _ := getDataSourceDiskCache(OrgKey("org-id", "us-east-1")).load(resourceType)
+	if _, ok := entries["skill"]; ok {
+		t.Errorf("Expected skill to be removed from disk")
+	}
+	if entries["other skill"] != "other-skill-id" {
+		t.Errorf("Expected other skill to remain on disk, got %v", entries)
+	}
+
+	// Expired lookups are hydrated again
+	if err := ConfigureDataSourceDiskCache(dir, "org-id", "us-east-1", time.Nanosecond); err != nil {
+		t.Fatalf("Failed to configure the data source disk cache: %v", err)
+	}
+	RetrieveId(NewDataSourceCache(nil, hydrate, getFromApi), resourceType, "skill", ctx)
+	if hydrateCount != 2 {
+		t.Errorf("Expected the expired cache to be hydrated again, got %d hydrations", hydrateCount)
+	}
+
+	// Caches without an org never read or write the files of the configured org
+	if err := ConfigureDataSourceDiskCache(dir, "org-id", "us-east-1", time.Hour); err != nil {
+		t.Fatalf("Failed to configure the data source disk cache: %v", err)
+	}
+	cache = NewDataSourceCache(nil, hydrate, getFromApi)
+	RetrieveId(cache, resourceType, "skill", context.Background())
+	if hydrateCount != 3 {
+		t.Errorf("Expected a cache without an org to be hydrated from the API, got %d hydrations", hydrateCount)
+	}
+	if getDataSourceDiskCache("") != nil {
+		t.Errorf("Expected no disk cache for an unknown org")
+	}
+
+	if err := InvalidateDataSourceDiskCache("org-id", "us-east-1"); err != nil {
+		t.Fatalf("Failed to invalidate the data source disk cache: %v", err)
+	}
+	if files, _ := os.ReadDir(filepath.Join(dir, "org-id_us-east-1")); len(files) != 0 {
+		t.Errorf("Expected the cache files to be removed, got %d", len(files))
+	}
+}
+
+func TestUnitDataSourceCachePerOrg(t *testing.T) {
+	hydrate := func(c *DataSourceCache, ctx context.Context) error {
+		// Each org resolves the same name to a different ID
+		c.Cache["skill"] = c.orgKey + "-skill-id"
+		return nil
+	}
+	getFromApi := func(c *DataSourceCache, key string, ctx context.Context) (string, diag.Diagnostics) {
+		return "", diag.Errorf("unexpected API call for %s", key)
+	}
+	cache := NewDataSourceCache(nil, hydrate, getFromApi)
+
+	devCtx := ContextWithOrg(context.Background(), "dev", nil)
+	prodCtx := ContextWithOrg(context.Background(), "prod", nil)
+	for i := 0; i < 2; i++ {
+		if id, diagErr := RetrieveId(cache, "genesyscloud_routing_skill", "skill", devCtx); diagErr != nil || id != "dev-skill-id" {
+			t.Errorf("Expected dev-skill-id in the dev org, got %s: %v", id, diagErr)
+		}
+		if id, diagErr := RetrieveId(cache, "genesyscloud_routing_skill", "skill", prodCtx); diagErr != nil || id != "prod-skill-id" {
+			t.Errorf("Expected prod-skill-id in the prod org, got %s: %v", id, diagErr)
+		}
+	}
+}
@@ -0,0 +1,37 @@
+package resource_cache
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	"github.com/mypurecloud/platform-client-sdk-go/v152/platformclientv2"
+)
+
+/*
+Caches are scoped to the org of the provider configuration that uses them, so provider configurations for several orgs
+in one process never serve each other's lookups. The org of an operation is passed in its context.
+*/
+
+type orgContextKey struct{}
+
+type orgScope struct {
+	key          string
+	clientConfig *platformclientv2.Configuration
+}
+
+// OrgKey identifies an org in a region
+func OrgKey(orgId string, region string) string {
+	return fmt.Sprintf("%s_%s", orgId, strings.ToLower(region))
+}
+
+// ContextWithOrg returns a context for operations on the org with the given key. The client config is used by caches
+// that have to call the API of the org.
+func ContextWithOrg(ctx context.Context, orgKey string, clientConfig *platformclientv2.Configuration) context.Context {
+	return context.WithValue(ctx, orgContextKey{}, orgScope{key: orgKey, clientConfig: clientConfig})
+}
+
+func orgFromContext(ctx context.Context) (orgScope, bool) {
+	org, ok := ctx.Value(orgContextKey{}).(orgScope)
+	return org, ok && org.key != ""
+}
@@ -1,7 +1,10 @@
 package resource_cache
 
 import (
+	"context"
 	"log"
+	"sync"
+	"sync/atomic"
 	"terraform-provider-genesyscloud/genesyscloud/tfexporter_state"
 )
 
@@ -13,27 +16,128 @@ type CacheInterface[T any] interface {
 	Delete(key string)
 }
 
+/*
+The read-through cache allows resources to be served from their cache outside of exports, e.g. during a plan or a refresh.
+The first time a resource of a type is read, all resources of that type are listed in bulk and every following read
+of a single resource is served from the cache. Resources are removed from all caches whenever they are created, updated
+or deleted so they are read from the API again.
+
+The read-through cache is enabled per org, so provider configurations for several orgs in one process each use their own
+setting. Resource IDs are unique across orgs, so the orgs share the caches themselves.
+*/
+var (
+	readThroughCacheOrgs    = make(map[string]bool)
+	readThroughCacheEnabled atomic.Int32
+	readThroughCacheMutex   sync.RWMutex
+)
+
+// Every cache is registered so an item can be invalidated without knowing which cache holds it
+var (
+	registeredCaches      []interface{ Delete(key string) }
+	registeredCachesMutex sync.Mutex
+	hydratedCaches        sync.Map
+)
+
+// SetReadThroughCacheEnabled enables or disables the read-through cache of an org outside of exports. An empty org key
+// applies to operations whose context has no org.
+func SetReadThroughCacheEnabled(orgKey string, enabled bool) {
+	if enabled {
+		log.Printf("Read-through resource cache is enabled for org %s", orgKey)
+	}
+	readThroughCacheMutex.Lock()
+	defer readThroughCacheMutex.Unlock()
+	if readThroughCacheOrgs[orgKey] == enabled {
+		return
+	}
+	readThroughCacheOrgs[orgKey] = enabled
+	if enabled {
+		readThroughCacheEnabled.Add(1)
+	} else {
+		readThroughCacheEnabled.Add(-1)
+	}
+}
+
+// IsReadThroughCacheEnabled returns true if the read-through cache is enabled for the org of the context
+func IsReadThroughCacheEnabled(ctx context.Context) bool {
+	orgKey := ""
+	if org, ok := orgFromContext(ctx); ok {
+		orgKey = org.key
+	}
+	readThroughCacheMutex.RLock()
+	defer readThroughCacheMutex.RUnlock()
+	return readThroughCacheOrgs[orgKey]
+}
+
+// isCacheActive returns true if single resources can be set and deleted from the caches. Items are only served to orgs
+// that enabled the read-through cache, see GetCacheItem.
+func isCacheActive() bool {
+	return tfexporter_state.IsExporterActive() || readThroughCacheEnabled.Load() > 0
+}
+
 // NewResourceCache is a factory method to return the cache implementation. We have made this a cache so we can plugin in
 func NewResourceCache[T any]() CacheInterface[T] {
-	return &inMemoryCache[T]{ //This will show as a missing type in goland, but it compiles.  I think golang is have a problem resolving this
+	cache := &inMemoryCache[T]{ //This will show as a missing type in goland, but it compiles.  I think golang is have a problem resolving this
 		data: make(map[string]T),
 	}
+
+	registeredCachesMutex.Lock()
+	defer registeredCachesMutex.Unlock()
+	registeredCaches = append(registeredCaches, cache)
+	return cache
 }
 
 func SetCache[T any](cache CacheInterface[T], key string, value T) {
-	if tfexporter_state.IsExporterActive() {
+	if isCacheActive() {
 		cache.Set(key, value)
 	}
 }
 
 func DeleteCacheItem[T any](cache CacheInterface[T], key string) {
-	if tfexporter_state.IsExporterActive() {
+	if isCacheActive() {
 		cache.Delete(key)
 	}
 }
 
-func GetCacheItem[T any](cache CacheInterface[T], key string) *T {
-	if tfexporter_state.IsExporterActive() {
+// InvalidateCacheItem removes an item from every cache. It is called whenever a resource is created, updated or deleted.
+func InvalidateCacheItem(key string) {
+	if key == "" || !isCacheActive() {
+		return
+	}
+	registeredCachesMutex.Lock()
+	defer registeredCachesMutex.Unlock()
+	for _, cache := range registeredCaches {
+		cache.Delete(key)
+	}
+}
+
+// HydrateCache bulk loads a cache the first time it is read when the read-through cache is enabled. The hydrate function
+// is expected to call the GetAll function of the proxy, which sets every resource in the cache. Exports list all
+// resources themselves, so nothing is done during an export.
+func HydrateCache[T any](ctx context.Context, cache CacheInterface[T], hydrate func(ctx context.Context) error) {
+	if !IsReadThroughCacheEnabled(ctx) || tfexporter_state.IsExporterActive() {
+		return
+	}
+	// Every org hydrates the cache with its own resources
+	hydrationKey := struct {
+		cache  any
+		orgKey string
+	}{cache: cache}
+	if org, ok := orgFromContext(ctx); ok {
+		hydrationKey.orgKey = org.key
+	}
+	once, _ := hydratedCaches.LoadOrStore(hydrationKey, &sync.Once{})
+	once.(*sync.Once).Do(func() {
+		log.Printf("Hydrating the read-through resource cache")
+		if err := hydrate(ctx); err != nil {
+			// Reads fall back to the API for any resource that is not in the cache
+			log.Printf("Failed to hydrate the read-through resource cache: %v", err)
+		}
+	})
+}
+
+// GetCacheItem returns an item during an export, or when the org of the context enabled the read-through cache
+func GetCacheItem[T any](ctx context.Context, cache CacheInterface[T], key string) *T {
+	if tfexporter_state.IsExporterActive() || IsReadThroughCacheEnabled(ctx) {
 		eg, ok := cache.Get(key)
 		if ok {
 			return &eg
@@ -43,6 +147,8 @@ func GetCacheItem[T any](cache CacheInterface[T], key string) *T {
 	return nil
 }
 
+// GetCache returns all items of a cache during an export. It is not served by the read-through cache, because callers
+// use it in place of listing resources and a cache is only known to hold every resource during an export.
 func GetCache[T any](cache CacheInterface[T]) *[]T {
 	if tfexporter_state.IsExporterActive() {
 		items := cache.GetAll()
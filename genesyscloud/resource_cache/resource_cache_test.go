@@ -1,6 +1,7 @@
 package resource_cache
 
 import (
+	"context"
 	"terraform-provider-genesyscloud/genesyscloud/tfexporter_state"
 	"testing"
 )
@@ -11,18 +12,63 @@ func TestUnitWithoutExporterState(t *testing.T) {
 	SetCache(cache, "key1", 10)
 
 	// Test GetCacheItem
-	valPtr := GetCacheItem(cache, "key1")
+	valPtr := GetCacheItem(context.Background(), cache, "key1")
 	if valPtr != nil {
 		t.Errorf("Expected Nil Value for key 'key1', got %v", valPtr)
 	}
 
 	// Test GetCacheItem for non-existent key
-	valPtr = GetCacheItem(cache, "nonexistent")
+	valPtr = GetCacheItem(context.Background(), cache, "nonexistent")
 	if valPtr != nil {
 		t.Errorf("Expected nil value from the Cache")
 	}
 }
 
+// Must run before the exporter state is activated, because the read-through cache is not hydrated during an export
+func TestUnitReadThroughCache(t *testing.T) {
+	SetReadThroughCacheEnabled("", true)
+	defer SetReadThroughCacheEnabled("", false)
+
+	cache := NewResourceCache[int]()
+	otherCache := NewResourceCache[string]()
+
+	hydrateCount := 0
+	hydrate := func(ctx context.Context) error {
+		hydrateCount++
+		SetCache(cache, "key1", 10)
+		SetCache(cache, "key2", 20)
+		return nil
+	}
+	HydrateCache(context.Background(), cache, hydrate)
+	HydrateCache(context.Background(), cache, hydrate)
+	if hydrateCount != 1 {
+		t.Errorf("Expected the cache to be hydrated once, got %d", hydrateCount)
+	}
+
+	valPtr := GetCacheItem(context.Background(), cache, "key1")
+	if valPtr == nil || *valPtr != 10 {
+		t.Errorf("Expected value %d for key 'key1', got %v", 10, valPtr)
+	}
+
+	// Invalidating an item removes it from every cache
+	SetCache(otherCache, "key1", "value")
+	InvalidateCacheItem("key1")
+	if valPtr := GetCacheItem(context.Background(), cache, "key1"); valPtr != nil {
+		t.Errorf("Expected key 'key1' to be invalidated, got %v", *valPtr)
+	}
+	if valPtr := GetCacheItem(context.Background(), otherCache, "key1"); valPtr != nil {
+		t.Errorf("Expected key 'key1' to be invalidated in every cache, got %v", *valPtr)
+	}
+	if valPtr := GetCacheItem(context.Background(), cache, "key2"); valPtr == nil || *valPtr != 20 {
+		t.Errorf("Expected value %d for key 'key2', got %v", 20, valPtr)
+	}
+
+	// GetCache is not served by the read-through cache
+	if items := GetCache(cache); items != nil {
+		t.Errorf("Expected nil from GetCache outside of an export, got %v", *items)
+	}
+}
+
 func TestUnitSetCacheAndGetCache(t *testing.T) {
 	tfexporter_state.ActivateExporterState()
 	cache := NewResourceCache[int]()
@@ -30,13 +76,13 @@ func TestUnitSetCacheAndGetCache(t *testing.T) {
 	SetCache(cache, "key1", 10)
 
 	// Test GetCacheItem
-	valPtr := GetCacheItem(cache, "key1")
+	valPtr := GetCacheItem(context.Background(), cache, "key1")
 	if *valPtr != 10 {
 		t.Errorf("Expected value %d for key 'key1', got %v", 10, valPtr)
 	}
 
 	// Test GetCacheItem for non-existent key
-	valPtr = GetCacheItem(cache, "nonexistent")
+	valPtr = GetCacheItem(context.Background(), cache, "nonexistent")
 	if &valPtr == nil {
 		t.Errorf("Expected key 'nonexistent' to not exist in the cache")
 	}
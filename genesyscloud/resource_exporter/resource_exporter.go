@@ -27,6 +27,12 @@ type ResourceMeta struct {
 	IdPrefix string
 
 	OriginalLabel string
+
+	// Version or last modified date of the resource as returned by the API. Incremental exports use this value to
+	// determine if a resource changed since a previous export, so it must change whenever any attribute read by the
+	// resource changes. Only set it if that holds, e.g. not for a version that ignores members read from another API.
+	// Resources without a version are always read.
+	Version string
 }
 
 // ResourceIDMetaMap is a map of IDs to ResourceMeta
@@ -54,6 +60,8 @@ type ResourceInfo struct {
 	Type          string
 	CtyType       cty.Type
 	BlockType     string
+	// ImportId is the ID passed to the resource's importer. It includes the IdPrefix of the resource, if any.
+	ImportId string
 }
 
 // DataSourceResolver allows the definition of a custom resolver for an exporter.
@@ -169,7 +177,12 @@ type ResourceExporter struct {
 	FilterResource func(resourceIdMetaMap ResourceIDMetaMap, resourceType string, filter []string) ResourceIDMetaMap
 	// Attributes that are mentioned with custom exports like e164 numbers,rrule  should be ensured to export in the correct format (remove hyphens, whitespace, etc.)
 	CustomValidateExports map[string][]string
-	mutex                 sync.RWMutex
+
+	// Replaces is the resource type of an exporter that exports the same objects in another way. An exporter that replaces
+	// another one is only used when its resource type is listed in the replacement_exporters attribute of the export, in
+	// which case the replaced exporter is not used, so the objects are never exported twice.
+	Replaces string
+	mutex    sync.RWMutex
 }
 
 func (r *ResourceExporter) LoadSanitizedResourceMap(ctx context.Context, resourceType string, filter []string) diag.Diagnostics {
@@ -328,6 +341,17 @@ func GetAvailableExporterTypes() []string {
 	return types
 }
 
+// GetReplacementExporterTypes returns the resource types of the exporters that replace the exporter of another resource type
+func GetReplacementExporterTypes() []string {
+	types := make([]string, 0)
+	for resourceType, exporter := range GetResourceExporters() {
+		if exporter.Replaces != "" {
+			types = append(types, resourceType)
+		}
+	}
+	return types
+}
+
 func escapeRune(s string) string {
 	// Always replace with an underscore for readability. The appended hash will help ensure uniqueness
 	return "_"
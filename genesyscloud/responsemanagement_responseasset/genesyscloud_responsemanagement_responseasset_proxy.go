@@ -15,9 +15,11 @@ with the Genesys Cloud SDK. We use composition here for each function on the pro
 out during testing.
 */
 
-// internalProxy holds a proxy instance that can be used throughout the package
+// internalProxy holds a proxy instance that unit tests can set to replace the proxy of the package
 var internalProxy *responsemanagementResponseassetProxy
 
+var assetCache = rc.NewResourceCache[platformclientv2.Responseasset]()
+
 // Type definitions for each func on our proxy so we can easily mock them out later
 type getAllResponseAssetsFunc func(ctx context.Context, p *responsemanagementResponseassetProxy) (*[]platformclientv2.Responseasset, *platformclientv2.APIResponse, error)
 type createRespManagementRespAssetFunc func(ctx context.Context, p *responsemanagementResponseassetProxy, respAsset *platformclientv2.Createresponseassetrequest) (*platformclientv2.Createresponseassetresponse, *platformclientv2.APIResponse, error)
@@ -42,7 +44,6 @@ type responsemanagementResponseassetProxy struct {
 // newRespManagementRespAssetProxy initializes the responsemanagement responseasset proxy with all of the data needed to communicate with Genesys Cloud
 func newRespManagementRespAssetProxy(clientConfig *platformclientv2.Configuration) *responsemanagementResponseassetProxy {
 	api := platformclientv2.NewResponseManagementApiWithConfig(clientConfig)
-	assetCache := rc.NewResourceCache[platformclientv2.Responseasset]()
 	return &responsemanagementResponseassetProxy{
 		clientConfig:                         clientConfig,
 		responseManagementApi:                api,
@@ -56,13 +57,14 @@ func newRespManagementRespAssetProxy(clientConfig *platformclientv2.Configuratio
 	}
 }
 
-// getRespManagementRespAssetProxy acts as a singleton to for the internalProxy.  It also ensures
-// that we can still proxy our tests by directly setting internalProxy package variable
+// getRespManagementRespAssetProxy returns a new proxy for the client config of every call, so provider configurations for
+// several orgs never share a proxy. It also ensures that we can still proxy our tests by directly setting
+// internalProxy package variable
 func getRespManagementRespAssetProxy(clientConfig *platformclientv2.Configuration) *responsemanagementResponseassetProxy {
-	if internalProxy == nil {
-		internalProxy = newRespManagementRespAssetProxy(clientConfig)
+	if internalProxy != nil {
+		return internalProxy
 	}
-	return internalProxy
+	return newRespManagementRespAssetProxy(clientConfig)
 }
 
 func (p *responsemanagementResponseassetProxy) getAllResponseAssets(ctx context.Context) (*[]platformclientv2.Responseasset, *platformclientv2.APIResponse, error) {
@@ -154,7 +156,7 @@ func updateRespManagementRespAssetFn(ctx context.Context, p *responsemanagementR
 
 // getRespManagementRespAssetByIdFn is an implementation of the function to get a Genesys Cloud responsemanagement responseasset by Id
 func getRespManagementRespAssetByIdFn(ctx context.Context, p *responsemanagementResponseassetProxy, id string) (*platformclientv2.Responseasset, *platformclientv2.APIResponse, error) {
-	asset := rc.GetCacheItem(p.assetCache, id)
+	asset := rc.GetCacheItem(ctx, p.assetCache, id)
 	if asset != nil {
 		return asset, nil, nil
 	}
@@ -11,6 +11,8 @@ import (
 
 var internalProxy *routingEmailDomainProxy
 
+var routingEmailDomainCache = rc.NewResourceCache[platformclientv2.Inbounddomain]()
+
 type getAllRoutingEmailDomainsFunc func(ctx context.Context, p *routingEmailDomainProxy) (*[]platformclientv2.Inbounddomain, *platformclientv2.APIResponse, error)
 type createRoutingEmailDomainFunc func(ctx context.Context, p *routingEmailDomainProxy, inboundDomain *platformclientv2.Inbounddomain) (*platformclientv2.Inbounddomain, *platformclientv2.APIResponse, error)
 type getRoutingEmailDomainByIdFunc func(ctx context.Context, p *routingEmailDomainProxy, id string) (*platformclientv2.Inbounddomain, *platformclientv2.APIResponse, error)
@@ -34,7 +36,6 @@ type routingEmailDomainProxy struct {
 // newRoutingEmailDomainProxy initializes the routing email domain proxy with all of the data needed to communicate with Genesys Cloud
 func newRoutingEmailDomainProxy(clientConfig *platformclientv2.Configuration) *routingEmailDomainProxy {
 	api := platformclientv2.NewRoutingApiWithConfig(clientConfig)
-	routingEmailDomainCache := rc.NewResourceCache[platformclientv2.Inbounddomain]()
 	return &routingEmailDomainProxy{
 		clientConfig:                      clientConfig,
 		routingApi:                        api,
@@ -48,13 +49,14 @@ func newRoutingEmailDomainProxy(clientConfig *platformclientv2.Configuration) *r
 	}
 }
 
-// getRoutingEmailDomainProxy acts as a singleton to for the internalProxy.  It also ensures
-// that we can still proxy our tests by directly setting internalProxy package variable
+// getRoutingEmailDomainProxy returns a new proxy for the client config of every call, so provider configurations for
+// several orgs never share a proxy. It also ensures that we can still proxy our tests by directly setting
+// internalProxy package variable
 func getRoutingEmailDomainProxy(clientConfig *platformclientv2.Configuration) *routingEmailDomainProxy {
-	if internalProxy == nil {
-		internalProxy = newRoutingEmailDomainProxy(clientConfig)
+	if internalProxy != nil {
+		return internalProxy
 	}
-	return internalProxy
+	return newRoutingEmailDomainProxy(clientConfig)
 }
 
 func (p *routingEmailDomainProxy) getAllRoutingEmailDomains(ctx context.Context) (*[]platformclientv2.Inbounddomain, *platformclientv2.APIResponse, error) {
@@ -127,7 +129,7 @@ func createRoutingEmailDomainFn(ctx context.Context, p *routingEmailDomainProxy,
 }
 
 func getRoutingEmailDomainByIdFn(ctx context.Context, p *routingEmailDomainProxy, id string) (*platformclientv2.Inbounddomain, *platformclientv2.APIResponse, error) {
-	if domain := rc.GetCacheItem(p.routingEmailDomainCache, id); domain != nil {
+	if domain := rc.GetCacheItem(ctx, p.routingEmailDomainCache, id); domain != nil {
 		return domain, nil, nil
 	}
 	return p.routingApi.GetRoutingEmailDomain(id)
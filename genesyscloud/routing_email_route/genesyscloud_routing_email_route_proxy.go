@@ -15,7 +15,7 @@ with the Genesys Cloud SDK. We use composition here for each function on the pro
 out during testing.
 */
 
-// internalProxy holds a proxy instance that can be used throughout the package
+// internalProxy holds a proxy instance that unit tests can set to replace the proxy of the package
 var internalProxy *routingEmailRouteProxy
 
 // Type definitions for each func on our proxy so we can easily mock them out later
@@ -53,13 +53,14 @@ func newRoutingEmailRouteProxy(clientConfig *platformclientv2.Configuration) *ro
 	}
 }
 
-// getRoutingEmailRouteProxy acts as a singleton to for the internalProxy.  It also ensures
-// that we can still proxy our tests by directly setting internalProxy package variable
+// getRoutingEmailRouteProxy returns a new proxy for the client config of every call, so provider configurations for
+// several orgs never share a proxy. It also ensures that we can still proxy our tests by directly setting
+// internalProxy package variable
 func getRoutingEmailRouteProxy(clientConfig *platformclientv2.Configuration) *routingEmailRouteProxy {
-	if internalProxy == nil {
-		internalProxy = newRoutingEmailRouteProxy(clientConfig)
+	if internalProxy != nil {
+		return internalProxy
 	}
-	return internalProxy
+	return newRoutingEmailRouteProxy(clientConfig)
 }
 
 // createRoutingEmailRoute creates a Genesys Cloud routing email route
@@ -3,7 +3,9 @@ package routing_language
 import (
 	"context"
 	"fmt"
+	"log"
 	"terraform-provider-genesyscloud/genesyscloud/provider"
+	rc "terraform-provider-genesyscloud/genesyscloud/resource_cache"
 	"terraform-provider-genesyscloud/genesyscloud/util"
 	"time"
 
@@ -13,14 +15,55 @@ import (
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 )
 
+var dataSourceRoutingLanguageCache *rc.DataSourceCache
+
 func dataSourceRoutingLanguageRead(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	sdkConfig := m.(*provider.ProviderMeta).ClientConfig
-	proxy := getRoutingLanguageProxy(sdkConfig)
 	name := d.Get("name").(string)
 
+	if dataSourceRoutingLanguageCache == nil {
+		dataSourceRoutingLanguageCache = rc.NewDataSourceCache(sdkConfig, hydrateRoutingLanguageCacheFn, getLanguageByNameFn)
+	}
+
+	languageId, err := rc.RetrieveId(dataSourceRoutingLanguageCache, ResourceType, name, ctx)
+	if err != nil {
+		return err
+	}
+
+	d.SetId(languageId)
+	return nil
+}
+
+func hydrateRoutingLanguageCacheFn(c *rc.DataSourceCache, ctx context.Context) error {
+	log.Printf("hydrating cache for data source %s", ResourceType)
+	proxy := getRoutingLanguageProxy(c.ClientConfig)
+
+	languages, resp, getErr := proxy.getAllRoutingLanguages(ctx, "")
+	if getErr != nil {
+		return fmt.Errorf("failed to get page of languages: %v %v", getErr, resp)
+	}
+
+	if languages == nil || len(*languages) == 0 {
+		log.Printf("no languages returned. Cache will remain empty")
+		return nil
+	}
+
+	for _, language := range *languages {
+		c.Cache[*language.Name] = *language.Id
+	}
+
+	log.Printf("cache hydration completed for data source %s", ResourceType)
+
+	return nil
+}
+
+func getLanguageByNameFn(c *rc.DataSourceCache, name string, ctx context.Context) (string, diag.Diagnostics) {
+	languageId := ""
+	proxy := getRoutingLanguageProxy(c.ClientConfig)
+
 	// Find first non-deleted language by name. Retry in case new language is not yet indexed by search
-	return util.WithRetries(ctx, 15*time.Second, func() *retry.RetryError {
-		languageId, resp, retryable, err := proxy.getRoutingLanguageIdByName(ctx, name)
+	diag := util.WithRetries(ctx, 15*time.Second, func() *retry.RetryError {
+		language, resp, retryable, err := proxy.getRoutingLanguageIdByName(ctx, name)
 		if err != nil && !retryable {
 			return retry.NonRetryableError(util.BuildWithRetriesApiDiagnosticError(ResourceType, fmt.Sprintf("Error requesting language %s | error: %s", name, err), resp))
 		}
@@ -28,7 +71,8 @@ func dataSourceRoutingLanguageRead(ctx context.Context, d *schema.ResourceData,
 			return retry.RetryableError(util.BuildWithRetriesApiDiagnosticError(ResourceType, fmt.Sprintf("Error requesting language %s | error: %s", name, err), resp))
 		}
 
-		d.SetId(languageId)
+		languageId = language
 		return nil
 	})
+	return languageId, diag
 }
@@ -11,6 +11,8 @@ import (
 
 var internalProxy *routingLanguageProxy
 
+var routingLanguageCache = rc.NewResourceCache[platformclientv2.Language]()
+
 type getAllRoutingLanguagesFunc func(ctx context.Context, p *routingLanguageProxy, name string) (*[]platformclientv2.Language, *platformclientv2.APIResponse, error)
 type createRoutingLanguageFunc func(ctx context.Context, p *routingLanguageProxy, language *platformclientv2.Language) (*platformclientv2.Language, *platformclientv2.APIResponse, error)
 type getRoutingLanguageByIdFunc func(ctx context.Context, p *routingLanguageProxy, id string) (*platformclientv2.Language, *platformclientv2.APIResponse, error)
@@ -32,7 +34,6 @@ type routingLanguageProxy struct {
 // newRoutingLanguageProxy initializes the routing language proxy with all of the data needed to communicate with Genesys Cloud
 func newRoutingLanguageProxy(clientConfig *platformclientv2.Configuration) *routingLanguageProxy {
 	api := platformclientv2.NewRoutingApiWithConfig(clientConfig)
-	routingLanguageCache := rc.NewResourceCache[platformclientv2.Language]()
 	return &routingLanguageProxy{
 		clientConfig:                   clientConfig,
 		routingApi:                     api,
@@ -46,10 +47,10 @@ func newRoutingLanguageProxy(clientConfig *platformclientv2.Configuration) *rout
 }
 
 func getRoutingLanguageProxy(clientConfig *platformclientv2.Configuration) *routingLanguageProxy {
-	if internalProxy == nil {
-		internalProxy = newRoutingLanguageProxy(clientConfig)
+	if internalProxy != nil {
+		return internalProxy
 	}
-	return internalProxy
+	return newRoutingLanguageProxy(clientConfig)
 }
 
 // getRoutingLanguage retrieves all Genesys Cloud routing language
@@ -122,7 +123,7 @@ func createRoutingLanguageFn(ctx context.Context, p *routingLanguageProxy, routi
 
 // getRoutingLanguageByIdFn is an implementation of the function to get a Genesys Cloud routing language by Id
 func getRoutingLanguageByIdFn(ctx context.Context, p *routingLanguageProxy, id string) (*platformclientv2.Language, *platformclientv2.APIResponse, error) {
-	if language := rc.GetCacheItem(p.routingLanguageCache, id); language != nil {
+	if language := rc.GetCacheItem(ctx, p.routingLanguageCache, id); language != nil {
 		return language, nil, nil
 	}
 	return p.routingApi.GetRoutingLanguage(id)
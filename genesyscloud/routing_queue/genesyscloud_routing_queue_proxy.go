@@ -188,7 +188,11 @@ func createRoutingQueueFn(ctx context.Context, p *RoutingQueueProxy, createReq *
 // getRoutingQueueByIdFn is the implementation for retrieving a routing queues in Genesys Cloud
 func getRoutingQueueByIdFn(ctx context.Context, p *RoutingQueueProxy, queueId string, checkCache bool) (*platformclientv2.Queue, *platformclientv2.APIResponse, error) {
 	if checkCache {
-		queue := rc.GetCacheItem(p.RoutingQueueCache, queueId)
+		rc.HydrateCache(ctx, p.RoutingQueueCache, func(ctx context.Context) error {
+			_, _, err := p.GetAllRoutingQueues(ctx, "", false)
+			return err
+		})
+		queue := rc.GetCacheItem(ctx, p.RoutingQueueCache, queueId)
 		if queue != nil {
 			return queue, nil, nil
 		}
@@ -6,6 +6,7 @@ import (
 	"log"
 	"net/http"
 	"terraform-provider-genesyscloud/genesyscloud/consistency_checker"
+	dependentConsumers "terraform-provider-genesyscloud/genesyscloud/dependent_consumers"
 	"terraform-provider-genesyscloud/genesyscloud/provider"
 	"terraform-provider-genesyscloud/genesyscloud/util"
 	"terraform-provider-genesyscloud/genesyscloud/util/constants"
@@ -417,6 +418,10 @@ func deleteRoutingQueue(ctx context.Context, d *schema.ResourceData, meta interf
 	sdkConfig := meta.(*provider.ProviderMeta).ClientConfig
 	proxy := GetRoutingQueueProxy(sdkConfig)
 
+	if diagErr := dependentConsumers.CheckDeleteProtection(ctx, meta, ResourceType, d.Id()); diagErr != nil {
+		return diagErr
+	}
+
 	log.Printf("Deleting queue %s", name)
 	resp, err := proxy.deleteRoutingQueue(ctx, d.Id(), true)
 	if err != nil {
@@ -9,7 +9,7 @@ import (
 	"github.com/mypurecloud/platform-client-sdk-go/v152/platformclientv2"
 )
 
-// internalProxy holds a proxy instance that can be used throughout the package
+// internalProxy holds a proxy instance that unit tests can set to replace the proxy of the package
 var internalProxy *routingQueueConditionalGroupRoutingProxy
 
 // Type definitions for each func on our proxy so we can easily mock them out later
@@ -44,11 +44,10 @@ func newRoutingQueueConditionalGroupRoutingProxy(clientConfig *platformclientv2.
 
 // getRoutingQueueConditionalGroupRoutingProxy retrieves all Genesys Cloud Routing queue conditional group routing
 func getRoutingQueueConditionalGroupRoutingProxy(clientConfig *platformclientv2.Configuration) *routingQueueConditionalGroupRoutingProxy {
-	if internalProxy == nil {
-		internalProxy = newRoutingQueueConditionalGroupRoutingProxy(clientConfig)
+	if internalProxy != nil {
+		return internalProxy
 	}
-
-	return internalProxy
+	return newRoutingQueueConditionalGroupRoutingProxy(clientConfig)
 }
 
 // getRoutingQueueById get a queue by ID
@@ -74,7 +73,7 @@ func getRoutingQueueConditionRoutingFn(ctx context.Context, p *routingQueueCondi
 		err   error
 	)
 
-	queue = rc.GetCacheItem(p.routingQueueProxy.RoutingQueueCache, queueId)
+	queue = rc.GetCacheItem(ctx, p.routingQueueProxy.RoutingQueueCache, queueId)
 	if queue == nil {
 		queue, resp, err = p.getRoutingQueueById(ctx, queueId)
 		if err != nil {
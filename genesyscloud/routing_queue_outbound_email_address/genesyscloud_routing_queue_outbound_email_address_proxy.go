@@ -9,7 +9,7 @@ import (
 	"github.com/mypurecloud/platform-client-sdk-go/v152/platformclientv2"
 )
 
-// internalProxy holds a proxy instance that can be used throughout the package
+// internalProxy holds a proxy instance that unit tests can set to replace the proxy of the package
 var internalProxy *routingQueueOutboundEmailAddressProxy
 
 type getRoutingQueueOutboundEmailAddressFunc func(ctx context.Context, p *routingQueueOutboundEmailAddressProxy, queueId string) (*platformclientv2.Queueemailaddress, *platformclientv2.APIResponse, error)
@@ -39,11 +39,10 @@ func newRoutingQueueOutboundEmailAddressProxy(clientConfig *platformclientv2.Con
 }
 
 func getRoutingQueueOutboundEmailAddressProxy(clientConfig *platformclientv2.Configuration) *routingQueueOutboundEmailAddressProxy {
-	if internalProxy == nil {
-		internalProxy = newRoutingQueueOutboundEmailAddressProxy(clientConfig)
+	if internalProxy != nil {
+		return internalProxy
 	}
-
-	return internalProxy
+	return newRoutingQueueOutboundEmailAddressProxy(clientConfig)
 }
 
 // getRoutingQueueOutboundEmailAddress gets the Outbound Email Address for a queue
@@ -64,7 +63,7 @@ func getRoutingQueueOutboundEmailAddressFn(ctx context.Context, p *routingQueueO
 		err   error
 	)
 
-	queue = rc.GetCacheItem(p.routingQueueProxy.RoutingQueueCache, queueId)
+	queue = rc.GetCacheItem(ctx, p.routingQueueProxy.RoutingQueueCache, queueId)
 	if queue == nil {
 		queue, resp, err = p.routingApi.GetRoutingQueue(queueId)
 		if err != nil {
@@ -48,10 +48,10 @@ func newRoutingSettingsProxy(clientConfig *platformclientv2.Configuration) *rout
 }
 
 func getRoutingSettingsProxy(clientConfig *platformclientv2.Configuration) *routingSettingsProxy {
-	if internalProxy == nil {
-		internalProxy = newRoutingSettingsProxy(clientConfig)
+	if internalProxy != nil {
+		return internalProxy
 	}
-	return internalProxy
+	return newRoutingSettingsProxy(clientConfig)
 }
 
 func (p *routingSettingsProxy) getRoutingSettings(ctx context.Context) (*platformclientv2.Routingsettings, *platformclientv2.APIResponse, error) {
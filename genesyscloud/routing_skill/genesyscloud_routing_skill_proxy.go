@@ -109,7 +109,11 @@ func createRoutingSkillFn(ctx context.Context, p *routingSkillProxy, routingSkil
 }
 
 func getRoutingSkillByIdFn(ctx context.Context, p *routingSkillProxy, id string) (*platformclientv2.Routingskill, *platformclientv2.APIResponse, error) {
-	if skill := rc.GetCacheItem(p.routingSkillCache, id); skill != nil {
+	rc.HydrateCache(ctx, p.routingSkillCache, func(ctx context.Context) error {
+		_, _, err := p.getAllRoutingSkills(ctx, "")
+		return err
+	})
+	if skill := rc.GetCacheItem(ctx, p.routingSkillCache, id); skill != nil {
 		return skill, nil, nil
 	}
 	return p.routingApi.GetRoutingSkill(id)
@@ -32,6 +32,9 @@ func GetAllRoutingSkills(ctx context.Context, clientConfig *platformclientv2.Con
 	for _, skill := range *skills {
 		if skill.State != nil && *skill.State != "deleted" {
 			resources[*skill.Id] = &resourceExporter.ResourceMeta{BlockLabel: *skill.Name}
+			if skill.Version != nil {
+				resources[*skill.Id].Version = *skill.Version
+			}
 		}
 	}
 
@@ -1,13 +1,16 @@
 package routing_skill
 
 import (
+	"context"
 	"fmt"
+	"os"
 	"terraform-provider-genesyscloud/genesyscloud/provider"
 	"terraform-provider-genesyscloud/genesyscloud/util"
+	"terraform-provider-genesyscloud/genesyscloud/util/testrunner"
 	"testing"
 
-	"github.com/google/uuid"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
 	"github.com/mypurecloud/platform-client-sdk-go/v152/platformclientv2"
 )
@@ -15,10 +18,10 @@ import (
 func TestAccResourceRoutingSkillBasic(t *testing.T) {
 	var (
 		skillResourceLabel1 = "test-skill1"
-		skillName1          = "Terraform Skill" + uuid.NewString()
+		skillName1          = "Terraform Skill" + testrunner.NewUUID(t)
 	)
 
-	resource.Test(t, resource.TestCase{
+	testrunner.Test(t, resource.TestCase{
 		PreCheck:          func() { util.TestAccPreCheck(t) },
 		ProviderFactories: provider.GetProviderFactories(providerResources, providerDataSources),
 		Steps: []resource.TestStep{
@@ -43,6 +46,45 @@ func TestAccResourceRoutingSkillBasic(t *testing.T) {
 	})
 }
 
+// TestAccResourceRoutingSkillLifecycle creates, reads and deletes a skill with the functions of the resource instead of the
+// Terraform CLI, so its cassette can be replayed wherever the Go tests run
+func TestAccResourceRoutingSkillLifecycle(t *testing.T) {
+	if os.Getenv(resource.EnvTfAcc) == "" {
+		t.Skipf("Acceptance tests skipped unless env '%s' set", resource.EnvTfAcc)
+	}
+	testrunner.UseCassette(t)
+	util.TestAccPreCheck(t)
+
+	sdkConfig, err := provider.AuthorizeSdk()
+	if err != nil {
+		t.Fatalf("Failed to authorize the SDK: %v", err)
+	}
+	var (
+		ctx       = context.Background()
+		meta      = &provider.ProviderMeta{ClientConfig: sdkConfig}
+		skillName = "Terraform Skill " + testrunner.NewUUID(t)
+		d         = schema.TestResourceDataRaw(t, ResourceRoutingSkill().Schema, map[string]interface{}{"name": skillName})
+	)
+
+	if diagErr := createRoutingSkill(ctx, d, meta); diagErr.HasError() {
+		t.Fatalf("Failed to create skill %s: %v", skillName, diagErr)
+	}
+	if d.Id() == "" || d.Get("name") != skillName {
+		t.Fatalf("Expected skill %s to be read after it was created, got ID '%s' and name '%v'", skillName, d.Id(), d.Get("name"))
+	}
+
+	skillId := d.Id()
+	if diagErr := deleteRoutingSkill(ctx, d, meta); diagErr.HasError() {
+		t.Fatalf("Failed to delete skill %s: %v", skillId, diagErr)
+	}
+	if diagErr := readRoutingSkill(ctx, d, meta); diagErr.HasError() {
+		t.Fatalf("Failed to read deleted skill %s: %v", skillId, diagErr)
+	}
+	if d.Id() != "" {
+		t.Errorf("Expected deleted skill %s to be removed from the state", skillId)
+	}
+}
+
 func testVerifySkillsDestroyed(state *terraform.State) error {
 	routingAPI := platformclientv2.NewRoutingApi()
 	for _, rs := range state.RootModule().Resources {
@@ -24,7 +24,7 @@ type routingSmsAddressProxy struct {
 	deleteSmsAddressByIdAttr  deleteSmsAddressByIdFunc
 }
 
-// internalProxy holds a proxy instance that can be used throughout the package
+// internalProxy holds a proxy instance that unit tests can set to replace the proxy of the package
 var internalProxy *routingSmsAddressProxy
 
 // newRoutingSmsAddressProxy initializes the sms address proxy with all of the data needed to communicate with Genesys Cloud
@@ -40,13 +40,14 @@ func newRoutingSmsAddressProxy(clientConfig *platformclientv2.Configuration) *ro
 	}
 }
 
-// getRoutingSmsAddressProxy acts as a singleton for the internalProxy.  It also ensures
-// that we can still proxy our tests by directly setting internalProxy package variable
+// getRoutingSmsAddressProxy returns a new proxy for the client config of every call, so provider configurations for
+// several orgs never share a proxy. It also ensures that we can still proxy our tests by directly setting
+// internalProxy package variable
 func getRoutingSmsAddressProxy(clientConfig *platformclientv2.Configuration) *routingSmsAddressProxy {
-	if internalProxy == nil {
-		internalProxy = newRoutingSmsAddressProxy(clientConfig)
+	if internalProxy != nil {
+		return internalProxy
 	}
-	return internalProxy
+	return newRoutingSmsAddressProxy(clientConfig)
 }
 
 // createSmsAddress creates a Genesys Cloud Sms Address
@@ -32,10 +32,10 @@ func newRoutingUtilizationProxy(clientConfig *platformclientv2.Configuration) *r
 }
 
 func getRoutingUtilizationProxy(clientConfig *platformclientv2.Configuration) *routingUtilizationProxy {
-	if internalProxy == nil {
-		internalProxy = newRoutingUtilizationProxy(clientConfig)
+	if internalProxy != nil {
+		return internalProxy
 	}
-	return internalProxy
+	return newRoutingUtilizationProxy(clientConfig)
 }
 
 func (p *routingUtilizationProxy) getRoutingUtilization(ctx context.Context) (*platformclientv2.Utilizationresponse, *platformclientv2.APIResponse, error) {
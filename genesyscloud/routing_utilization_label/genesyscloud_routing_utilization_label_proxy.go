@@ -11,6 +11,8 @@ import (
 
 var internalProxy *routingUtilizationLabelProxy
 
+var routingCache = rc.NewResourceCache[platformclientv2.Utilizationlabel]()
+
 type getAllRoutingUtilizationLabelsFunc func(ctx context.Context, p *routingUtilizationLabelProxy, name string) (*[]platformclientv2.Utilizationlabel, *platformclientv2.APIResponse, error)
 type createRoutingUtilizationLabelFunc func(ctx context.Context, p *routingUtilizationLabelProxy, req *platformclientv2.Createutilizationlabelrequest) (*platformclientv2.Utilizationlabel, *platformclientv2.APIResponse, error)
 type getRoutingUtilizationLabelFunc func(ctx context.Context, p *routingUtilizationLabelProxy, id string) (*platformclientv2.Utilizationlabel, *platformclientv2.APIResponse, error)
@@ -32,7 +34,6 @@ type routingUtilizationLabelProxy struct {
 
 func newRoutingUtilizationLabelProxy(clientConfig *platformclientv2.Configuration) *routingUtilizationLabelProxy {
 	api := platformclientv2.NewRoutingApiWithConfig(clientConfig)
-	routingCache := rc.NewResourceCache[platformclientv2.Utilizationlabel]()
 	return &routingUtilizationLabelProxy{
 		clientConfig:                         clientConfig,
 		routingApi:                           api,
@@ -47,10 +48,10 @@ func newRoutingUtilizationLabelProxy(clientConfig *platformclientv2.Configuratio
 }
 
 func getRoutingUtilizationLabelProxy(clientConfig *platformclientv2.Configuration) *routingUtilizationLabelProxy {
-	if internalProxy == nil {
-		internalProxy = newRoutingUtilizationLabelProxy(clientConfig)
+	if internalProxy != nil {
+		return internalProxy
 	}
-	return internalProxy
+	return newRoutingUtilizationLabelProxy(clientConfig)
 }
 
 func (p *routingUtilizationLabelProxy) getAllRoutingUtilizationLabels(ctx context.Context, name string) (*[]platformclientv2.Utilizationlabel, *platformclientv2.APIResponse, error) {
@@ -114,8 +115,8 @@ func createRoutingUtilizationLabelFn(_ context.Context, p *routingUtilizationLab
 	return p.routingApi.PostRoutingUtilizationLabels(*req)
 }
 
-func getRoutingUtilizationLabelFn(_ context.Context, p *routingUtilizationLabelProxy, id string) (*platformclientv2.Utilizationlabel, *platformclientv2.APIResponse, error) {
-	if label := rc.GetCacheItem(p.routingCache, id); label != nil {
+func getRoutingUtilizationLabelFn(ctx context.Context, p *routingUtilizationLabelProxy, id string) (*platformclientv2.Utilizationlabel, *platformclientv2.APIResponse, error) {
+	if label := rc.GetCacheItem(ctx, p.routingCache, id); label != nil {
 		return label, nil, nil
 	}
 	return p.routingApi.GetRoutingUtilizationLabel(id)
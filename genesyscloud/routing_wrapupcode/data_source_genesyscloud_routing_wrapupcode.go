@@ -3,7 +3,9 @@ package routing_wrapupcode
 import (
 	"context"
 	"fmt"
+	"log"
 	"terraform-provider-genesyscloud/genesyscloud/provider"
+	rc "terraform-provider-genesyscloud/genesyscloud/resource_cache"
 	"terraform-provider-genesyscloud/genesyscloud/util"
 	"time"
 
@@ -13,14 +15,54 @@ import (
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 )
 
+var dataSourceRoutingWrapupcodeCache *rc.DataSourceCache
+
 func dataSourceRoutingWrapupcodeRead(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	sdkConfig := m.(*provider.ProviderMeta).ClientConfig
+	name := d.Get("name").(string)
 
-	proxy := getRoutingWrapupcodeProxy(m.(*provider.ProviderMeta).ClientConfig)
+	if dataSourceRoutingWrapupcodeCache == nil {
+		dataSourceRoutingWrapupcodeCache = rc.NewDataSourceCache(sdkConfig, hydrateRoutingWrapupcodeCacheFn, getWrapupcodeByNameFn)
+	}
 
-	name := d.Get("name").(string)
+	wrapupcodeId, err := rc.RetrieveId(dataSourceRoutingWrapupcodeCache, ResourceType, name, ctx)
+	if err != nil {
+		return err
+	}
+
+	d.SetId(wrapupcodeId)
+	return nil
+}
+
+func hydrateRoutingWrapupcodeCacheFn(c *rc.DataSourceCache, ctx context.Context) error {
+	log.Printf("hydrating cache for data source %s", ResourceType)
+	proxy := getRoutingWrapupcodeProxy(c.ClientConfig)
+
+	wrapupcodes, resp, getErr := proxy.getAllRoutingWrapupcode(ctx)
+	if getErr != nil {
+		return fmt.Errorf("failed to get page of wrap-up codes: %v %v", getErr, resp)
+	}
+
+	if wrapupcodes == nil || len(*wrapupcodes) == 0 {
+		log.Printf("no wrap-up codes returned. Cache will remain empty")
+		return nil
+	}
+
+	for _, wrapupcode := range *wrapupcodes {
+		c.Cache[*wrapupcode.Name] = *wrapupcode.Id
+	}
+
+	log.Printf("cache hydration completed for data source %s", ResourceType)
+
+	return nil
+}
+
+func getWrapupcodeByNameFn(c *rc.DataSourceCache, name string, ctx context.Context) (string, diag.Diagnostics) {
+	wrapupcodeId := ""
+	proxy := getRoutingWrapupcodeProxy(c.ClientConfig)
 
-	return util.WithRetries(ctx, 15*time.Second, func() *retry.RetryError {
-		wrapupcodeId, retryable, proxyResponse, err := proxy.getRoutingWrapupcodeIdByName(ctx, name)
+	diag := util.WithRetries(ctx, 15*time.Second, func() *retry.RetryError {
+		wrapupcode, retryable, proxyResponse, err := proxy.getRoutingWrapupcodeIdByName(ctx, name)
 
 		if err != nil && !retryable {
 			return retry.NonRetryableError(util.BuildWithRetriesApiDiagnosticError(ResourceType, fmt.Sprintf("Error requesting wrap-up code %s | error: %s", name, err), proxyResponse))
@@ -30,7 +72,8 @@ func dataSourceRoutingWrapupcodeRead(ctx context.Context, d *schema.ResourceData
 			return retry.RetryableError(util.BuildWithRetriesApiDiagnosticError(ResourceType, fmt.Sprintf("No wrap-up code found with name %s", name), proxyResponse))
 		}
 
-		d.SetId(wrapupcodeId)
+		wrapupcodeId = wrapupcode
 		return nil
 	})
+	return wrapupcodeId, diag
 }
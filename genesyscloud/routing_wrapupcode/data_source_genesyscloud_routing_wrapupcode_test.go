@@ -5,9 +5,9 @@ import (
 	authDivision "terraform-provider-genesyscloud/genesyscloud/auth_division"
 	"terraform-provider-genesyscloud/genesyscloud/provider"
 	"terraform-provider-genesyscloud/genesyscloud/util"
+	"terraform-provider-genesyscloud/genesyscloud/util/testrunner"
 	"testing"
 
-	"github.com/google/uuid"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
 )
 
@@ -15,13 +15,13 @@ func TestAccDataSourceWrapupcode(t *testing.T) {
 	var (
 		codeResourceLabel = "routing-wrapupcode"
 		codeDataLabel     = "codeData"
-		codeName          = "Terraform Code-" + uuid.NewString()
+		codeName          = "Terraform Code-" + testrunner.NewUUID(t)
 		divResourceLabel  = "test-division"
-		divName           = "terraform-" + uuid.NewString()
+		divName           = "terraform-" + testrunner.NewUUID(t)
 		description       = "Terraform wrapup code description"
 	)
 
-	resource.Test(t, resource.TestCase{
+	testrunner.Test(t, resource.TestCase{
 		PreCheck:          func() { util.TestAccPreCheck(t) },
 		ProviderFactories: provider.GetProviderFactories(providerResources, providerDataSources),
 		Steps: []resource.TestStep{
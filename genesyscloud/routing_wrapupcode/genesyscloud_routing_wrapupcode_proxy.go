@@ -18,9 +18,11 @@ to isolate and test individual functions more easily. For testing purposes, we c
 simulate these smaller parts, known as stubs, to ensure that each function behaves correctly in different scenarios.
 */
 
-// internalProxy holds a proxy instance that can be used throughout the package
+// internalProxy holds a proxy instance that unit tests can set to replace the proxy of the package
 var internalProxy *routingWrapupcodeProxy
 
+var routingWrapupcodesCache = rc.NewResourceCache[platformclientv2.Wrapupcode]() // Create Cache for routing wrapupcode resource
+
 // Type definitions for each func on our proxy so we can easily mock them out later
 type createRoutingWrapupcodeFunc func(ctx context.Context, p *routingWrapupcodeProxy, wrapupcode *platformclientv2.Wrapupcoderequest) (*platformclientv2.Wrapupcode, *platformclientv2.APIResponse, error)
 type getAllRoutingWrapupcodeFunc func(ctx context.Context, p *routingWrapupcodeProxy) (*[]platformclientv2.Wrapupcode, *platformclientv2.APIResponse, error)
@@ -55,8 +57,7 @@ This includes configuring the proxy with the required data and settings so that
 seamlessly with the Genesys Cloud platform.
 */
 func newRoutingWrapupcodeProxy(clientConfig *platformclientv2.Configuration) *routingWrapupcodeProxy {
-	api := platformclientv2.NewRoutingApiWithConfig(clientConfig)                 // NewArchitectApiWithConfig creates an Genesyc Cloud API instance using the provided configuration
-	routingWrapupcodesCache := rc.NewResourceCache[platformclientv2.Wrapupcode]() // Create Cache for routing wrapupcode resource
+	api := platformclientv2.NewRoutingApiWithConfig(clientConfig) // NewArchitectApiWithConfig creates an Genesyc Cloud API instance using the provided configuration
 	return &routingWrapupcodeProxy{
 		clientConfig:                     clientConfig,
 		routingApi:                       api,
@@ -71,17 +72,16 @@ func newRoutingWrapupcodeProxy(clientConfig *platformclientv2.Configuration) *ro
 }
 
 /*
-The function getRoutingWrapupcodeProxy serves a dual purpose: first, it functions as a singleton for
-the internalProxy, meaning it ensures that only one instance of the internalProxy exists. Second,
-it enables us to proxy our tests by allowing us to directly set the internalProxy package variable.
-This ensures consistency and control in managing the internalProxy across our codebase, while also
-facilitating efficient testing by providing a straightforward way to substitute the proxy for testing purposes.
+The function getRoutingWrapupcodeProxy serves a dual purpose: first, it returns a new proxy for the client config of every
+call, so provider configurations for several orgs never share a proxy. Second, it enables us to proxy our tests by
+allowing us to directly set the internalProxy package variable, which facilitates efficient testing by providing a
+straightforward way to substitute the proxy for testing purposes.
 */
 func getRoutingWrapupcodeProxy(clientConfig *platformclientv2.Configuration) *routingWrapupcodeProxy {
-	if internalProxy == nil {
-		internalProxy = newRoutingWrapupcodeProxy(clientConfig)
+	if internalProxy != nil {
+		return internalProxy
 	}
-	return internalProxy
+	return newRoutingWrapupcodeProxy(clientConfig)
 }
 
 // createRoutingWrapupcode creates a Genesys Cloud routing wrapupcodes
@@ -101,7 +101,11 @@ func (p *routingWrapupcodeProxy) getRoutingWrapupcodeIdByName(ctx context.Contex
 
 // getRoutingWrapupcodeById returns a single Genesys Cloud routing wrapupcodes by Id
 func (p *routingWrapupcodeProxy) getRoutingWrapupcodeById(ctx context.Context, id string) (routingWrapupcode *platformclientv2.Wrapupcode, response *platformclientv2.APIResponse, err error) {
-	if wrapupcode := rc.GetCacheItem(p.routingWrapupcodesCache, id); wrapupcode != nil { // Get the wrapupcode from the cache, if not there in the cache then call p.getRoutingWrapupcodeByIdAttr()
+	rc.HydrateCache(ctx, p.routingWrapupcodesCache, func(ctx context.Context) error {
+		_, _, err := p.getAllRoutingWrapupcode(ctx)
+		return err
+	})
+	if wrapupcode := rc.GetCacheItem(ctx, p.routingWrapupcodesCache, id); wrapupcode != nil { // Get the wrapupcode from the cache, if not there in the cache then call p.getRoutingWrapupcodeByIdAttr()
 		return wrapupcode, nil, nil
 	}
 	return p.getRoutingWrapupcodeByIdAttr(ctx, p, id)
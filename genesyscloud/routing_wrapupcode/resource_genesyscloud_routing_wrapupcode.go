@@ -31,6 +31,9 @@ func getAllRoutingWrapupCodes(ctx context.Context, clientConfig *platformclientv
 
 	for _, wrapupcode := range *wrapupcodes {
 		resources[*wrapupcode.Id] = &resourceExporter.ResourceMeta{BlockLabel: *wrapupcode.Name}
+		if wrapupcode.DateModified != nil {
+			resources[*wrapupcode.Id].Version = wrapupcode.DateModified.String()
+		}
 	}
 
 	return resources, nil
@@ -5,9 +5,9 @@ import (
 	authDivision "terraform-provider-genesyscloud/genesyscloud/auth_division"
 	"terraform-provider-genesyscloud/genesyscloud/provider"
 	"terraform-provider-genesyscloud/genesyscloud/util"
+	"terraform-provider-genesyscloud/genesyscloud/util/testrunner"
 	"testing"
 
-	"github.com/google/uuid"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
 	"github.com/mypurecloud/platform-client-sdk-go/v152/platformclientv2"
@@ -16,14 +16,14 @@ import (
 func TestAccResourceRoutingWrapupcode(t *testing.T) {
 	var (
 		codeResourceLabel1 = "routing-wrapupcode1"
-		codeName1          = "Terraform Code-" + uuid.NewString()
-		codeName2          = "Terraform Code-" + uuid.NewString()
+		codeName1          = "Terraform Code-" + testrunner.NewUUID(t)
+		codeName2          = "Terraform Code-" + testrunner.NewUUID(t)
 		divResourceLabel   = "test-division"
-		divName            = "terraform-" + uuid.NewString()
+		divName            = "terraform-" + testrunner.NewUUID(t)
 		description        = "Terraform wrapup code description"
 	)
 
-	resource.Test(t, resource.TestCase{
+	testrunner.Test(t, resource.TestCase{
 		PreCheck:          func() { util.TestAccPreCheck(t) },
 		ProviderFactories: provider.GetProviderFactories(providerResources, providerDataSources),
 		Steps: []resource.TestStep{
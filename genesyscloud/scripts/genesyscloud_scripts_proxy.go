@@ -359,14 +359,14 @@ func scriptWasUploadedSuccessfullyFn(_ context.Context, p *scriptsProxy, uploadI
 }
 
 // getScriptExportUrlFn retrieves the export URL for a targeted script
-func getScriptExportUrlFn(_ context.Context, p *scriptsProxy, scriptId string) (string, *platformclientv2.APIResponse, error) {
+func getScriptExportUrlFn(ctx context.Context, p *scriptsProxy, scriptId string) (string, *platformclientv2.APIResponse, error) {
 	var (
 		body platformclientv2.Exportscriptrequest
 	)
 
 	// Sets the VersionId on the request so that the Published Version of the script is exported and not the editable version
 	// See DEVTOOLING-777
-	scriptCache := rc.GetCacheItem(p.scriptCache, scriptId)
+	scriptCache := rc.GetCacheItem(ctx, p.scriptCache, scriptId)
 	body.VersionId = scriptCache.VersionId
 
 	data, resp, err := p.scriptsApi.PostScriptExport(scriptId, body)
@@ -406,8 +406,8 @@ func deleteScriptFn(_ context.Context, p *scriptsProxy, scriptId string) error {
 }
 
 // getScriptByIdFn retrieves a script by Id
-func getScriptByIdFn(_ context.Context, p *scriptsProxy, scriptId string) (script *platformclientv2.Script, resp *platformclientv2.APIResponse, err error) {
-	if script := rc.GetCacheItem(p.scriptCache, scriptId); script != nil {
+func getScriptByIdFn(ctx context.Context, p *scriptsProxy, scriptId string) (script *platformclientv2.Script, resp *platformclientv2.APIResponse, err error) {
+	if script := rc.GetCacheItem(ctx, p.scriptCache, scriptId); script != nil {
 		return script, nil, nil
 	}
 
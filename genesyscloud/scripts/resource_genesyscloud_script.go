@@ -30,6 +30,9 @@ func getAllScripts(ctx context.Context, clientConfig *platformclientv2.Configura
 
 	for _, script := range *scripts {
 		resources[*script.Id] = &resourceExporter.ResourceMeta{BlockLabel: *script.Name}
+		if script.ModifiedDate != nil {
+			resources[*script.Id].Version = script.ModifiedDate.String()
+		}
 	}
 
 	return resources, nil
@@ -15,9 +15,11 @@ with the Genesys Cloud SDK. We use composition here for each function on the pro
 out during testing.
 */
 
-// internalProxy holds a proxy instance that can be used throughout the package
+// internalProxy holds a proxy instance that unit tests can set to replace the proxy of the package
 var internalProxy *taskManagementWorkbinProxy
 
+var workbinCache = rc.NewResourceCache[platformclientv2.Workbin]()
+
 // Type definitions for each func on our proxy so we can easily mock them out later
 type createTaskManagementWorkbinFunc func(ctx context.Context, p *taskManagementWorkbinProxy, workbin *platformclientv2.Workbincreate) (*platformclientv2.Workbin, *platformclientv2.APIResponse, error)
 type getAllTaskManagementWorkbinFunc func(ctx context.Context, p *taskManagementWorkbinProxy) (*[]platformclientv2.Workbin, *platformclientv2.APIResponse, error)
@@ -42,7 +44,6 @@ type taskManagementWorkbinProxy struct {
 // newTaskManagementWorkbinProxy initializes the task management workbin proxy with all of the data needed to communicate with Genesys Cloud
 func newTaskManagementWorkbinProxy(clientConfig *platformclientv2.Configuration) *taskManagementWorkbinProxy {
 	api := platformclientv2.NewTaskManagementApiWithConfig(clientConfig)
-	workbinCache := rc.NewResourceCache[platformclientv2.Workbin]()
 	return &taskManagementWorkbinProxy{
 		clientConfig:                         clientConfig,
 		taskManagementApi:                    api,
@@ -56,13 +57,14 @@ func newTaskManagementWorkbinProxy(clientConfig *platformclientv2.Configuration)
 	}
 }
 
-// getTaskManagementWorkbinProxy acts as a singleton to for the internalProxy.  It also ensures
-// that we can still proxy our tests by directly setting internalProxy package variable
+// getTaskManagementWorkbinProxy returns a new proxy for the client config of every call, so provider configurations for
+// several orgs never share a proxy. It also ensures that we can still proxy our tests by directly setting
+// internalProxy package variable
 func getTaskManagementWorkbinProxy(clientConfig *platformclientv2.Configuration) *taskManagementWorkbinProxy {
-	if internalProxy == nil {
-		internalProxy = newTaskManagementWorkbinProxy(clientConfig)
+	if internalProxy != nil {
+		return internalProxy
 	}
-	return internalProxy
+	return newTaskManagementWorkbinProxy(clientConfig)
 }
 
 // createTaskManagementWorkbin creates a Genesys Cloud task management workbin
@@ -148,7 +150,7 @@ func getTaskManagementWorkbinIdByNameFn(ctx context.Context, p *taskManagementWo
 
 // getTaskManagementWorkbinByIdFn is an implementation of the function to get a Genesys Cloud task management workbin by Id
 func getTaskManagementWorkbinByIdFn(ctx context.Context, p *taskManagementWorkbinProxy, id string) (taskManagementWorkbin *platformclientv2.Workbin, resp *platformclientv2.APIResponse, err error) {
-	workbin := rc.GetCacheItem(p.workbinCache, id)
+	workbin := rc.GetCacheItem(ctx, p.workbinCache, id)
 	if workbin != nil {
 		return workbin, nil, nil
 	}
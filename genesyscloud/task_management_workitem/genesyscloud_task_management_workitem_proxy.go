@@ -16,9 +16,11 @@ with the Genesys Cloud SDK. We use composition here for each function on the pro
 out during testing.
 */
 
-// internalProxy holds a proxy instance that can be used throughout the package
+// internalProxy holds a proxy instance that unit tests can set to replace the proxy of the package
 var internalProxy *taskManagementWorkitemProxy
 
+var workitemCache = rc.NewResourceCache[platformclientv2.Workitem]()
+
 // Type definitions for each func on our proxy so we can easily mock them out later
 type createTaskManagementWorkitemFunc func(ctx context.Context, p *taskManagementWorkitemProxy, workitem *platformclientv2.Workitemcreate) (*platformclientv2.Workitem, *platformclientv2.APIResponse, error)
 type getAllTaskManagementWorkitemFunc func(ctx context.Context, p *taskManagementWorkitemProxy) (*[]platformclientv2.Workitem, *platformclientv2.APIResponse, error)
@@ -43,7 +45,6 @@ type taskManagementWorkitemProxy struct {
 // newTaskManagementWorkitemProxy initializes the task management workitem proxy with all of the data needed to communicate with Genesys Cloud
 func newTaskManagementWorkitemProxy(clientConfig *platformclientv2.Configuration) *taskManagementWorkitemProxy {
 	api := platformclientv2.NewTaskManagementApiWithConfig(clientConfig)
-	workitemCache := rc.NewResourceCache[platformclientv2.Workitem]()
 	return &taskManagementWorkitemProxy{
 		clientConfig:                          clientConfig,
 		taskManagementApi:                     api,
@@ -57,13 +58,14 @@ func newTaskManagementWorkitemProxy(clientConfig *platformclientv2.Configuration
 	}
 }
 
-// getTaskManagementWorkitemProxy acts as a singleton to for the internalProxy.  It also ensures
-// that we can still proxy our tests by directly setting internalProxy package variable
+// getTaskManagementWorkitemProxy returns a new proxy for the client config of every call, so provider configurations for
+// several orgs never share a proxy. It also ensures that we can still proxy our tests by directly setting
+// internalProxy package variable
 func getTaskManagementWorkitemProxy(clientConfig *platformclientv2.Configuration) *taskManagementWorkitemProxy {
-	if internalProxy == nil {
-		internalProxy = newTaskManagementWorkitemProxy(clientConfig)
+	if internalProxy != nil {
+		return internalProxy
 	}
-	return internalProxy
+	return newTaskManagementWorkitemProxy(clientConfig)
 }
 
 // createTaskManagementWorkitem creates a Genesys Cloud task management workitem
@@ -230,7 +232,7 @@ func getTaskManagementWorkitemIdByNameFn(ctx context.Context, p *taskManagementW
 
 // getTaskManagementWorkitemByIdFn is an implementation of the function to get a Genesys Cloud task management workitem by Id
 func getTaskManagementWorkitemByIdFn(ctx context.Context, p *taskManagementWorkitemProxy, id string) (taskManagementWorkitem *platformclientv2.Workitem, resp *platformclientv2.APIResponse, err error) {
-	workitem := rc.GetCacheItem(p.workitemCache, id)
+	workitem := rc.GetCacheItem(ctx, p.workitemCache, id)
 	if workitem != nil {
 		return workitem, nil, nil
 	}
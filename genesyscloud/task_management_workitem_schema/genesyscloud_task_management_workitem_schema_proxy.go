@@ -19,9 +19,11 @@ with the Genesys Cloud SDK. We use composition here for each function on the pro
 out during testing.
 */
 
-// internalProxy holds a proxy instance that can be used throughout the package
+// internalProxy holds a proxy instance that unit tests can set to replace the proxy of the package
 var internalProxy *taskManagementProxy
 
+var workitemSchemaCache = rc.NewResourceCache[platformclientv2.Dataschema]()
+
 // Type definitions for each func on our proxy so we can easily mock them out later
 type createTaskManagementWorkitemSchemaFunc func(ctx context.Context, p *taskManagementProxy, schema *platformclientv2.Dataschema) (*platformclientv2.Dataschema, *platformclientv2.APIResponse, error)
 type getAllTaskManagementWorkitemSchemaFunc func(ctx context.Context, p *taskManagementProxy) (*[]platformclientv2.Dataschema, *platformclientv2.APIResponse, error)
@@ -48,7 +50,6 @@ type taskManagementProxy struct {
 // newTaskManagementProxy initializes the task management proxy with all of the data needed to communicate with Genesys Cloud
 func newTaskManagementProxy(clientConfig *platformclientv2.Configuration) *taskManagementProxy {
 	api := platformclientv2.NewTaskManagementApiWithConfig(clientConfig)
-	workitemSchemaCache := rc.NewResourceCache[platformclientv2.Dataschema]()
 
 	return &taskManagementProxy{
 		clientConfig:                                     clientConfig,
@@ -64,13 +65,14 @@ func newTaskManagementProxy(clientConfig *platformclientv2.Configuration) *taskM
 	}
 }
 
-// getTaskManagementProxy acts as a singleton to for the internalProxy.  It also ensures
-// that we can still proxy our tests by directly setting internalProxy package variable
+// getTaskManagementProxy returns a new proxy for the client config of every call, so provider configurations for
+// several orgs never share a proxy. It also ensures that we can still proxy our tests by directly setting
+// internalProxy package variable
 func getTaskManagementProxy(clientConfig *platformclientv2.Configuration) *taskManagementProxy {
-	if internalProxy == nil {
-		internalProxy = newTaskManagementProxy(clientConfig)
+	if internalProxy != nil {
+		return internalProxy
 	}
-	return internalProxy
+	return newTaskManagementProxy(clientConfig)
 }
 
 // createTaskManagementWorkitemSchema creates a Genesys Cloud task management workitem schema
@@ -158,7 +160,7 @@ func getTaskManagementWorkitemSchemasByNameFn(ctx context.Context, p *taskManage
 
 // getTaskManagementWorkitemSchemaByIdFn is an implementation of the function to get a Genesys Cloud task management workitem schema by Id
 func getTaskManagementWorkitemSchemaByIdFn(ctx context.Context, p *taskManagementProxy, id string) (schema *platformclientv2.Dataschema, resp *platformclientv2.APIResponse, err error) {
-	workitemSchema := rc.GetCacheItem(p.workitemSchemaCache, id)
+	workitemSchema := rc.GetCacheItem(ctx, p.workitemSchemaCache, id)
 	if workitemSchema != nil {
 		return schema, nil, nil
 	}
@@ -15,9 +15,11 @@ with the Genesys Cloud SDK. We use composition here for each function on the pro
 out during testing.
 */
 
-// internalProxy holds a proxy instance that can be used throughout the package
+// internalProxy holds a proxy instance that unit tests can set to replace the proxy of the package
 var internalProxy *TaskManagementWorktypeProxy
 
+var worktypeCache = rc.NewResourceCache[platformclientv2.Worktype]()
+
 // Type definitions for each func on our proxy so we can easily mock them out later
 type createTaskManagementWorktypeFunc func(ctx context.Context, p *TaskManagementWorktypeProxy, worktype *platformclientv2.Worktypecreate) (*platformclientv2.Worktype, *platformclientv2.APIResponse, error)
 type getAllTaskManagementWorktypeFunc func(ctx context.Context, p *TaskManagementWorktypeProxy) (*[]platformclientv2.Worktype, *platformclientv2.APIResponse, error)
@@ -44,7 +46,6 @@ type TaskManagementWorktypeProxy struct {
 // newTaskManagementWorktypeProxy initializes the task management worktype proxy with all the data needed to communicate with Genesys Cloud
 func newTaskManagementWorktypeProxy(clientConfig *platformclientv2.Configuration) *TaskManagementWorktypeProxy {
 	api := platformclientv2.NewTaskManagementApiWithConfig(clientConfig)
-	worktypeCache := rc.NewResourceCache[platformclientv2.Worktype]()
 	return &TaskManagementWorktypeProxy{
 		clientConfig:                          clientConfig,
 		taskManagementApi:                     api,
@@ -59,13 +60,14 @@ func newTaskManagementWorktypeProxy(clientConfig *platformclientv2.Configuration
 	}
 }
 
-// GetTaskManagementWorktypeProxy acts as a singleton to for the internalProxy.  It also ensures
-// that we can still proxy our tests by directly setting internalProxy package variable
+// GetTaskManagementWorktypeProxy returns a new proxy for the client config of every call, so provider configurations for
+// several orgs never share a proxy. It also ensures that we can still proxy our tests by directly setting
+// internalProxy package variable
 func GetTaskManagementWorktypeProxy(clientConfig *platformclientv2.Configuration) *TaskManagementWorktypeProxy {
-	if internalProxy == nil {
-		internalProxy = newTaskManagementWorktypeProxy(clientConfig)
+	if internalProxy != nil {
+		return internalProxy
 	}
-	return internalProxy
+	return newTaskManagementWorktypeProxy(clientConfig)
 }
 
 // createTaskManagementWorktype creates a Genesys Cloud task management worktype
@@ -199,7 +201,7 @@ func getTaskManagementWorktypeByNameFn(ctx context.Context, p *TaskManagementWor
 
 // getTaskManagementWorktypeByIdFn is an implementation of the function to get a Genesys Cloud task management worktype by Id
 func getTaskManagementWorktypeByIdFn(ctx context.Context, p *TaskManagementWorktypeProxy, id string) (taskManagementWorktype *platformclientv2.Worktype, resp *platformclientv2.APIResponse, err error) {
-	worktype := rc.GetCacheItem(p.worktypeCache, id)
+	worktype := rc.GetCacheItem(ctx, p.worktypeCache, id)
 	if worktype != nil {
 		return worktype, nil, nil
 	}
@@ -15,9 +15,11 @@ with the Genesys Cloud SDK. We use composition here for each function on the pro
 out during testing.
 */
 
-// internalProxy holds a proxy instance that can be used throughout the package
+// internalProxy holds a proxy instance that unit tests can set to replace the proxy of the package
 var internalProxy *taskManagementDateBasedRuleProxy
 
+var dateBasedRuleCache = rc.NewResourceCache[platformclientv2.Workitemdatebasedrule]()
+
 // Type definitions for each func on our proxy so we can easily mock them out later
 type createTaskManagementDateBasedRuleFunc func(ctx context.Context, p *taskManagementDateBasedRuleProxy, worktypeId string, dateBasedRuleCreate *platformclientv2.Workitemdatebasedrulecreate) (*platformclientv2.Workitemdatebasedrule, *platformclientv2.APIResponse, error)
 type getAllTaskManagementDateBasedRuleFunc func(ctx context.Context, p *taskManagementDateBasedRuleProxy, worktypeId string) (*[]platformclientv2.Workitemdatebasedrule, *platformclientv2.APIResponse, error)
@@ -43,7 +45,6 @@ type taskManagementDateBasedRuleProxy struct {
 // newTaskManagementDateBasedRuleProxy initializes the task management worktype proxy with all the data needed to communicate with Genesys Cloud
 func newTaskManagementDateBasedRuleProxy(clientConfig *platformclientv2.Configuration) *taskManagementDateBasedRuleProxy {
 	api := platformclientv2.NewTaskManagementApiWithConfig(clientConfig)
-	dateBasedRuleCache := rc.NewResourceCache[platformclientv2.Workitemdatebasedrule]()
 	taskmanagementProxy := taskManagementWorktype.GetTaskManagementWorktypeProxy(clientConfig)
 	return &taskManagementDateBasedRuleProxy{
 		clientConfig:                               clientConfig,
@@ -59,13 +60,14 @@ func newTaskManagementDateBasedRuleProxy(clientConfig *platformclientv2.Configur
 	}
 }
 
-// getTaskManagementDateBasedRuleProxy acts as a singleton to for the internalProxy.  It also ensures
-// that we can still proxy our tests by directly setting internalProxy package variable
+// getTaskManagementDateBasedRuleProxy returns a new proxy for the client config of every call, so provider configurations for
+// several orgs never share a proxy. It also ensures that we can still proxy our tests by directly setting
+// internalProxy package variable
 func getTaskManagementDateBasedRuleProxy(clientConfig *platformclientv2.Configuration) *taskManagementDateBasedRuleProxy {
-	if internalProxy == nil {
-		internalProxy = newTaskManagementDateBasedRuleProxy(clientConfig)
+	if internalProxy != nil {
+		return internalProxy
 	}
-	return internalProxy
+	return newTaskManagementDateBasedRuleProxy(clientConfig)
 }
 
 // createTaskManagementDateBasedRule creates a Genesys Cloud task management datebased rule
@@ -164,7 +166,7 @@ func getTaskManagementDateBasedRuleIdByNameFn(ctx context.Context, p *taskManage
 
 // getTaskManagementDateBasedRuleByIdFn is an implementation of the function to get a Genesys Cloud task management datebased rule by Id
 func getTaskManagementDateBasedRuleByIdFn(ctx context.Context, p *taskManagementDateBasedRuleProxy, worktypeId string, id string) (taskManagementDateBasedRule *platformclientv2.Workitemdatebasedrule, resp *platformclientv2.APIResponse, err error) {
-	dateBasedRule := rc.GetCacheItem(p.dateBasedRuleCache, id)
+	dateBasedRule := rc.GetCacheItem(ctx, p.dateBasedRuleCache, id)
 	if dateBasedRule != nil {
 		return dateBasedRule, nil, nil
 	}
@@ -15,9 +15,11 @@ with the Genesys Cloud SDK. We use composition here for each function on the pro
 out during testing.
 */
 
-// internalProxy holds a proxy instance that can be used throughout the package
+// internalProxy holds a proxy instance that unit tests can set to replace the proxy of the package
 var internalProxy *taskManagementOnAttributeChangeRuleProxy
 
+var onAttributeChangeRuleCache = rc.NewResourceCache[platformclientv2.Workitemonattributechangerule]()
+
 // Type definitions for each func on our proxy so we can easily mock them out later
 type createTaskManagementOnAttributeChangeRuleFunc func(ctx context.Context, p *taskManagementOnAttributeChangeRuleProxy, worktypeId string, onAttributeChangeRuleCreate *platformclientv2.Workitemonattributechangerulecreate) (*platformclientv2.Workitemonattributechangerule, *platformclientv2.APIResponse, error)
 type getAllTaskManagementOnAttributeChangeRuleFunc func(ctx context.Context, p *taskManagementOnAttributeChangeRuleProxy, worktypeId string) (*[]platformclientv2.Workitemonattributechangerule, *platformclientv2.APIResponse, error)
@@ -43,7 +45,6 @@ type taskManagementOnAttributeChangeRuleProxy struct {
 // newTaskManagementOnAttributeChangeRuleProxy initializes the task management worktype proxy with all the data needed to communicate with Genesys Cloud
 func newTaskManagementOnAttributeChangeRuleProxy(clientConfig *platformclientv2.Configuration) *taskManagementOnAttributeChangeRuleProxy {
 	api := platformclientv2.NewTaskManagementApiWithConfig(clientConfig)
-	onAttributeChangeRuleCache := rc.NewResourceCache[platformclientv2.Workitemonattributechangerule]()
 	taskmanagementProxy := taskManagementWorktype.GetTaskManagementWorktypeProxy(clientConfig)
 	return &taskManagementOnAttributeChangeRuleProxy{
 		clientConfig:      clientConfig,
@@ -59,13 +60,14 @@ func newTaskManagementOnAttributeChangeRuleProxy(clientConfig *platformclientv2.
 	}
 }
 
-// GetTaskManagementOnAttributeChangeRuleProxy acts as a singleton to for the internalProxy.  It also ensures
-// that we can still proxy our tests by directly setting internalProxy package variable
+// GetTaskManagementOnAttributeChangeRuleProxy returns a new proxy for the client config of every call, so provider configurations for
+// several orgs never share a proxy. It also ensures that we can still proxy our tests by directly setting
+// internalProxy package variable
 func getTaskManagementOnAttributeChangeRuleProxy(clientConfig *platformclientv2.Configuration) *taskManagementOnAttributeChangeRuleProxy {
-	if internalProxy == nil {
-		internalProxy = newTaskManagementOnAttributeChangeRuleProxy(clientConfig)
+	if internalProxy != nil {
+		return internalProxy
 	}
-	return internalProxy
+	return newTaskManagementOnAttributeChangeRuleProxy(clientConfig)
 }
 
 // createTaskManagementOnAttributeChangeRule creates a Genesys Cloud task management onattributechange rule
@@ -164,7 +166,7 @@ func getTaskManagementOnAttributeChangeRuleIdByNameFn(ctx context.Context, p *ta
 
 // getTaskManagementOnAttributeChangeRuleByIdFn is an implementation of the function to get a Genesys Cloud task management onattributechange rule by Id
 func getTaskManagementOnAttributeChangeRuleByIdFn(ctx context.Context, p *taskManagementOnAttributeChangeRuleProxy, worktypeId string, id string) (taskManagementOnAttributeChangeRule *platformclientv2.Workitemonattributechangerule, resp *platformclientv2.APIResponse, err error) {
-	onAttributeChangeRule := rc.GetCacheItem(p.onAttributeChangeRuleCache, id)
+	onAttributeChangeRule := rc.GetCacheItem(ctx, p.onAttributeChangeRuleCache, id)
 	if onAttributeChangeRule != nil {
 		return onAttributeChangeRule, nil, nil
 	}
@@ -15,9 +15,11 @@ with the Genesys Cloud SDK. We use composition here for each function on the pro
 out during testing.
 */
 
-// internalProxy holds a proxy instance that can be used throughout the package
+// internalProxy holds a proxy instance that unit tests can set to replace the proxy of the package
 var internalProxy *taskManagementOnCreateRuleProxy
 
+var onCreateRuleCache = rc.NewResourceCache[platformclientv2.Workitemoncreaterule]()
+
 // Type definitions for each func on our proxy so we can easily mock them out later
 type createTaskManagementOnCreateRuleFunc func(ctx context.Context, p *taskManagementOnCreateRuleProxy, worktypeId string, onCreateRuleCreate *platformclientv2.Workitemoncreaterulecreate) (*platformclientv2.Workitemoncreaterule, *platformclientv2.APIResponse, error)
 type getAllTaskManagementOnCreateRuleFunc func(ctx context.Context, p *taskManagementOnCreateRuleProxy, worktypeId string) (*[]platformclientv2.Workitemoncreaterule, *platformclientv2.APIResponse, error)
@@ -43,7 +45,6 @@ type taskManagementOnCreateRuleProxy struct {
 // newTaskManagementOnCreateRuleProxy initializes the task management worktype proxy with all the data needed to communicate with Genesys Cloud
 func newTaskManagementOnCreateRuleProxy(clientConfig *platformclientv2.Configuration) *taskManagementOnCreateRuleProxy {
 	api := platformclientv2.NewTaskManagementApiWithConfig(clientConfig)
-	onCreateRuleCache := rc.NewResourceCache[platformclientv2.Workitemoncreaterule]()
 	taskmanagementProxy := taskManagementWorktype.GetTaskManagementWorktypeProxy(clientConfig)
 	return &taskManagementOnCreateRuleProxy{
 		clientConfig:                              clientConfig,
@@ -59,13 +60,14 @@ func newTaskManagementOnCreateRuleProxy(clientConfig *platformclientv2.Configura
 	}
 }
 
-// GetTaskManagementOnCreateRuleProxy acts as a singleton to for the internalProxy.  It also ensures
-// that we can still proxy our tests by directly setting internalProxy package variable
+// GetTaskManagementOnCreateRuleProxy returns a new proxy for the client config of every call, so provider configurations for
+// several orgs never share a proxy. It also ensures that we can still proxy our tests by directly setting
+// internalProxy package variable
 func getTaskManagementOnCreateRuleProxy(clientConfig *platformclientv2.Configuration) *taskManagementOnCreateRuleProxy {
-	if internalProxy == nil {
-		internalProxy = newTaskManagementOnCreateRuleProxy(clientConfig)
+	if internalProxy != nil {
+		return internalProxy
 	}
-	return internalProxy
+	return newTaskManagementOnCreateRuleProxy(clientConfig)
 }
 
 // createTaskManagementOnCreateRule creates a Genesys Cloud task management oncreate rule
@@ -164,7 +166,7 @@ func getTaskManagementOnCreateRuleIdByNameFn(ctx context.Context, p *taskManagem
 
 // getTaskManagementOnCreateRuleByIdFn is an implementation of the function to get a Genesys Cloud task management oncreate rule by Id
 func getTaskManagementOnCreateRuleByIdFn(ctx context.Context, p *taskManagementOnCreateRuleProxy, worktypeId string, id string) (taskManagementOnCreateRule *platformclientv2.Workitemoncreaterule, resp *platformclientv2.APIResponse, err error) {
-	onCreateRule := rc.GetCacheItem(p.onCreateRuleCache, id)
+	onCreateRule := rc.GetCacheItem(ctx, p.onCreateRuleCache, id)
 	if onCreateRule != nil {
 		return onCreateRule, nil, nil
 	}
@@ -15,7 +15,7 @@ with the Genesys Cloud SDK. We use composition here for each function on the pro
 out during testing.
 */
 
-// internalProxy holds a proxy instance that can be used throughout the package
+// internalProxy holds a proxy instance that unit tests can set to replace the proxy of the package
 var internalProxy *teamProxy
 
 // Type definitions for each func on our proxy so we can easily mock them out later
@@ -62,13 +62,14 @@ func newTeamProxy(clientConfig *platformclientv2.Configuration) *teamProxy {
 	}
 }
 
-// getTeamProxy acts as a singleton to for the internalProxy.  It also ensures
-// that we can still proxy our tests by directly setting internalProxy package variable
+// getTeamProxy returns a new proxy for the client config of every call, so provider configurations for
+// several orgs never share a proxy. It also ensures that we can still proxy our tests by directly setting
+// internalProxy package variable
 func getTeamProxy(clientConfig *platformclientv2.Configuration) *teamProxy {
-	if internalProxy == nil {
-		internalProxy = newTeamProxy(clientConfig)
+	if internalProxy != nil {
+		return internalProxy
 	}
-	return internalProxy
+	return newTeamProxy(clientConfig)
 }
 
 // createTeam creates a Genesys Cloud team
@@ -3,10 +3,12 @@ package team
 // build
 import (
 	"context"
+	"encoding/json"
 	"fmt"
 	"terraform-provider-genesyscloud/genesyscloud/provider"
 
 	"net/http"
+	"net/http/httptest"
 	"testing"
 
 	"github.com/google/uuid"
@@ -256,6 +258,58 @@ func TestUnitResourceTeamGetAll(t *testing.T) {
 
 }
 
+// TestUnitResourceTeamReadSeveralOrgs reads a team through provider configurations for two orgs. Every read must query
+// the org of its own provider configuration, not the org that was read first.
+func TestUnitResourceTeamReadSeveralOrgs(t *testing.T) {
+	tId := uuid.NewString()
+	tDivisionId := uuid.NewString()
+
+	// Both orgs answer for the same team ID with a team of their own
+	newOrg := func(teamName string) *httptest.Server {
+		return httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			w.Header().Set("Content-Type", "application/json")
+			switch r.URL.Path {
+			case "/api/v2/teams/" + tId:
+				_ = json.NewEncoder(w).Encode(platformclientv2.Team{
+					Id:       &tId,
+					Name:     &teamName,
+					Division: &platformclientv2.Writabledivision{Id: &tDivisionId},
+				})
+			case "/api/v2/teams/" + tId + "/members":
+				_ = json.NewEncoder(w).Encode(platformclientv2.Teammemberentitylisting{Entities: &[]platformclientv2.Userreferencewithname{}})
+			default:
+				w.WriteHeader(http.StatusNotFound)
+				_, _ = w.Write([]byte(`{"status":404,"code":"not.found","message":"not found"}`))
+			}
+		}))
+	}
+	orgA := newOrg("Org A team")
+	defer orgA.Close()
+	orgB := newOrg("Org B team")
+	defer orgB.Close()
+
+	newMeta := func(basePath string) *provider.ProviderMeta {
+		config := platformclientv2.NewConfiguration()
+		config.BasePath = basePath
+		config.AccessToken = uuid.NewString()
+		return &provider.ProviderMeta{ClientConfig: config}
+	}
+	metaA := newMeta(orgA.URL)
+	metaB := newMeta(orgB.URL)
+
+	for _, org := range []struct {
+		meta     *provider.ProviderMeta
+		teamName string
+	}{{metaA, "Org A team"}, {metaB, "Org B team"}, {metaA, "Org A team"}} {
+		d := schema.TestResourceDataRaw(t, ResourceTeam().Schema, buildTeamResourceMap(tId, org.teamName, "", tDivisionId))
+		d.SetId(tId)
+
+		diag := readTeam(context.Background(), d, org.meta)
+		assert.Equal(t, false, diag.HasError(), fmt.Sprintf("%v", diag))
+		assert.Equal(t, org.teamName, d.Get("name").(string))
+	}
+}
+
 func buildTeamResourceMap(tId string, tName string, tDescription string, tDivisionId string) map[string]interface{} {
 	resourceDataMap := map[string]interface{}{
 		"id":          tId,
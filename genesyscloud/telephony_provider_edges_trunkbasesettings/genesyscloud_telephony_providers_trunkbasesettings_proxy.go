@@ -48,15 +48,14 @@ func newTrunkBaseSettingProxy(clientConfig *platformclientv2.Configuration) *tru
 	}
 }
 
-//	getTrunkBaseSettingProxy acts as a singleton to for the internalProxy.  It also ensures
-//
-// that we can still proxy our tests by directly setting internalProxy package variable
+// getTrunkBaseSettingProxy returns a new proxy for the client config of every call, so provider configurations for
+// several orgs never share a proxy. It also ensures that we can still proxy our tests by directly setting
+// internalProxy package variable
 func getTrunkBaseSettingProxy(clientConfig *platformclientv2.Configuration) *trunkbaseSettingProxy {
-	if internalProxy == nil {
-		internalProxy = newTrunkBaseSettingProxy(clientConfig)
+	if internalProxy != nil {
+		return internalProxy
 	}
-
-	return internalProxy
+	return newTrunkBaseSettingProxy(clientConfig)
 }
 
 func (p *trunkbaseSettingProxy) GetTrunkBaseSettingById(ctx context.Context, trunkBaseSettingId string) (*platformclientv2.Trunkbase, *platformclientv2.APIResponse, error) {
@@ -84,7 +83,7 @@ func (p *trunkbaseSettingProxy) DeleteTrunkBaseSetting(ctx context.Context, trun
 }
 
 func getTrunkBaseSettingByIdFn(ctx context.Context, p *trunkbaseSettingProxy, trunkBaseSettingId string) (*platformclientv2.Trunkbase, *platformclientv2.APIResponse, error) {
-	tb := rc.GetCacheItem(p.trunkBaseCache, trunkBaseSettingId)
+	tb := rc.GetCacheItem(ctx, p.trunkBaseCache, trunkBaseSettingId)
 	if tb != nil {
 		return tb, nil, nil
 	}
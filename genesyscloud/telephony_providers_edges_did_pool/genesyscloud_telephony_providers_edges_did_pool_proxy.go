@@ -29,7 +29,7 @@ Each proxy implementation:
 
 */
 
-// internalProxy holds a proxy instance that can be used throughout the package
+// internalProxy holds a proxy instance that unit tests can set to replace the proxy of the package
 var internalProxy *telephonyDidPoolProxy
 
 // Type definitions for each func on our proxy, so we can easily mock them out later
@@ -67,13 +67,14 @@ func newTelephonyProvidersEdgesDidPoolProxy(clientConfig *platformclientv2.Confi
 	}
 }
 
-// getTelephonyDidPoolProxy acts as a singleton for the internalProxy. It also ensures
-// that we can still proxy our tests by directly setting internalProxy package variable
+// getTelephonyDidPoolProxy returns a new proxy for the client config of every call, so provider configurations for
+// several orgs never share a proxy. It also ensures that we can still proxy our tests by directly setting
+// internalProxy package variable
 func getTelephonyDidPoolProxy(clientConfig *platformclientv2.Configuration) *telephonyDidPoolProxy {
-	if internalProxy == nil {
-		internalProxy = newTelephonyProvidersEdgesDidPoolProxy(clientConfig)
+	if internalProxy != nil {
+		return internalProxy
 	}
-	return internalProxy
+	return newTelephonyProvidersEdgesDidPoolProxy(clientConfig)
 }
 
 // createTelephonyDidPool creates a Genesys Cloud did pool
@@ -32,9 +32,11 @@ Each proxy implementation:
 
 */
 
-// internalProxy holds a proxy instance that can be used throughout the package
+// internalProxy holds a proxy instance that unit tests can set to replace the proxy of the package
 var internalProxy *phoneProxy
 
+var phoneCache = rc.NewResourceCache[platformclientv2.Phone]()
+
 // Type definitions for each func on our proxy so we can easily mock them out later
 type getAllPhonesFunc func(ctx context.Context, p *phoneProxy) (*[]platformclientv2.Phone, *platformclientv2.APIResponse, error)
 type createPhoneFunc func(ctx context.Context, p *phoneProxy, phoneConfig *platformclientv2.Phone) (*platformclientv2.Phone, *platformclientv2.APIResponse, error)
@@ -76,7 +78,6 @@ func newPhoneProxy(clientConfig *platformclientv2.Configuration) *phoneProxy {
 	edgesApi := platformclientv2.NewTelephonyProvidersEdgeApiWithConfig(clientConfig)
 	stationsApi := platformclientv2.NewStationsApiWithConfig(clientConfig)
 	usersApi := platformclientv2.NewUsersApiWithConfig(clientConfig)
-	phoneCache := rc.NewResourceCache[platformclientv2.Phone]()
 
 	return &phoneProxy{
 		clientConfig: clientConfig,
@@ -100,13 +101,14 @@ func newPhoneProxy(clientConfig *platformclientv2.Configuration) *phoneProxy {
 	}
 }
 
-// getPhoneProxy acts as a singleton to for the internalProxy.  It also ensures
-// that we can still proxy our tests by directly setting internalProxy package variable
+// getPhoneProxy returns a new proxy for the client config of every call, so provider configurations for
+// several orgs never share a proxy. It also ensures that we can still proxy our tests by directly setting
+// internalProxy package variable
 func getPhoneProxy(clientConfig *platformclientv2.Configuration) *phoneProxy {
-	if internalProxy == nil {
-		internalProxy = newPhoneProxy(clientConfig)
+	if internalProxy != nil {
+		return internalProxy
 	}
-	return internalProxy
+	return newPhoneProxy(clientConfig)
 }
 
 // getAllPhones retrieves all Genesys Cloud Phones
@@ -121,7 +123,7 @@ func (p *phoneProxy) createPhone(ctx context.Context, phoneConfig *platformclien
 
 // getPhoneById retrieves a Genesys Cloud Phone by id
 func (p *phoneProxy) getPhoneById(ctx context.Context, phoneId string) (*platformclientv2.Phone, *platformclientv2.APIResponse, error) {
-	if phone := rc.GetCacheItem(p.phoneCache, phoneId); phone != nil {
+	if phone := rc.GetCacheItem(ctx, p.phoneCache, phoneId); phone != nil {
 		return phone, nil, nil
 	}
 	return p.getPhoneByIdAttr(ctx, p, phoneId)
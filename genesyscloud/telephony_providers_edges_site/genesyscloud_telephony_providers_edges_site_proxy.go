@@ -29,9 +29,14 @@ Each proxy implementation:
 
 */
 
-// internalProxy holds a proxy instance that can be used throughout the package
+// internalProxy holds a proxy instance that unit tests can set to replace the proxy of the package
 var internalProxy *SiteProxy
 
+var (
+	unmanagedSiteCache = rc.NewResourceCache[platformclientv2.Site]()
+	managedSiteCache   = rc.NewResourceCache[platformclientv2.Site]()
+)
+
 // Type definitions for each func on our proxy so we can easily mock them out later
 type getAllSitesFunc func(ctx context.Context, p *SiteProxy, managed bool) (*[]platformclientv2.Site, *platformclientv2.APIResponse, error)
 type createSiteFunc func(ctx context.Context, p *SiteProxy, site *platformclientv2.Site) (*platformclientv2.Site, *platformclientv2.APIResponse, error)
@@ -92,9 +97,6 @@ func newSiteProxy(clientConfig *platformclientv2.Configuration) *SiteProxy {
 	telephonyApi := platformclientv2.NewTelephonyApiWithConfig(clientConfig)
 	organizationApi := platformclientv2.NewOrganizationApiWithConfig(clientConfig)
 
-	unmanagedSiteCache := rc.NewResourceCache[platformclientv2.Site]()
-	managedSiteCache := rc.NewResourceCache[platformclientv2.Site]()
-
 	return &SiteProxy{
 		clientConfig:    clientConfig,
 		edgesApi:        edgesApi,
@@ -127,13 +129,14 @@ func newSiteProxy(clientConfig *platformclientv2.Configuration) *SiteProxy {
 	}
 }
 
-// GetSiteProxy acts as a singleton for the internalProxy.  It also ensures
-// that we can still proxy our tests by directly setting internalProxy package variable
+// GetSiteProxy returns a new proxy for the client config of every call, so provider configurations for
+// several orgs never share a proxy. It also ensures that we can still proxy our tests by directly setting
+// internalProxy package variable
 func GetSiteProxy(clientConfig *platformclientv2.Configuration) *SiteProxy {
-	if internalProxy == nil {
-		internalProxy = newSiteProxy(clientConfig)
+	if internalProxy != nil {
+		return internalProxy
 	}
-	return internalProxy
+	return newSiteProxy(clientConfig)
 }
 
 // GetAllSites retrieves all managed Genesys Cloud Sites
@@ -301,12 +304,12 @@ func getSiteByIdFn(ctx context.Context, p *SiteProxy, siteId string) (*platformc
 	var site *platformclientv2.Site
 
 	// Query managed site cache for the site
-	site = rc.GetCacheItem(p.managedSiteCache, siteId)
+	site = rc.GetCacheItem(ctx, p.managedSiteCache, siteId)
 	if site != nil {
 		return site, nil, nil
 	} else {
 		// Query unmanaged sites cache if not in managed site cache
-		site = rc.GetCacheItem(p.unmanagedSiteCache, siteId)
+		site = rc.GetCacheItem(ctx, p.unmanagedSiteCache, siteId)
 		if site != nil {
 			return site, nil, nil
 		}
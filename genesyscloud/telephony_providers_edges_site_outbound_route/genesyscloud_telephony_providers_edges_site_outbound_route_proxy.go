@@ -15,9 +15,11 @@ with the Genesys Cloud SDK. We use composition here for each function on the pro
 out during testing.
 */
 
-// internalProxy holds a proxy instance that can be used throughout the package
+// internalProxy holds a proxy instance that unit tests can set to replace the proxy of the package
 var internalProxy *siteOutboundRouteProxy
 
+var siteOutboundRouteCache = rc.NewResourceCache[platformclientv2.Outboundroutebase]()
+
 // Type definitions for each func on our proxy so we can easily mock them out later
 type getAllSiteOutboundRoutesFunc func(ctx context.Context, p *siteOutboundRouteProxy, siteId string) (*[]platformclientv2.Outboundroutebase, *platformclientv2.APIResponse, error)
 type getSiteByIdFunc func(ctx context.Context, p *siteOutboundRouteProxy, siteId string) (*platformclientv2.Site, *platformclientv2.APIResponse, error)
@@ -47,7 +49,6 @@ type siteOutboundRouteProxy struct {
 func newSiteOutboundRouteProxy(clientConfig *platformclientv2.Configuration) *siteOutboundRouteProxy {
 	edgesApi := platformclientv2.NewTelephonyProvidersEdgeApiWithConfig(clientConfig)
 	siteProxy := telephonyProvidersEdgesSite.GetSiteProxy(clientConfig)
-	siteOutboundRouteCache := rc.NewResourceCache[platformclientv2.Outboundroutebase]()
 
 	return &siteOutboundRouteProxy{
 		clientConfig: clientConfig,
@@ -65,13 +66,14 @@ func newSiteOutboundRouteProxy(clientConfig *platformclientv2.Configuration) *si
 	}
 }
 
-// getSiteOutboundRouteProxy acts as a singleton for the internalProxy.  It also ensures
-// that we can still proxy our tests by directly setting internalProxy package variable
+// getSiteOutboundRouteProxy returns a new proxy for the client config of every call, so provider configurations for
+// several orgs never share a proxy. It also ensures that we can still proxy our tests by directly setting
+// internalProxy package variable
 func getSiteOutboundRouteProxy(clientConfig *platformclientv2.Configuration) *siteOutboundRouteProxy {
-	if internalProxy == nil {
-		internalProxy = newSiteOutboundRouteProxy(clientConfig)
+	if internalProxy != nil {
+		return internalProxy
 	}
-	return internalProxy
+	return newSiteOutboundRouteProxy(clientConfig)
 }
 
 func (p *siteOutboundRouteProxy) getAllSiteOutboundRoutes(ctx context.Context, siteId string) (*[]platformclientv2.Outboundroutebase, *platformclientv2.APIResponse, error) {
@@ -150,7 +152,7 @@ func getAllSiteOutboundRoutesFn(ctx context.Context, p *siteOutboundRouteProxy,
 // getSiteOutboundRouteByIdFn is an implementation function for getting an outbound route for a Genesys Cloud Site
 func getSiteOutboundRouteByIdFn(ctx context.Context, p *siteOutboundRouteProxy, siteId string, outboundRouteId string) (*platformclientv2.Outboundroutebase, *platformclientv2.APIResponse, error) {
 	// Check if site's outbound route exist in cache
-	route := rc.GetCacheItem(p.siteOutboundRouteCache, buildSiteAndOutboundRouteId(siteId, outboundRouteId))
+	route := rc.GetCacheItem(ctx, p.siteOutboundRouteCache, buildSiteAndOutboundRouteId(siteId, outboundRouteId))
 	if route != nil {
 		return route, nil, nil
 	}
@@ -511,7 +511,7 @@ func TestAccResourceSiteoutboundRoutesWhenSiteResourceIsDeleted(t *testing.T) {
 						return
 					}
 					ctx := context.Background()
-					proxy := telephonyProvidersEdgesSite.GetSiteProxy(internalProxy.clientConfig)
+					proxy := telephonyProvidersEdgesSite.GetSiteProxy(sdkConfig)
 					_, err := proxy.DeleteSite(ctx, siteId)
 					if err != nil {
 						log.Printf("Unable to delete site with ID %s, %v", siteId, err)
@@ -8,7 +8,7 @@ import (
 
 //generate a proxy for telephony_providers_edges_trunk
 
-// internalProxy holds a proxy instance that can be used throughout the package
+// internalProxy holds a proxy instance that unit tests can set to replace the proxy of the package
 var internalProxy *trunkProxy
 
 // Type definitions for each func on our proxy so we can easily mock them out later
@@ -51,14 +51,14 @@ func newTrunkProxy(clientConfig *platformclientv2.Configuration) *trunkProxy {
 	}
 }
 
-// getTeamProxy acts as a singleton to for the internalProxy.  It also ensures
-// that we can still proxy our tests by directly setting internalProxy package variable
+// getTeamProxy returns a new proxy for the client config of every call, so provider configurations for
+// several orgs never share a proxy. It also ensures that we can still proxy our tests by directly setting
+// internalProxy package variable
 func getTrunkProxy(clientConfig *platformclientv2.Configuration) *trunkProxy {
-	if internalProxy == nil {
-		internalProxy = newTrunkProxy(clientConfig)
+	if internalProxy != nil {
+		return internalProxy
 	}
-
-	return internalProxy
+	return newTrunkProxy(clientConfig)
 }
 
 func (p *trunkProxy) getEdge(ctx context.Context, edgeId string) (*platformclientv2.Edge, *platformclientv2.APIResponse, error) {
@@ -0,0 +1,277 @@
+package tfexporter
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"log"
+	"path/filepath"
+	"sort"
+	"strings"
+	"sync"
+	"terraform-provider-genesyscloud/genesyscloud/util/files"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+)
+
+/*
+This file contains all of the functions used to build a drift report. A drift report compares every managed resource in an
+existing Terraform state file with its current configuration in Genesys Cloud and lists the attributes that were added,
+removed or changed outside of Terraform.
+*/
+
+const (
+	driftReportJSONFile     = "drift_report.json"
+	driftReportMarkdownFile = "drift_report.md"
+
+	driftStatusChanged = "changed"
+	driftStatusDeleted = "deleted"
+	driftStatusError   = "error"
+
+	sensitiveValueMask = "(sensitive value)"
+)
+
+type DriftReport struct {
+	StateFile   string          `json:"state_file"`
+	GeneratedAt string          `json:"generated_at"`
+	Summary     DriftSummary    `json:"summary"`
+	Resources   []ResourceDrift `json:"resources"`
+}
+
+type DriftSummary struct {
+	ResourcesChecked int `json:"resources_checked"`
+	ResourcesInSync  int `json:"resources_in_sync"`
+	ResourcesChanged int `json:"resources_changed"`
+	ResourcesDeleted int `json:"resources_deleted"`
+	ResourcesFailed  int `json:"resources_failed"`
+}
+
+type ResourceDrift struct {
+	Type    string           `json:"type"`
+	Label   string           `json:"label"`
+	Id      string           `json:"id"`
+	Status  string           `json:"status"`
+	Error   string           `json:"error,omitempty"`
+	Added   []AttributeDrift `json:"added,omitempty"`
+	Removed []AttributeDrift `json:"removed,omitempty"`
+	Changed []AttributeDrift `json:"changed,omitempty"`
+}
+
+type AttributeDrift struct {
+	Attribute string `json:"attribute"`
+	Expected  string `json:"expected,omitempty"`
+	Actual    string `json:"actual,omitempty"`
+}
+
+// generateDriftReport reads every managed resource of the state file from Genesys Cloud and writes the differences to a JSON
+// and a Markdown report in the export directory. No configuration or state files are exported in this mode.
+func (g *GenesysCloudResourceExporter) generateDriftReport(stateFilePath string) diag.Diagnostics {
+	log.Printf("Generating drift report for state file %s", stateFilePath)
+	reader := NewTfStateFileReader(stateFilePath)
+	if diagErr := reader.readTfStateFile(g.provider); diagErr != nil {
+		return diagErr
+	}
+
+	instances := reader.getInstances()
+	drifts := make([]*ResourceDrift, len(instances))
+
+	var wg sync.WaitGroup
+	for i, instance := range instances {
+		res := g.provider.ResourcesMap[instance.resourceType]
+		if res == nil {
+			log.Printf("Resource type %s is not defined. Skipping drift check of %s.", instance.resourceType, instance.resourceLabel)
+			continue
+		}
+		wg.Add(1)
+		go func(i int, instance *tfStateInstance, res *schema.Resource) {
+			defer wg.Done()
+			drifts[i] = g.getResourceDrift(res, instance)
+		}(i, instance, res)
+	}
+	wg.Wait()
+
+	report := buildDriftReport(stateFilePath, drifts)
+	log.Printf("Drift report: %d resources checked, %d changed, %d deleted, %d failed", report.Summary.ResourcesChecked,
+		report.Summary.ResourcesChanged, report.Summary.ResourcesDeleted, report.Summary.ResourcesFailed)
+
+	return report.writeFiles(g.exportDirPath)
+}
+
+// getResourceDrift refreshes the state of a single resource and compares it with the state from the state file
+func (g *GenesysCloudResourceExporter) getResourceDrift(res *schema.Resource, instance *tfStateInstance) *ResourceDrift {
+	ctx, cancel := context.WithTimeout(context.Background(), time.Duration(30)*time.Minute)
+	defer cancel()
+
+	drift := &ResourceDrift{
+		Type:  instance.resourceType,
+		Label: instance.resourceLabel,
+		Id:    instance.state.ID,
+	}
+
+	releaseRead, waitErr := g.throttle.acquireRead(ctx)
+	if waitErr != nil {
+		drift.Status = driftStatusError
+		drift.Error = fmt.Sprintf("%v", waitErr)
+		return drift
+	}
+	currentState, err := res.RefreshWithoutUpgrade(ctx, instance.state.DeepCopy(), g.meta)
+	releaseRead()
+	if err != nil {
+		if strings.Contains(fmt.Sprintf("%v", err), "API Error: 404") ||
+			strings.Contains(fmt.Sprintf("%v", err), "API Error: 410") {
+			drift.Status = driftStatusDeleted
+			return drift
+		}
+		log.Printf("Failed to read %s.%s for the drift report: %v", instance.resourceType, instance.resourceLabel, err)
+		drift.Status = driftStatusError
+		drift.Error = fmt.Sprintf("%v", err)
+		return drift
+	}
+	if currentState == nil || currentState.ID == "" {
+		drift.Status = driftStatusDeleted
+		return drift
+	}
+
+	drift.Added, drift.Removed, drift.Changed = diffInstanceStates(res, instance.state, currentState)
+	if len(drift.Added) > 0 || len(drift.Removed) > 0 || len(drift.Changed) > 0 {
+		drift.Status = driftStatusChanged
+	}
+	return drift
+}
+
+// diffInstanceStates compares the flattened attributes of two states of a resource. Read-only attributes and the element
+// counts of lists, sets and maps are ignored, and the values of sensitive attributes are masked.
+func diffInstanceStates(res *schema.Resource, expected, actual *terraform.InstanceState) (added, removed, changed []AttributeDrift) {
+	schemaMap := res.SchemaMap()
+	isIgnored := func(key string) bool {
+		if key == "id" || strings.HasSuffix(key, ".%") || strings.HasSuffix(key, ".#") {
+			return true
+		}
+		attrSchema, ok := schemaMap[strings.Split(key, ".")[0]]
+		return ok && attrSchema.Computed && !attrSchema.Optional
+	}
+	maskValue := func(key, value string) string {
+		if attrSchema, ok := schemaMap[strings.Split(key, ".")[0]]; ok && attrSchema.Sensitive {
+			return sensitiveValueMask
+		}
+		return value
+	}
+
+	for key, expectedValue := range expected.Attributes {
+		if isIgnored(key) {
+			continue
+		}
+		actualValue, ok := actual.Attributes[key]
+		if !ok {
+			removed = append(removed, AttributeDrift{Attribute: key, Expected: maskValue(key, expectedValue)})
+		} else if actualValue != expectedValue {
+			changed = append(changed, AttributeDrift{Attribute: key, Expected: maskValue(key, expectedValue), Actual: maskValue(key, actualValue)})
+		}
+	}
+	for key, actualValue := range actual.Attributes {
+		if isIgnored(key) {
+			continue
+		}
+		if _, ok := expected.Attributes[key]; !ok {
+			added = append(added, AttributeDrift{Attribute: key, Actual: maskValue(key, actualValue)})
+		}
+	}
+
+	sortAttributeDrifts(added)
+	sortAttributeDrifts(removed)
+	sortAttributeDrifts(changed)
+	return added, removed, changed
+}
+
+func sortAttributeDrifts(drifts []AttributeDrift) {
+	sort.Slice(drifts, func(i, j int) bool {
+		return drifts[i].Attribute < drifts[j].Attribute
+	})
+}
+
+func buildDriftReport(stateFilePath string, drifts []*ResourceDrift) *DriftReport {
+	report := &DriftReport{
+		StateFile:   stateFilePath,
+		GeneratedAt: time.Now().UTC().Format(time.RFC3339),
+		Resources:   make([]ResourceDrift, 0),
+	}
+	for _, drift := range drifts {
+		if drift == nil {
+			continue
+		}
+		report.Summary.ResourcesChecked++
+		switch drift.Status {
+		case driftStatusChanged:
+			report.Summary.ResourcesChanged++
+		case driftStatusDeleted:
+			report.Summary.ResourcesDeleted++
+		case driftStatusError:
+			report.Summary.ResourcesFailed++
+		default:
+			report.Summary.ResourcesInSync++
+			continue
+		}
+		report.Resources = append(report.Resources, *drift)
+	}
+	return report
+}
+
+func (r *DriftReport) writeFiles(directory string) diag.Diagnostics {
+	jsonBytes, err := json.MarshalIndent(r, "", "  ")
+	if err != nil {
+		return diag.Errorf("Failed to marshal the drift report: %v", err)
+	}
+	if diagErr := files.WriteToFile(jsonBytes, filepath.Join(directory, driftReportJSONFile)); diagErr != nil {
+		return diagErr
+	}
+	return files.WriteToFile([]byte(r.toMarkdown()), filepath.Join(directory, driftReportMarkdownFile))
+}
+
+func (r *DriftReport) toMarkdown() string {
+	var sb strings.Builder
+	sb.WriteString("# Genesys Cloud Drift Report\n\n")
+	sb.WriteString(fmt.Sprintf("State file: `%s`\n\nGenerated at: %s\n\n", r.StateFile, r.GeneratedAt))
+	sb.WriteString("| Checked | In Sync | Changed | Deleted | Failed |\n")
+	sb.WriteString("|---|---|---|---|---|\n")
+	sb.WriteString(fmt.Sprintf("| %d | %d | %d | %d | %d |\n", r.Summary.ResourcesChecked, r.Summary.ResourcesInSync,
+		r.Summary.ResourcesChanged, r.Summary.ResourcesDeleted, r.Summary.ResourcesFailed))
+
+	if len(r.Resources) == 0 {
+		sb.WriteString("\nNo drift detected.\n")
+		return sb.String()
+	}
+
+	for _, resource := range r.Resources {
+		sb.WriteString(fmt.Sprintf("\n## %s.%s (%s)\n\n", resource.Type, resource.Label, resource.Status))
+		sb.WriteString(fmt.Sprintf("ID: `%s`\n", resource.Id))
+		switch resource.Status {
+		case driftStatusDeleted:
+			sb.WriteString("\nThe resource no longer exists in Genesys Cloud.\n")
+			continue
+		case driftStatusError:
+			sb.WriteString(fmt.Sprintf("\nThe resource could not be read: %s\n", resource.Error))
+			continue
+		}
+
+		sb.WriteString("\n| Attribute | Change | Expected | Actual |\n")
+		sb.WriteString("|---|---|---|---|\n")
+		writeRows := func(change string, drifts []AttributeDrift) {
+			for _, d := range drifts {
+				sb.WriteString(fmt.Sprintf("| `%s` | %s | %s | %s |\n", d.Attribute, change, markdownCell(d.Expected), markdownCell(d.Actual)))
+			}
+		}
+		writeRows("added", resource.Added)
+		writeRows("removed", resource.Removed)
+		writeRows("changed", resource.Changed)
+	}
+	return sb.String()
+}
+
+// markdownCell escapes a value so it can be written to a single cell of a Markdown table
+func markdownCell(value string) string {
+	value = strings.ReplaceAll(value, "|", "\\|")
+	return strings.ReplaceAll(value, "\n", "<br>")
+}
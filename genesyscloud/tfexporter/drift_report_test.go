@@ -0,0 +1,95 @@
+package tfexporter
+
+import (
+	"encoding/json"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestUnitDiffInstanceStates(t *testing.T) {
+	res := &schema.Resource{
+		Schema: map[string]*schema.Schema{
+			"name":          {Type: schema.TypeString, Required: true},
+			"description":   {Type: schema.TypeString, Optional: true},
+			"division_id":   {Type: schema.TypeString, Optional: true, Computed: true},
+			"password":      {Type: schema.TypeString, Optional: true, Sensitive: true},
+			"date_modified": {Type: schema.TypeString, Computed: true},
+			"skills":        {Type: schema.TypeSet, Optional: true, Elem: &schema.Schema{Type: schema.TypeString}},
+		},
+	}
+	expected := &terraform.InstanceState{
+		ID: "queue-id",
+		Attributes: map[string]string{
+			"id":            "queue-id",
+			"name":          "queue",
+			"description":   "a queue",
+			"password":      "secret",
+			"date_modified": "2024-01-01",
+			"skills.#":      "1",
+			"skills.1":      "skill-1",
+		},
+	}
+	actual := &terraform.InstanceState{
+		ID: "queue-id",
+		Attributes: map[string]string{
+			"id":            "queue-id",
+			"name":          "renamed queue",
+			"division_id":   "division-id",
+			"password":      "new secret",
+			"date_modified": "2024-02-01",
+			"skills.#":      "1",
+			"skills.1":      "skill-1",
+		},
+	}
+
+	added, removed, changed := diffInstanceStates(res, expected, actual)
+
+	assert.Equal(t, []AttributeDrift{{Attribute: "division_id", Actual: "division-id"}}, added)
+	assert.Equal(t, []AttributeDrift{{Attribute: "description", Expected: "a queue"}}, removed)
+	assert.Equal(t, []AttributeDrift{
+		{Attribute: "name", Expected: "queue", Actual: "renamed queue"},
+		{Attribute: "password", Expected: sensitiveValueMask, Actual: sensitiveValueMask},
+	}, changed)
+}
+
+func TestUnitDriftReportWriteFiles(t *testing.T) {
+	drifts := []*ResourceDrift{
+		{Type: "genesyscloud_routing_queue", Label: "in_sync", Id: "queue-id-1"},
+		{Type: "genesyscloud_routing_queue", Label: "changed", Id: "queue-id-2", Status: driftStatusChanged,
+			Changed: []AttributeDrift{{Attribute: "name", Expected: "a|b", Actual: "c"}}},
+		{Type: "genesyscloud_routing_queue", Label: "deleted", Id: "queue-id-3", Status: driftStatusDeleted},
+		nil,
+	}
+	report := buildDriftReport("terraform.tfstate", drifts)
+
+	assert.Equal(t, DriftSummary{ResourcesChecked: 3, ResourcesInSync: 1, ResourcesChanged: 1, ResourcesDeleted: 1}, report.Summary)
+	assert.Len(t, report.Resources, 2)
+
+	dir := t.TempDir()
+	if diagErr := report.writeFiles(dir); diagErr != nil {
+		t.Fatalf("Failed to write drift report: %v", diagErr)
+	}
+
+	data, err := os.ReadFile(filepath.Join(dir, driftReportJSONFile))
+	if err != nil {
+		t.Fatalf("Failed to read %s: %v", driftReportJSONFile, err)
+	}
+	var written DriftReport
+	if err := json.Unmarshal(data, &written); err != nil {
+		t.Fatalf("Failed to parse %s: %v", driftReportJSONFile, err)
+	}
+	assert.Equal(t, report.Summary, written.Summary)
+
+	markdown, err := os.ReadFile(filepath.Join(dir, driftReportMarkdownFile))
+	if err != nil {
+		t.Fatalf("Failed to read %s: %v", driftReportMarkdownFile, err)
+	}
+	assert.True(t, strings.Contains(string(markdown), "| `name` | changed | a\\|b | c |"), "Expected changed attribute row in:\n%s", string(markdown))
+	assert.True(t, strings.Contains(string(markdown), "## genesyscloud_routing_queue.deleted (deleted)"))
+}
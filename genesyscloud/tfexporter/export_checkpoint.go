@@ -0,0 +1,318 @@
+package tfexporter
+
+import (
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
+	"fmt"
+	"log"
+	"os"
+	"path/filepath"
+	"sort"
+	"strings"
+	"sync"
+
+	resourceExporter "terraform-provider-genesyscloud/genesyscloud/resource_exporter"
+
+	"github.com/hashicorp/go-cty/cty"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+)
+
+/*
+This file contains the checkpointing of an export. When resume_from_checkpoint is set, every resource type is written to
+a checkpoint file in the export directory as soon as all of its resources have been retrieved. When the failed export is
+run again, the resource types found in the checkpoint are neither listed nor read again. The checkpoint is removed once
+the export finishes successfully.
+
+Sensitive values are never written to a checkpoint. A resource type with sensitive values is not saved, so it is read
+again when the export is resumed.
+*/
+
+const (
+	checkpointDirName = ".genesyscloud_export_checkpoint"
+)
+
+// checkpointResourceType is the content of the checkpoint file of a single resource type
+type checkpointResourceType struct {
+	Fingerprint  string               `json:"fingerprint"`
+	ResourceType string               `json:"resource_type"`
+	Resources    []checkpointResource `json:"resources"`
+}
+
+type checkpointResource struct {
+	Id            string                 `json:"id"`
+	BlockLabel    string                 `json:"block_label"`
+	OriginalLabel string                 `json:"original_label,omitempty"`
+	BlockType     string                 `json:"block_type,omitempty"`
+	IdPrefix      string                 `json:"id_prefix,omitempty"`
+	Version       string                 `json:"version,omitempty"`
+	Attributes    map[string]string      `json:"attributes"`
+	Meta          map[string]interface{} `json:"meta,omitempty"`
+}
+
+type exportCheckpoint struct {
+	mutex         sync.Mutex
+	dirPath       string
+	fingerprint   string
+	resourceTypes map[string]*checkpointResourceType
+}
+
+func newExportCheckpoint(exportDirPath string, fingerprint string) *exportCheckpoint {
+	return &exportCheckpoint{
+		dirPath:       filepath.Join(exportDirPath, checkpointDirName),
+		fingerprint:   fingerprint,
+		resourceTypes: make(map[string]*checkpointResourceType),
+	}
+}
+
+// computeCheckpointFingerprint returns a hash of all settings that change which resources are retrieved and how. A
+// checkpoint is only resumed by an export with the same fingerprint.
+func computeCheckpointFingerprint(d *schema.ResourceData, version string) string {
+	settings := []string{version}
+	for _, key := range []string{"resource_types", "include_filter_resources", "exclude_filter_resources", "replace_with_datasource", "replacement_exporters", "export_computed"} {
+		settings = append(settings, fmt.Sprintf("%s=%v", key, d.Get(key)))
+	}
+	hash := sha256.Sum256([]byte(strings.Join(settings, "\n")))
+	return hex.EncodeToString(hash[:])
+}
+
+// load reads the checkpoint files of a previous export. Files that cannot be read or were written by an export with
+// different settings are ignored, so their resource types are retrieved again.
+func (c *exportCheckpoint) load() diag.Diagnostics {
+	if c == nil {
+		return nil
+	}
+	entries, err := os.ReadDir(c.dirPath)
+	if os.IsNotExist(err) {
+		log.Printf("No checkpoint found in %s. Running a full export.", c.dirPath)
+		return nil
+	}
+	if err != nil {
+		return diag.Errorf("Failed to read checkpoint directory %s: %v", c.dirPath, err)
+	}
+
+	for _, entry := range entries {
+		if entry.IsDir() || filepath.Ext(entry.Name()) != ".json" {
+			continue
+		}
+		path := filepath.Join(c.dirPath, entry.Name())
+		data, err := os.ReadFile(path)
+		if err != nil {
+			log.Printf("Failed to read checkpoint file %s. Its resource type will be exported again: %v", path, err)
+			continue
+		}
+		var resourceType checkpointResourceType
+		if err := json.Unmarshal(data, &resourceType); err != nil {
+			log.Printf("Failed to parse checkpoint file %s. Its resource type will be exported again: %v", path, err)
+			continue
+		}
+		if resourceType.Fingerprint != c.fingerprint {
+			log.Printf("Checkpoint of %s was written with different export settings. It will be exported again.", resourceType.ResourceType)
+			continue
+		}
+		c.resourceTypes[resourceType.ResourceType] = &resourceType
+	}
+	log.Printf("Resuming %d resource types from checkpoint %s", len(c.resourceTypes), c.dirPath)
+	return nil
+}
+
+// restoreSanitizedResourceMaps sets the sanitized resource map of every exporter whose resource type is in the
+// checkpoint. The exporters that still need to be listed are returned.
+func (c *exportCheckpoint) restoreSanitizedResourceMaps(exporters map[string]*resourceExporter.ResourceExporter) map[string]*resourceExporter.ResourceExporter {
+	if c == nil || len(c.resourceTypes) == 0 {
+		return exporters
+	}
+	remaining := make(map[string]*resourceExporter.ResourceExporter)
+	for resType, exporter := range exporters {
+		resourceType, ok := c.resourceTypes[resType]
+		if !ok {
+			remaining[resType] = exporter
+			continue
+		}
+		exporter.SanitizedResourceMap = make(resourceExporter.ResourceIDMetaMap)
+		for _, resource := range resourceType.Resources {
+			exporter.SanitizedResourceMap[resource.Id] = &resourceExporter.ResourceMeta{
+				BlockLabel:    resource.BlockLabel,
+				IdPrefix:      resource.IdPrefix,
+				OriginalLabel: resource.OriginalLabel,
+				Version:       resource.Version,
+			}
+		}
+		log.Printf("Restored %d resources for type %s from checkpoint", len(exporter.SanitizedResourceMap), resType)
+	}
+	return remaining
+}
+
+// getResources returns the resources of a type from the checkpoint. The second return value is false if the type is not
+// in the checkpoint.
+func (c *exportCheckpoint) getResources(resType string, schemaProvider *schema.Provider) ([]resourceExporter.ResourceInfo, bool, diag.Diagnostics) {
+	if c == nil {
+		return nil, false, nil
+	}
+	resourceType, ok := c.resourceTypes[resType]
+	if !ok {
+		return nil, false, nil
+	}
+
+	resources := make([]resourceExporter.ResourceInfo, 0, len(resourceType.Resources))
+	for _, resource := range resourceType.Resources {
+		var ctyType cty.Type
+		if resource.BlockType == "data" {
+			resData := schemaProvider.DataSourcesMap[resType]
+			if resData == nil {
+				return nil, true, diag.Errorf("DataSource type %v not defined", resType)
+			}
+			ctyType = resData.CoreConfigSchema().ImpliedType()
+		} else {
+			res := schemaProvider.ResourcesMap[resType]
+			if res == nil {
+				return nil, true, diag.Errorf("Resource type %v not defined", resType)
+			}
+			ctyType = res.CoreConfigSchema().ImpliedType()
+		}
+
+		resources = append(resources, resourceExporter.ResourceInfo{
+			State: &terraform.InstanceState{
+				ID:         resource.Id,
+				Attributes: resource.Attributes,
+				Meta:       resource.Meta,
+			},
+			BlockLabel:    resource.BlockLabel,
+			OriginalLabel: resource.OriginalLabel,
+			Type:          resType,
+			CtyType:       ctyType,
+			BlockType:     resource.BlockType,
+			ImportId:      resource.IdPrefix + resource.Id,
+		})
+	}
+	return resources, true, nil
+}
+
+// save writes the resources of a type to the checkpoint. Types without resources are not saved so they are listed
+// again on resume, e.g. after a permission error was fixed. Types with sensitive values are not saved either.
+func (c *exportCheckpoint) save(resType string, resources []resourceExporter.ResourceInfo, exporter *resourceExporter.ResourceExporter, schemaProvider *schema.Provider) diag.Diagnostics {
+	if c == nil || len(resources) == 0 {
+		return nil
+	}
+	if _, ok := c.resourceTypes[resType]; ok {
+		return nil
+	}
+	for _, resource := range resources {
+		if attribute, ok := findSensitiveValue(resource, schemaProvider); ok {
+			log.Printf("Not saving %s to the checkpoint because attribute %s of %s is sensitive. It will be exported again on resume.", resType, attribute, resource.State.ID)
+			return nil
+		}
+	}
+
+	resourceType := checkpointResourceType{
+		Fingerprint:  c.fingerprint,
+		ResourceType: resType,
+		Resources:    make([]checkpointResource, 0, len(resources)),
+	}
+	for _, resource := range resources {
+		checkpointRes := checkpointResource{
+			Id:            resource.State.ID,
+			BlockLabel:    resource.BlockLabel,
+			OriginalLabel: resource.OriginalLabel,
+			BlockType:     resource.BlockType,
+			Attributes:    resource.State.Attributes,
+			Meta:          resource.State.Meta,
+		}
+		if resMeta, ok := exporter.SanitizedResourceMap[resource.State.ID]; ok {
+			checkpointRes.IdPrefix = resMeta.IdPrefix
+			checkpointRes.Version = resMeta.Version
+		}
+		resourceType.Resources = append(resourceType.Resources, checkpointRes)
+	}
+
+	data, err := json.Marshal(resourceType)
+	if err != nil {
+		return diag.Errorf("Failed to marshal checkpoint of %s: %v", resType, err)
+	}
+
+	c.mutex.Lock()
+	defer c.mutex.Unlock()
+	if err := os.MkdirAll(c.dirPath, 0700); err != nil {
+		return diag.Errorf("Failed to create checkpoint directory %s: %v", c.dirPath, err)
+	}
+
+	// Write to a temporary file first so a failure while writing never leaves a partial checkpoint behind. The
+	// checkpoint holds the full state of the resources, so only the user can read it.
+	path := filepath.Join(c.dirPath, resType+".json")
+	if err := os.WriteFile(path+".tmp", data, 0600); err != nil {
+		return diag.Errorf("Failed to write checkpoint file %s: %v", path, err)
+	}
+	if err := os.Rename(path+".tmp", path); err != nil {
+		return diag.Errorf("Failed to write checkpoint file %s: %v", path, err)
+	}
+	log.Printf("Saved checkpoint of %d resources for type %s", len(resources), resType)
+	return nil
+}
+
+// findSensitiveValue returns the first attribute of a resource that has a value and is marked as sensitive in the schema
+// of its resource type or of any block containing it
+func findSensitiveValue(resource resourceExporter.ResourceInfo, schemaProvider *schema.Provider) (string, bool) {
+	var res *schema.Resource
+	if schemaProvider != nil {
+		if resource.BlockType == "data" {
+			res = schemaProvider.DataSourcesMap[resource.Type]
+		} else {
+			res = schemaProvider.ResourcesMap[resource.Type]
+		}
+	}
+	if res == nil || resource.State == nil {
+		return "", false
+	}
+
+	keys := make([]string, 0, len(resource.State.Attributes))
+	for key := range resource.State.Attributes {
+		keys = append(keys, key)
+	}
+	sort.Strings(keys)
+	for _, key := range keys {
+		// Element counts are not secret
+		if resource.State.Attributes[key] == "" || strings.HasSuffix(key, ".#") || strings.HasSuffix(key, ".%") {
+			continue
+		}
+		if isSensitiveAttribute(res.SchemaMap(), strings.Split(key, ".")) {
+			return key, true
+		}
+	}
+	return "", false
+}
+
+// isSensitiveAttribute reports whether the flatmap path of a state attribute, e.g. "fields.secret" or "settings.0.token",
+// is sensitive. The second segment of the path of a block is its list or set index.
+func isSensitiveAttribute(schemaMap map[string]*schema.Schema, path []string) bool {
+	if len(path) == 0 {
+		return false
+	}
+	attrSchema, ok := schemaMap[path[0]]
+	if !ok {
+		return false
+	}
+	if attrSchema.Sensitive {
+		return true
+	}
+	if len(path) < 3 {
+		return false
+	}
+	// path[1] is a list or set index
+	if elem, ok := attrSchema.Elem.(*schema.Resource); ok {
+		return isSensitiveAttribute(elem.SchemaMap(), path[2:])
+	}
+	return false
+}
+
+// remove deletes the checkpoint once the export finished successfully
+func (c *exportCheckpoint) remove() diag.Diagnostics {
+	if c == nil {
+		return nil
+	}
+	if err := os.RemoveAll(c.dirPath); err != nil {
+		return diag.Errorf("Failed to remove checkpoint directory %s: %v", c.dirPath, err)
+	}
+	return nil
+}
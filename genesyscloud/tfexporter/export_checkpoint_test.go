@@ -0,0 +1,153 @@
+package tfexporter
+
+import (
+	"os"
+	"path/filepath"
+	resourceExporter "terraform-provider-genesyscloud/genesyscloud/resource_exporter"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestUnitExportCheckpointSaveAndResume(t *testing.T) {
+	const queueType = "genesyscloud_routing_queue"
+	schemaProvider := &schema.Provider{
+		ResourcesMap: map[string]*schema.Resource{
+			queueType: {
+				Schema: map[string]*schema.Schema{
+					"name": {Type: schema.TypeString, Required: true},
+				},
+			},
+		},
+	}
+	exporter := &resourceExporter.ResourceExporter{
+		SanitizedResourceMap: resourceExporter.ResourceIDMetaMap{
+			"queue-id": {BlockLabel: "queue", IdPrefix: "prefix/", Version: "3"},
+		},
+	}
+	resources := []resourceExporter.ResourceInfo{
+		{
+			State: &terraform.InstanceState{
+				ID:         "queue-id",
+				Attributes: map[string]string{"id": "queue-id", "name": "queue"},
+			},
+			BlockLabel: "queue",
+			Type:       queueType,
+			ImportId:   "prefix/queue-id",
+		},
+	}
+
+	dir := t.TempDir()
+	checkpoint := newExportCheckpoint(dir, "fingerprint")
+	if diagErr := checkpoint.save(queueType, resources, exporter, schemaProvider); diagErr != nil {
+		t.Fatalf("Failed to save checkpoint: %v", diagErr)
+	}
+	// Checkpoint files hold the state of the resources, so only the user can read them
+	if info, err := os.Stat(filepath.Join(dir, checkpointDirName, queueType+".json")); err != nil {
+		t.Fatalf("Failed to stat checkpoint file: %v", err)
+	} else {
+		assert.Equal(t, os.FileMode(0600), info.Mode().Perm())
+	}
+	if diagErr := checkpoint.save("genesyscloud_user", nil, exporter, schemaProvider); diagErr != nil {
+		t.Fatalf("Failed to save empty checkpoint: %v", diagErr)
+	}
+	if _, err := os.Stat(filepath.Join(dir, checkpointDirName, "genesyscloud_user.json")); !os.IsNotExist(err) {
+		t.Errorf("Expected resource types without resources not to be saved")
+	}
+
+	// A resumed export with the same settings restores the resource type
+	resumed := newExportCheckpoint(dir, "fingerprint")
+	if diagErr := resumed.load(); diagErr != nil {
+		t.Fatalf("Failed to load checkpoint: %v", diagErr)
+	}
+	exporters := map[string]*resourceExporter.ResourceExporter{
+		queueType:           {},
+		"genesyscloud_user": {},
+	}
+	remaining := resumed.restoreSanitizedResourceMaps(exporters)
+	assert.Len(t, remaining, 1)
+	assert.Contains(t, remaining, "genesyscloud_user")
+	assert.Equal(t, &resourceExporter.ResourceMeta{BlockLabel: "queue", IdPrefix: "prefix/", Version: "3"},
+		exporters[queueType].SanitizedResourceMap["queue-id"])
+
+	restoredResources, restored, diagErr := resumed.getResources(queueType, schemaProvider)
+	if diagErr != nil {
+		t.Fatalf("Failed to get resources from checkpoint: %v", diagErr)
+	}
+	assert.True(t, restored)
+	assert.Len(t, restoredResources, 1)
+	assert.Equal(t, "queue", restoredResources[0].BlockLabel)
+	assert.Equal(t, "prefix/queue-id", restoredResources[0].ImportId)
+	assert.Equal(t, resources[0].State.Attributes, restoredResources[0].State.Attributes)
+	assert.Equal(t, schemaProvider.ResourcesMap[queueType].CoreConfigSchema().ImpliedType(), restoredResources[0].CtyType)
+
+	_, restored, _ = resumed.getResources("genesyscloud_user", schemaProvider)
+	assert.False(t, restored)
+
+	// A resumed export with different settings retrieves everything again
+	changed := newExportCheckpoint(dir, "other fingerprint")
+	if diagErr := changed.load(); diagErr != nil {
+		t.Fatalf("Failed to load checkpoint: %v", diagErr)
+	}
+	assert.Len(t, changed.restoreSanitizedResourceMaps(exporters), 2)
+
+	if diagErr := resumed.remove(); diagErr != nil {
+		t.Fatalf("Failed to remove checkpoint: %v", diagErr)
+	}
+	if _, err := os.Stat(filepath.Join(dir, checkpointDirName)); !os.IsNotExist(err) {
+		t.Errorf("Expected the checkpoint directory to be removed")
+	}
+}
+
+func TestUnitExportCheckpointSkipsSensitiveValues(t *testing.T) {
+	const credentialType = "genesyscloud_integration_credential"
+	schemaProvider := &schema.Provider{
+		ResourcesMap: map[string]*schema.Resource{
+			credentialType: {
+				Schema: map[string]*schema.Schema{
+					"name":   {Type: schema.TypeString, Required: true},
+					"fields": {Type: schema.TypeMap, Optional: true, Sensitive: true},
+					"settings": {
+						Type:     schema.TypeList,
+						Optional: true,
+						Elem: &schema.Resource{
+							Schema: map[string]*schema.Schema{
+								"token": {Type: schema.TypeString, Optional: true, Sensitive: true},
+							},
+						},
+					},
+				},
+			},
+		},
+	}
+	exporter := &resourceExporter.ResourceExporter{SanitizedResourceMap: resourceExporter.ResourceIDMetaMap{}}
+	newResources := func(attributes map[string]string) []resourceExporter.ResourceInfo {
+		return []resourceExporter.ResourceInfo{{
+			State:      &terraform.InstanceState{ID: "credential-id", Attributes: attributes},
+			BlockLabel: "credential",
+			Type:       credentialType,
+		}}
+	}
+
+	testCases := map[string]struct {
+		attributes map[string]string
+		saved      bool
+	}{
+		"no sensitive values":    {map[string]string{"name": "credential", "fields.%": "0"}, true},
+		"sensitive map":          {map[string]string{"name": "credential", "fields.%": "1", "fields.secret": "value"}, false},
+		"sensitive nested value": {map[string]string{"name": "credential", "settings.#": "1", "settings.0.token": "value"}, false},
+	}
+	for name, testCase := range testCases {
+		t.Run(name, func(t *testing.T) {
+			dir := t.TempDir()
+			checkpoint := newExportCheckpoint(dir, "fingerprint")
+			if diagErr := checkpoint.save(credentialType, newResources(testCase.attributes), exporter, schemaProvider); diagErr != nil {
+				t.Fatalf("Failed to save checkpoint: %v", diagErr)
+			}
+			_, err := os.Stat(filepath.Join(dir, checkpointDirName, credentialType+".json"))
+			assert.Equal(t, testCase.saved, err == nil)
+		})
+	}
+}
@@ -25,7 +25,10 @@ const (
 	defaultTfHCLVariablesFile  = "variables.tf"
 	defaultTfJSONVariablesFile = "variables.tf.json"
 	defaultTfVarsFile          = "terraform.tfvars"
+	defaultTfVarsExampleFile   = "terraform.tfvars.example"
 	defaultTfStateFile         = "terraform.tfstate"
+	defaultTfHCLImportFile     = "import.tf"
+	defaultTfJSONImportFile    = "import.tf.json"
 )
 
 // Common Exporter interface to abstract away whether we are using HCL or JSON as our exporter
@@ -291,7 +294,19 @@ func getFilePath(d *schema.ResourceData, filename string) (string, diag.Diagnost
 
 // Get a string path to the target export directory
 func getDirPath(d *schema.ResourceData) (string, diag.Diagnostics) {
-	directory := d.Get("directory").(string)
+	directory, diagErr := expandHomeDir(d.Get("directory").(string))
+	if diagErr != nil {
+		return "", diagErr
+	}
+	if err := os.MkdirAll(directory, os.ModePerm); err != nil {
+		return "", diag.FromErr(err)
+	}
+
+	return directory, nil
+}
+
+// Replace a leading '~' in a directory path with the user's home directory
+func expandHomeDir(directory string) (string, diag.Diagnostics) {
 	if strings.HasPrefix(directory, "~") {
 		homeDir, err := os.UserHomeDir()
 		if err != nil {
@@ -299,10 +314,6 @@ func getDirPath(d *schema.ResourceData) (string, diag.Diagnostics) {
 		}
 		directory = strings.Replace(directory, "~", homeDir, 1)
 	}
-	if err := os.MkdirAll(directory, os.ModePerm); err != nil {
-		return "", diag.FromErr(err)
-	}
-
 	return directory, nil
 }
 
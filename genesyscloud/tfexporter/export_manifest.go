@@ -0,0 +1,191 @@
+package tfexporter
+
+import (
+	"encoding/json"
+	"fmt"
+	"path/filepath"
+	"sort"
+	"time"
+
+	resourceExporter "terraform-provider-genesyscloud/genesyscloud/resource_exporter"
+	"terraform-provider-genesyscloud/genesyscloud/util/files"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+)
+
+/*
+This file contains the export manifest. The manifest is a machine-readable summary of an export that is written to the
+export directory whether the export succeeds or not, so pipelines can gate on its content.
+*/
+
+const (
+	exportManifestFile = "export_manifest.json"
+
+	exportStatusSucceeded = "succeeded"
+	exportStatusFailed    = "failed"
+)
+
+type ExportManifest struct {
+	GeneratedAt          string                 `json:"generated_at"`
+	Directory            string                 `json:"directory"`
+	Status               string                 `json:"status"`
+	Error                string                 `json:"error,omitempty"`
+	DurationSeconds      float64                `json:"duration_seconds"`
+	Summary              ManifestSummary        `json:"summary"`
+	ResourceTypes        []ManifestResourceType `json:"resource_types"`
+	UnresolvedReferences []ManifestReference    `json:"unresolved_references"`
+	Variables            []ManifestVariable     `json:"variables"`
+}
+
+type ManifestSummary struct {
+	ResourceTypes        int `json:"resource_types"`
+	Resources            int `json:"resources"`
+	SkippedResourceTypes int `json:"skipped_resource_types"`
+	SkippedResources     int `json:"skipped_resources"`
+	UnresolvedReferences int `json:"unresolved_references"`
+	Variables            int `json:"variables"`
+}
+
+type ManifestResourceType struct {
+	Type            string                    `json:"type"`
+	Resources       int                       `json:"resources"`
+	SkipReason      string                    `json:"skip_reason,omitempty"`
+	Error           string                    `json:"error,omitempty"`
+	Skipped         []ManifestSkippedResource `json:"skipped,omitempty"`
+	DurationSeconds float64                   `json:"duration_seconds"`
+}
+
+type ManifestSkippedResource struct {
+	Id     string `json:"id"`
+	Label  string `json:"label"`
+	Reason string `json:"reason"`
+}
+
+type ManifestReference struct {
+	Type string `json:"type"`
+	Id   string `json:"id"`
+}
+
+type ManifestVariable struct {
+	Name          string `json:"name"`
+	ResourceType  string `json:"resource_type"`
+	ResourceLabel string `json:"resource_label"`
+	Attribute     string `json:"attribute"`
+	Sensitive     bool   `json:"sensitive"`
+}
+
+// writeExportManifest writes the manifest of the export to the export directory
+func (g *GenesysCloudResourceExporter) writeExportManifest(start time.Time, exportErr diag.Diagnostics) diag.Diagnostics {
+	manifest := buildExportManifest(g.metrics, g.resources, g.unresolvedAttrs, start, exportErr)
+	manifest.Directory = g.exportDirPath
+
+	jsonBytes, err := json.MarshalIndent(manifest, "", "  ")
+	if err != nil {
+		return diag.Errorf("Failed to marshal the export manifest: %v", err)
+	}
+	return files.WriteToFile(jsonBytes, filepath.Join(g.exportDirPath, exportManifestFile))
+}
+
+func buildExportManifest(metrics *exportMetrics, resources []resourceExporter.ResourceInfo, unresolvedAttrs []unresolvableAttributeInfo, start time.Time, exportErr diag.Diagnostics) *ExportManifest {
+	manifest := &ExportManifest{
+		GeneratedAt:          time.Now().UTC().Format(time.RFC3339),
+		Status:               exportStatusSucceeded,
+		DurationSeconds:      time.Since(start).Seconds(),
+		ResourceTypes:        make([]ManifestResourceType, 0),
+		UnresolvedReferences: make([]ManifestReference, 0),
+		Variables:            make([]ManifestVariable, 0),
+	}
+	if exportErr.HasError() {
+		manifest.Status = exportStatusFailed
+		manifest.Error = fmt.Sprintf("%v", exportErr)
+	}
+
+	exported := make(map[string]map[string]bool)
+	for _, resource := range resources {
+		if exported[resource.Type] == nil {
+			exported[resource.Type] = make(map[string]bool)
+		}
+		exported[resource.Type][resource.State.ID] = true
+	}
+
+	typeManifests := make(map[string]*ManifestResourceType)
+	getTypeManifest := func(resourceType string) *ManifestResourceType {
+		typeManifest, ok := typeManifests[resourceType]
+		if !ok {
+			typeManifest = &ManifestResourceType{Type: resourceType, Resources: len(exported[resourceType])}
+			typeManifests[resourceType] = typeManifest
+		}
+		return typeManifest
+	}
+	for resourceType := range exported {
+		getTypeManifest(resourceType)
+	}
+
+	if metrics != nil {
+		metrics.mutex.Lock()
+		defer metrics.mutex.Unlock()
+
+		for resourceType, typeMetrics := range metrics.types {
+			typeManifest := getTypeManifest(resourceType)
+			typeManifest.SkipReason = typeMetrics.skipReason
+			typeManifest.Error = typeMetrics.skipError
+			typeManifest.DurationSeconds = (typeMetrics.listDuration + typeMetrics.totalTime).Seconds()
+			for id, skipped := range typeMetrics.skipped {
+				// Resources filtered out in one pass may have been exported as a dependency in another
+				if exported[resourceType][id] {
+					continue
+				}
+				typeManifest.Skipped = append(typeManifest.Skipped, ManifestSkippedResource{Id: skipped.id, Label: skipped.label, Reason: skipped.reason})
+			}
+			sort.Slice(typeManifest.Skipped, func(i, j int) bool {
+				return typeManifest.Skipped[i].Id < typeManifest.Skipped[j].Id
+			})
+		}
+
+		for resourceType, ids := range metrics.unresolvedReferences {
+			for id := range ids {
+				// References that could not be resolved at first may have been exported as a dependency later
+				if exported[resourceType][id] {
+					continue
+				}
+				manifest.UnresolvedReferences = append(manifest.UnresolvedReferences, ManifestReference{Type: resourceType, Id: id})
+			}
+		}
+		sort.Slice(manifest.UnresolvedReferences, func(i, j int) bool {
+			if manifest.UnresolvedReferences[i].Type != manifest.UnresolvedReferences[j].Type {
+				return manifest.UnresolvedReferences[i].Type < manifest.UnresolvedReferences[j].Type
+			}
+			return manifest.UnresolvedReferences[i].Id < manifest.UnresolvedReferences[j].Id
+		})
+	}
+
+	for _, typeManifest := range typeManifests {
+		manifest.ResourceTypes = append(manifest.ResourceTypes, *typeManifest)
+		manifest.Summary.Resources += typeManifest.Resources
+		manifest.Summary.SkippedResources += len(typeManifest.Skipped)
+		if typeManifest.SkipReason != "" {
+			manifest.Summary.SkippedResourceTypes++
+		}
+	}
+	sort.Slice(manifest.ResourceTypes, func(i, j int) bool {
+		return manifest.ResourceTypes[i].Type < manifest.ResourceTypes[j].Type
+	})
+
+	for _, attr := range unresolvedAttrs {
+		manifest.Variables = append(manifest.Variables, ManifestVariable{
+			Name:          createUnresolvedAttrKey(attr),
+			ResourceType:  attr.ResourceType,
+			ResourceLabel: attr.ResourceLabel,
+			Attribute:     attr.Name,
+			Sensitive:     attr.Sensitive,
+		})
+	}
+	sort.Slice(manifest.Variables, func(i, j int) bool {
+		return manifest.Variables[i].Name < manifest.Variables[j].Name
+	})
+
+	manifest.Summary.ResourceTypes = len(manifest.ResourceTypes)
+	manifest.Summary.UnresolvedReferences = len(manifest.UnresolvedReferences)
+	manifest.Summary.Variables = len(manifest.Variables)
+	return manifest
+}
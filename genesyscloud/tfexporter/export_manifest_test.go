@@ -0,0 +1,91 @@
+package tfexporter
+
+import (
+	"encoding/json"
+	"os"
+	"path/filepath"
+	resourceExporter "terraform-provider-genesyscloud/genesyscloud/resource_exporter"
+	"testing"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestUnitBuildExportManifest(t *testing.T) {
+	metrics := newExportMetrics()
+	metrics.recordList("genesyscloud_routing_queue", time.Second)
+	metrics.recordTotal("genesyscloud_routing_queue", 2*time.Second)
+	metrics.recordSkippedResource("genesyscloud_routing_queue", "queue-id-2", "deleted_queue", skipReasonNotFound)
+	metrics.recordSkippedResource("genesyscloud_routing_queue", "queue-id-3", "filtered_queue", skipReasonFilter)
+	// Filtered out at first but exported later as a dependency
+	metrics.recordSkippedResource("genesyscloud_routing_queue", "queue-id-1", "queue", skipReasonFilter)
+	metrics.recordSkippedType("genesyscloud_user", skipReasonPermission, "API Error: 403")
+	metrics.recordUnresolvedReference("genesyscloud_routing_skill", "skill-id")
+	metrics.recordUnresolvedReference("genesyscloud_routing_queue", "queue-id-1")
+
+	resources := []resourceExporter.ResourceInfo{
+		{Type: "genesyscloud_routing_queue", BlockLabel: "queue", State: &terraform.InstanceState{ID: "queue-id-1"}},
+		{Type: "genesyscloud_auth_division", BlockLabel: "home", State: &terraform.InstanceState{ID: "division-id"}},
+	}
+	unresolvedAttrs := []unresolvableAttributeInfo{
+		{ResourceType: "genesyscloud_integration_credential", ResourceLabel: "cred", Name: "fields", Schema: &schema.Schema{}},
+	}
+
+	manifest := buildExportManifest(metrics, resources, unresolvedAttrs, time.Now(), nil)
+
+	assert.Equal(t, exportStatusSucceeded, manifest.Status)
+	assert.Equal(t, ManifestSummary{
+		ResourceTypes:        3,
+		Resources:            2,
+		SkippedResourceTypes: 1,
+		SkippedResources:     2,
+		UnresolvedReferences: 1,
+		Variables:            1,
+	}, manifest.Summary)
+
+	assert.Equal(t, "genesyscloud_auth_division", manifest.ResourceTypes[0].Type)
+	queueManifest := manifest.ResourceTypes[1]
+	assert.Equal(t, "genesyscloud_routing_queue", queueManifest.Type)
+	assert.Equal(t, 1, queueManifest.Resources)
+	assert.Equal(t, float64(3), queueManifest.DurationSeconds)
+	assert.Equal(t, []ManifestSkippedResource{
+		{Id: "queue-id-2", Label: "deleted_queue", Reason: skipReasonNotFound},
+		{Id: "queue-id-3", Label: "filtered_queue", Reason: skipReasonFilter},
+	}, queueManifest.Skipped)
+	userManifest := manifest.ResourceTypes[2]
+	assert.Equal(t, skipReasonPermission, userManifest.SkipReason)
+	assert.Equal(t, "API Error: 403", userManifest.Error)
+
+	assert.Equal(t, []ManifestReference{{Type: "genesyscloud_routing_skill", Id: "skill-id"}}, manifest.UnresolvedReferences)
+	assert.Equal(t, []ManifestVariable{{
+		Name:          "genesyscloud_integration_credential_cred_fields",
+		ResourceType:  "genesyscloud_integration_credential",
+		ResourceLabel: "cred",
+		Attribute:     "fields",
+	}}, manifest.Variables)
+}
+
+func TestUnitWriteExportManifestOnFailure(t *testing.T) {
+	dir := t.TempDir()
+	g := &GenesysCloudResourceExporter{exportDirPath: dir, metrics: newExportMetrics()}
+
+	if diagErr := g.writeExportManifest(time.Now(), diag.Errorf("export failed")); diagErr != nil {
+		t.Fatalf("Failed to write export manifest: %v", diagErr)
+	}
+
+	data, err := os.ReadFile(filepath.Join(dir, exportManifestFile))
+	if err != nil {
+		t.Fatalf("Failed to read %s: %v", exportManifestFile, err)
+	}
+	var manifest ExportManifest
+	if err := json.Unmarshal(data, &manifest); err != nil {
+		t.Fatalf("Failed to parse %s: %v", exportManifestFile, err)
+	}
+	assert.Equal(t, exportStatusFailed, manifest.Status)
+	assert.Contains(t, manifest.Error, "export failed")
+	assert.Equal(t, dir, manifest.Directory)
+	assert.Empty(t, manifest.ResourceTypes)
+}
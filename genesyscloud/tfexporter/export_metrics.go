@@ -0,0 +1,154 @@
+package tfexporter
+
+import (
+	"log"
+	"sort"
+	"sync"
+	"time"
+)
+
+/*
+This file contains the metrics of an export. The time spent listing and reading the resources of each type is
+recorded and logged once the export is finished, so slow resource types can be identified. The resources that were
+skipped and the references that could not be resolved are recorded for the export manifest.
+*/
+
+const (
+	skipReasonPermission = "permission"
+	skipReasonNotFound   = "not_found"
+	skipReasonFilter     = "filter"
+)
+
+type skippedResource struct {
+	id     string
+	label  string
+	reason string
+}
+
+type resourceTypeMetrics struct {
+	resourceType string
+	listDuration time.Duration
+	readCount    int
+	readDuration time.Duration
+	totalTime    time.Duration
+	skipReason   string
+	skipError    string
+	skipped      map[string]skippedResource
+}
+
+type exportMetrics struct {
+	mutex                sync.Mutex
+	types                map[string]*resourceTypeMetrics
+	unresolvedReferences map[string]map[string]bool
+}
+
+func newExportMetrics() *exportMetrics {
+	return &exportMetrics{
+		types:                make(map[string]*resourceTypeMetrics),
+		unresolvedReferences: make(map[string]map[string]bool),
+	}
+}
+
+func (m *exportMetrics) getTypeMetrics(resourceType string) *resourceTypeMetrics {
+	metrics, ok := m.types[resourceType]
+	if !ok {
+		metrics = &resourceTypeMetrics{resourceType: resourceType, skipped: make(map[string]skippedResource)}
+		m.types[resourceType] = metrics
+	}
+	return metrics
+}
+
+// recordList records the time taken to list all resources of a type
+func (m *exportMetrics) recordList(resourceType string, duration time.Duration) {
+	if m == nil {
+		return
+	}
+	m.mutex.Lock()
+	defer m.mutex.Unlock()
+	m.getTypeMetrics(resourceType).listDuration += duration
+}
+
+// recordRead records the time taken to read a single resource
+func (m *exportMetrics) recordRead(resourceType string, duration time.Duration) {
+	if m == nil {
+		return
+	}
+	m.mutex.Lock()
+	defer m.mutex.Unlock()
+	metrics := m.getTypeMetrics(resourceType)
+	metrics.readCount++
+	metrics.readDuration += duration
+}
+
+// recordTotal records the time taken to retrieve all resources of a type
+func (m *exportMetrics) recordTotal(resourceType string, duration time.Duration) {
+	if m == nil {
+		return
+	}
+	m.mutex.Lock()
+	defer m.mutex.Unlock()
+	m.getTypeMetrics(resourceType).totalTime += duration
+}
+
+// recordSkippedType records that no resource of a type was exported, e.g. because of a permission error
+func (m *exportMetrics) recordSkippedType(resourceType, reason, message string) {
+	if m == nil {
+		return
+	}
+	m.mutex.Lock()
+	defer m.mutex.Unlock()
+	metrics := m.getTypeMetrics(resourceType)
+	metrics.skipReason = reason
+	metrics.skipError = message
+}
+
+// recordSkippedResource records that a single resource was not exported
+func (m *exportMetrics) recordSkippedResource(resourceType, id, label, reason string) {
+	if m == nil {
+		return
+	}
+	m.mutex.Lock()
+	defer m.mutex.Unlock()
+	m.getTypeMetrics(resourceType).skipped[id] = skippedResource{id: id, label: label, reason: reason}
+}
+
+// recordUnresolvedReference records a reference to a resource that is not part of the export
+func (m *exportMetrics) recordUnresolvedReference(resourceType, id string) {
+	if m == nil {
+		return
+	}
+	m.mutex.Lock()
+	defer m.mutex.Unlock()
+	if m.unresolvedReferences[resourceType] == nil {
+		m.unresolvedReferences[resourceType] = make(map[string]bool)
+	}
+	m.unresolvedReferences[resourceType][id] = true
+}
+
+// logSummary logs the metrics of each resource type, slowest type first
+func (m *exportMetrics) logSummary() {
+	if m == nil {
+		return
+	}
+	m.mutex.Lock()
+	defer m.mutex.Unlock()
+
+	metrics := make([]*resourceTypeMetrics, 0, len(m.types))
+	for _, typeMetrics := range m.types {
+		metrics = append(metrics, typeMetrics)
+	}
+	sort.Slice(metrics, func(i, j int) bool {
+		return metrics[i].listDuration+metrics[i].totalTime > metrics[j].listDuration+metrics[j].totalTime
+	})
+
+	log.Printf("Export timing per resource type:")
+	for _, typeMetrics := range metrics {
+		var averageRead time.Duration
+		if typeMetrics.readCount > 0 {
+			averageRead = typeMetrics.readDuration / time.Duration(typeMetrics.readCount)
+		}
+		log.Printf("  %s: list %v, %d reads in %v (average read %v)", typeMetrics.resourceType,
+			typeMetrics.listDuration.Round(time.Millisecond), typeMetrics.readCount,
+			typeMetrics.totalTime.Round(time.Millisecond), averageRead.Round(time.Millisecond))
+	}
+}
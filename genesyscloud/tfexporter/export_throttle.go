@@ -0,0 +1,77 @@
+package tfexporter
+
+import (
+	"context"
+	"net/http"
+	"terraform-provider-genesyscloud/genesyscloud/util/ratelimit"
+)
+
+/*
+This file contains the throttling of an export. The number of resource types exported at the same time, the number of
+resources read at the same time and the rate at which reads are started can all be limited. Reads are paused whenever
+the API responds with a 429 and a Retry-After header.
+*/
+
+type exportThrottle struct {
+	typeSlots   chan struct{}
+	readSlots   chan struct{}
+	rateLimiter *ratelimit.TokenBucket
+}
+
+// newExportThrottle creates the throttle of an export. A limit of 0 means unlimited.
+func newExportThrottle(maxConcurrentTypes, maxConcurrentReads, maxReadsPerSecond int) *exportThrottle {
+	t := &exportThrottle{
+		rateLimiter: ratelimit.NewTokenBucket(float64(maxReadsPerSecond), maxReadsPerSecond),
+	}
+	if maxConcurrentTypes > 0 {
+		t.typeSlots = make(chan struct{}, maxConcurrentTypes)
+	}
+	if maxConcurrentReads > 0 {
+		t.readSlots = make(chan struct{}, maxConcurrentReads)
+	}
+	return t
+}
+
+// acquireTypeSlot blocks until another resource type can be exported. The returned function releases the slot.
+func (t *exportThrottle) acquireTypeSlot(ctx context.Context) (func(), error) {
+	if t == nil {
+		return func() {}, nil
+	}
+	return acquireSlot(ctx, t.typeSlots)
+}
+
+// acquireRead blocks until another API read can be started. The returned function releases the read.
+func (t *exportThrottle) acquireRead(ctx context.Context) (func(), error) {
+	if t == nil {
+		return func() {}, nil
+	}
+	release, err := acquireSlot(ctx, t.readSlots)
+	if err != nil {
+		return nil, err
+	}
+	if err := t.rateLimiter.Wait(ctx); err != nil {
+		release()
+		return nil, err
+	}
+	return release, nil
+}
+
+func acquireSlot(ctx context.Context, slots chan struct{}) (func(), error) {
+	if slots == nil {
+		return func() {}, nil
+	}
+	select {
+	case slots <- struct{}{}:
+		return func() { <-slots }, nil
+	case <-ctx.Done():
+		return nil, ctx.Err()
+	}
+}
+
+// observeResponse pauses reads for the duration of the Retry-After header of a 429 response
+func (t *exportThrottle) observeResponse(response *http.Response) {
+	if t == nil {
+		return
+	}
+	t.rateLimiter.ObserveResponse(response)
+}
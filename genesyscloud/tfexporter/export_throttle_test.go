@@ -0,0 +1,63 @@
+package tfexporter
+
+import (
+	"context"
+	"sync"
+	"sync/atomic"
+	"testing"
+	"time"
+)
+
+func TestUnitExportThrottleMaxConcurrentReads(t *testing.T) {
+	throttle := newExportThrottle(0, 2, 0)
+
+	var running, maxRunning int32
+	var wg sync.WaitGroup
+	for i := 0; i < 10; i++ {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			release, err := throttle.acquireRead(context.Background())
+			if err != nil {
+				t.Errorf("Unexpected error acquiring read: %v", err)
+				return
+			}
+			current := atomic.AddInt32(&running, 1)
+			for {
+				previous := atomic.LoadInt32(&maxRunning)
+				if current <= previous || atomic.CompareAndSwapInt32(&maxRunning, previous, current) {
+					break
+				}
+			}
+			time.Sleep(10 * time.Millisecond)
+			atomic.AddInt32(&running, -1)
+			release()
+		}()
+	}
+	wg.Wait()
+
+	if maxRunning > 2 {
+		t.Errorf("Expected at most 2 concurrent reads, got %d", maxRunning)
+	}
+}
+
+func TestUnitExportThrottleCancelledContext(t *testing.T) {
+	throttle := newExportThrottle(1, 0, 0)
+
+	release, err := throttle.acquireTypeSlot(context.Background())
+	if err != nil {
+		t.Fatalf("Unexpected error acquiring type slot: %v", err)
+	}
+	defer release()
+
+	ctx, cancel := context.WithTimeout(context.Background(), 20*time.Millisecond)
+	defer cancel()
+	if _, err := throttle.acquireTypeSlot(ctx); err == nil {
+		t.Errorf("Expected acquiring a second type slot to fail once the context is done")
+	}
+
+	var nilThrottle *exportThrottle
+	if _, err := nilThrottle.acquireRead(context.Background()); err != nil {
+		t.Errorf("Expected a nil throttle not to limit reads, got %v", err)
+	}
+}
@@ -24,6 +24,7 @@ import (
 	"terraform-provider-genesyscloud/genesyscloud/util/files"
 	"terraform-provider-genesyscloud/genesyscloud/util/lists"
 	"terraform-provider-genesyscloud/genesyscloud/util/stringmap"
+	"terraform-provider-genesyscloud/genesyscloud/util/tracing"
 	"time"
 
 	"github.com/google/uuid"
@@ -34,6 +35,7 @@ import (
 	"github.com/mohae/deepcopy"
 
 	"github.com/mypurecloud/platform-client-sdk-go/v152/platformclientv2"
+	"go.opentelemetry.io/otel/trace"
 )
 
 /*
@@ -61,6 +63,21 @@ type unresolvableAttributeInfo struct {
 	ResourceLabel string
 	Name          string
 	Schema        *schema.Schema
+	// Sensitive variables are written to the tfvars example file instead of the tfvars file
+	Sensitive bool
+	// ExampleValue is written to the tfvars example file. The default value of the schema is used if it is not set.
+	ExampleValue interface{}
+}
+
+const (
+	referencePolicyDefault    = "default"
+	referencePolicyDataSource = "data_source"
+)
+
+// referencedDataSource is a resource outside of the export that was added to the export as a data source because it is referenced
+type referencedDataSource struct {
+	label  string
+	config util.JsonMap
 }
 
 const (
@@ -82,6 +99,14 @@ type GenesysCloudResourceExporter struct {
 	addDependsOn          bool
 	replaceWithDatasource []string
 	includeStateFile      bool
+	includeImportBlocks   bool
+	sensitiveAsVariables  bool
+	exportAsModules       bool
+	referencePolicy       string
+	referencedDataSources map[string]*referencedDataSource
+	throttle              *exportThrottle
+	metrics               *exportMetrics
+	checkpoint            *exportCheckpoint
 	version               string
 	providerRegistry      string
 	provider              *schema.Provider
@@ -101,6 +126,7 @@ type GenesysCloudResourceExporter struct {
 	ignoreCyclicDeps      bool
 	flowResourcesList     []string
 	exportComputed        bool
+	previousExport        *TfStateFileReader
 }
 
 func configureExporterType(ctx context.Context, d *schema.ResourceData, gre *GenesysCloudResourceExporter, filterType ExporterFilterType) {
@@ -152,6 +178,12 @@ func NewGenesysCloudResourceExporter(ctx context.Context, d *schema.ResourceData
 		addDependsOn:         computeDependsOn(d),
 		filterType:           filterType,
 		includeStateFile:     d.Get("include_state_file").(bool),
+		includeImportBlocks:  d.Get("include_import_blocks").(bool),
+		sensitiveAsVariables: d.Get("export_sensitive_as_variables").(bool),
+		exportAsModules:      d.Get("export_as_modules").(bool),
+		referencePolicy:      d.Get("unresolved_reference_policy").(string),
+		throttle:             newExportThrottle(d.Get("max_concurrent_resource_types").(int), d.Get("max_concurrent_reads").(int), d.Get("max_reads_per_second").(int)),
+		metrics:              newExportMetrics(),
 		ignoreCyclicDeps:     d.Get("ignore_cyclic_deps").(bool),
 		version:              meta.(*provider.ProviderMeta).Version,
 		providerRegistry:     meta.(*provider.ProviderMeta).Registry,
@@ -165,6 +197,10 @@ func NewGenesysCloudResourceExporter(ctx context.Context, d *schema.ResourceData
 	if err != nil {
 		return nil, err
 	}
+	// Exports are only checkpointed when the user opts in, since the checkpoint holds the state of every resource
+	if d.Get("resume_from_checkpoint").(bool) {
+		gre.checkpoint = newExportCheckpoint(gre.exportDirPath, computeCheckpointFingerprint(d, gre.version))
+	}
 
 	gre.setupDataSource()
 
@@ -193,43 +229,88 @@ func computeDependsOn(d *schema.ResourceData) bool {
 }
 
 func (g *GenesysCloudResourceExporter) Export() (diagErr diag.Diagnostics) {
+	// Trace the whole export, with a span for each of its phases
+	exportCtx := g.ctx
+	var span trace.Span
+	g.ctx, span = tracing.StartSpan(exportCtx, "Export")
+	defer func() {
+		g.ctx = exportCtx
+		tracing.EndSpan(span, tracing.DiagnosticsError(diagErr))
+		tracing.Flush(exportCtx)
+	}()
+
+	// Pause reads whenever the API asks to retry later
+	unregisterResponseObserver := g.meta.(*provider.ProviderMeta).RegisterResponseObserver(g.throttle.observeResponse)
+	defer unregisterResponseObserver()
+
+	// Only a drift report is generated when a state file to check is given
+	if driftStateFile, ok := g.d.GetOk("drift_report_state_file"); ok {
+		driftStatePath, diagErr := expandHomeDir(driftStateFile.(string))
+		if diagErr != nil {
+			return diagErr
+		}
+		return g.generateDriftReport(driftStatePath)
+	}
+
+	// Write a manifest of the export, whether it succeeds or not
+	start := time.Now()
+	defer func() {
+		if manifestErr := g.writeExportManifest(start, diagErr); manifestErr != nil && !diagErr.HasError() {
+			diagErr = manifestErr
+		}
+	}()
+
+	// Step #0 Read the state of the previous export if this is an incremental export
+	diagErr = g.tracePhase("read previous export", g.readPreviousExport)
+	if diagErr != nil {
+		return diagErr
+	}
+
+	// Load the resource types that were already retrieved if a failed export is resumed
+	diagErr = g.checkpoint.load()
+	if diagErr != nil {
+		return diagErr
+	}
+
 	// Step #1 Retrieve the exporters we are have registered and have been requested by the user
-	diagErr = g.retrieveExporters()
+	diagErr = g.tracePhase("retrieve exporters", g.retrieveExporters)
 	if diagErr != nil {
 		return diagErr
 	}
 	// Step #2 Retrieve all the individual resources we are going to export
-	diagErr = g.retrieveSanitizedResourceMaps()
+	diagErr = g.tracePhase("retrieve resource maps", g.retrieveSanitizedResourceMaps)
 	if diagErr != nil {
 		return diagErr
 	}
 
 	// Step #3 Retrieve the individual genesys cloud object instances
-	diagErr = g.retrieveGenesysCloudObjectInstances()
+	diagErr = g.tracePhase("retrieve object instances", func() diag.Diagnostics {
+		return g.retrieveGenesysCloudObjectInstances(g.checkpoint)
+	})
 	if diagErr != nil {
 		return diagErr
 	}
 
 	// Step #4 export dependent resources for the flows
-	diagErr = g.buildAndExportDependsOnResourcesForFlows()
+	diagErr = g.tracePhase("export flow dependencies", g.buildAndExportDependsOnResourcesForFlows)
 	if diagErr != nil {
 		return diagErr
 	}
 
 	// Step #5 Convert the Genesys Cloud resources to neutral format (e.g. map of maps)
-	diagErr = g.buildResourceConfigMap()
+	diagErr = g.tracePhase("build resource config map", g.buildResourceConfigMap)
 	if diagErr != nil {
 		return diagErr
 	}
 
 	// Step #6 export dependents for other resources
-	diagErr = g.buildAndExportDependentResources()
+	diagErr = g.tracePhase("export dependent resources", g.buildAndExportDependentResources)
 	if diagErr != nil {
 		return diagErr
 	}
 
 	// Step #7 Write the terraform state file along with either the HCL or JSON
-	diagErr = g.generateOutputFiles()
+	diagErr = g.tracePhase("generate output files", g.generateOutputFiles)
 	if diagErr != nil {
 		return diagErr
 	}
@@ -237,9 +318,30 @@ func (g *GenesysCloudResourceExporter) Export() (diagErr diag.Diagnostics) {
 	// step #8 Verify the terraform state file with Exporter Resources
 	g.verifyTerraformState()
 
+	// The export finished so there is nothing left to resume
+	diagErr = g.checkpoint.remove()
+	if diagErr != nil {
+		return diagErr
+	}
+
+	g.metrics.logSummary()
 	return nil
 }
 
+// tracePhase runs a phase of the export in a span of its own
+func (g *GenesysCloudResourceExporter) tracePhase(name string, phase func() diag.Diagnostics) diag.Diagnostics {
+	parentCtx := g.ctx
+	var span trace.Span
+	g.ctx, span = tracing.StartSpan(parentCtx, "Export: "+name)
+	defer func() {
+		g.ctx = parentCtx
+	}()
+
+	diagErr := phase()
+	tracing.EndSpan(span, tracing.DiagnosticsError(diagErr))
+	return diagErr
+}
+
 func (g *GenesysCloudResourceExporter) setUpExportDirPath() (diagErr diag.Diagnostics) {
 	log.Printf("Setting up export directory path")
 
@@ -251,6 +353,23 @@ func (g *GenesysCloudResourceExporter) setUpExportDirPath() (diagErr diag.Diagno
 	return nil
 }
 
+// readPreviousExport loads the state file of a previous export so resources that have not changed since then are not read again
+func (g *GenesysCloudResourceExporter) readPreviousExport() diag.Diagnostics {
+	previousExportDir, ok := g.d.GetOk("previous_export_directory")
+	if !ok {
+		return nil
+	}
+
+	previousExportPath, diagErr := expandHomeDir(previousExportDir.(string))
+	if diagErr != nil {
+		return diagErr
+	}
+
+	log.Printf("Running incremental export from previous export %s", previousExportPath)
+	g.previousExport = NewTfStateFileReader(filepath.Join(previousExportPath, defaultTfStateFile))
+	return g.previousExport.readTfStateFile(g.provider)
+}
+
 func (g *GenesysCloudResourceExporter) setupDataSource() {
 	if replaceWithDatasource, ok := g.d.GetOk("replace_with_datasource"); ok {
 		dataSourceList := lists.InterfaceListToStrings(replaceWithDatasource.([]interface{}))
@@ -265,6 +384,9 @@ func (g *GenesysCloudResourceExporter) setupDataSource() {
 func (g *GenesysCloudResourceExporter) retrieveExporters() (diagErr diag.Diagnostics) {
 	log.Printf("Retrieving exporters list")
 	exports := resourceExporter.GetResourceExporters()
+	if diagErr := applyReplacementExporters(exports, lists.InterfaceListToStrings(g.d.Get("replacement_exporters").([]interface{}))); diagErr != nil {
+		return diagErr
+	}
 
 	log.Printf("Retrieving exporters list %v", g.filterList)
 
@@ -283,6 +405,28 @@ func (g *GenesysCloudResourceExporter) retrieveExporters() (diagErr diag.Diagnos
 	return nil
 }
 
+// applyReplacementExporters removes the exporters that replace another exporter unless they are requested, and the
+// exporters they replace if they are
+func applyReplacementExporters(exports map[string]*resourceExporter.ResourceExporter, requested []string) diag.Diagnostics {
+	for _, resourceType := range requested {
+		if exporter, ok := exports[resourceType]; !ok || exporter.Replaces == "" {
+			return diag.Errorf("%s does not replace the exporter of another resource type", resourceType)
+		}
+	}
+	for resourceType, exporter := range exports {
+		if exporter.Replaces == "" {
+			continue
+		}
+		if lists.ItemInSlice(resourceType, requested) {
+			log.Printf("Exporting %s in place of %s", resourceType, exporter.Replaces)
+			delete(exports, exporter.Replaces)
+		} else {
+			delete(exports, resourceType)
+		}
+	}
+	return nil
+}
+
 // Removes the ::resource_label from the resource_types list
 func formatFilter(filter []string) []string {
 	newFilter := make([]string, 0)
@@ -317,7 +461,9 @@ func (g *GenesysCloudResourceExporter) retrieveSanitizedResourceMaps() (diagErr
 	}
 
 	//Retrieve a map of all of the objects we are going to build.  Apply the filter that will remove specific classes of an object
-	diagErr = g.buildSanitizedResourceMaps(*g.exporters, newFilter, g.logPermissionErrors)
+	//Resource types restored from a checkpoint are not listed again
+	exportersToList := g.checkpoint.restoreSanitizedResourceMaps(*g.exporters)
+	diagErr = g.buildSanitizedResourceMaps(exportersToList, newFilter, g.logPermissionErrors)
 	if diagErr != nil {
 		return diagErr
 	}
@@ -330,8 +476,9 @@ func (g *GenesysCloudResourceExporter) retrieveSanitizedResourceMaps() (diagErr
 	return nil
 }
 
-// retrieveGenesysCloudObjectInstances will take a list of exporters and then return the actual terraform Genesys Cloud data
-func (g *GenesysCloudResourceExporter) retrieveGenesysCloudObjectInstances() diag.Diagnostics {
+// retrieveGenesysCloudObjectInstances will take a list of exporters and then return the actual terraform Genesys Cloud data.
+// If a checkpoint is given, resource types found in it are not read again and every other resource type is saved to it.
+func (g *GenesysCloudResourceExporter) retrieveGenesysCloudObjectInstances(checkpoint *exportCheckpoint) diag.Diagnostics {
 	log.Printf("Retrieving Genesys Cloud objects from Genesys Cloud")
 	// Retrieves data on each individual Genesys Cloud object from each registered exporter
 
@@ -347,8 +494,22 @@ func (g *GenesysCloudResourceExporter) retrieveGenesysCloudObjectInstances() dia
 		go func(resType string, exporter *resourceExporter.ResourceExporter) {
 			defer wg.Done()
 
-			log.Printf("Getting exported resources for [%s]", resType)
-			typeResources, err := g.getResourcesForType(resType, g.provider, exporter, g.meta)
+			releaseTypeSlot, waitErr := g.throttle.acquireTypeSlot(ctx)
+			if waitErr != nil {
+				return
+			}
+			defer releaseTypeSlot()
+
+			typeResources, restored, err := checkpoint.getResources(resType, g.provider)
+			if err == nil && !restored {
+				log.Printf("Getting exported resources for [%s]", resType)
+				start := time.Now()
+				typeResources, err = g.getResourcesForType(resType, g.provider, exporter, g.meta)
+				g.metrics.recordTotal(resType, time.Since(start))
+				if err == nil {
+					err = checkpoint.save(resType, typeResources, exporter, g.provider)
+				}
+			}
 
 			if err != nil {
 				select {
@@ -358,7 +519,17 @@ func (g *GenesysCloudResourceExporter) retrieveGenesysCloudObjectInstances() dia
 				cancel()
 				return
 			}
+			g.exMutex.Lock()
 			g.resources = append(g.resources, typeResources...)
+			if restored {
+				// Resources exported as data sources by their ExportAsDataFunc must still be exported as data sources
+				for _, resource := range typeResources {
+					if resource.BlockType == "data" && !g.isDataSource(resType, resource.BlockLabel, resource.OriginalLabel) {
+						g.replaceWithDatasource = append(g.replaceWithDatasource, resType+"::"+resource.BlockLabel)
+					}
+				}
+			}
+			g.exMutex.Unlock()
 		}(resType, exporter)
 	}
 
@@ -384,7 +555,7 @@ func (g *GenesysCloudResourceExporter) buildResourceConfigMap() diag.Diagnostics
 	g.dataSourceTypesMaps = make(map[string]resourceJSONMaps)
 	g.unresolvedAttrs = make([]unresolvableAttributeInfo, 0)
 
-	for _, resource := range g.resources {
+	for i, resource := range g.resources {
 		jsonResult, diagErr := g.instanceStateToMap(resource.State, resource.CtyType)
 		isDataSource := g.isDataSource(resource.Type, resource.BlockLabel, resource.OriginalLabel)
 		if diagErr != nil {
@@ -399,14 +570,12 @@ func (g *GenesysCloudResourceExporter) buildResourceConfigMap() diag.Diagnostics
 			algorithm := fnv.New32()
 			algorithm.Write([]byte(uuid.NewString()))
 			resource.BlockLabel = resource.BlockLabel + "_" + strconv.FormatUint(uint64(algorithm.Sum32()), 10)
+			g.resources[i].BlockLabel = resource.BlockLabel
 			g.updateSanitizeMap(*g.exporters, resource)
 		}
 
 		// Removes zero values and sets proper reference expressions
-		unresolved, _ := g.sanitizeConfigMap(resource, jsonResult, "", *g.exporters, g.includeStateFile, g.exportFormat, true)
-		if len(unresolved) > 0 {
-			g.unresolvedAttrs = append(g.unresolvedAttrs, unresolved...)
-		}
+		unresolved, _ := g.sanitizeConfigMap(resource, jsonResult, "", *g.exporters, g.includeStateFile || g.includeImportBlocks, g.exportFormat, true)
 
 		if isDataSource {
 			if g.dataSourceTypesMaps[resource.Type] == nil {
@@ -415,14 +584,32 @@ func (g *GenesysCloudResourceExporter) buildResourceConfigMap() diag.Diagnostics
 			g.dataSourceTypesMaps[resource.Type][resource.BlockLabel] = jsonResult
 		} else {
 			g.customWriteAttributes(jsonResult, resource)
+			// Files written by the custom file writer can resolve attributes that are variables otherwise
+			unresolved = removeResolvedAttributes(unresolved, jsonResult)
 			g.resourceTypesMaps[resource.Type][resource.BlockLabel] = jsonResult
 		}
+		if len(unresolved) > 0 {
+			g.unresolvedAttrs = append(g.unresolvedAttrs, unresolved...)
+		}
 
 	}
 
 	return nil
 }
 
+// removeResolvedAttributes returns the unresolvable attributes that are still set to a variable in the config map
+func removeResolvedAttributes(unresolved []unresolvableAttributeInfo, configMap util.JsonMap) []unresolvableAttributeInfo {
+	remaining := make([]unresolvableAttributeInfo, 0, len(unresolved))
+	for _, attr := range unresolved {
+		if value, ok := configMap[attr.Name].(string); ok && !strings.HasPrefix(value, "${var.") {
+			log.Printf("Attribute %s of %s.%s was resolved by its custom file writer", attr.Name, attr.ResourceType, attr.ResourceLabel)
+			continue
+		}
+		remaining = append(remaining, attr)
+	}
+	return remaining
+}
+
 func (g *GenesysCloudResourceExporter) customWriteAttributes(jsonResult util.JsonMap,
 	resource resourceExporter.ResourceInfo) {
 	exporters := *g.exporters
@@ -487,7 +674,7 @@ func (g *GenesysCloudResourceExporter) generateOutputFiles() diag.Diagnostics {
 	}
 
 	if g.includeStateFile {
-		t, err := NewTFStateWriter(g.ctx, g.resources, g.d, g.providerRegistry)
+		t, err := NewTFStateWriter(g.ctx, g.resources, g.d, g.providerRegistry, g.exportAsModules)
 		if err != nil {
 			return diag.FromErr(err)
 		}
@@ -496,16 +683,40 @@ func (g *GenesysCloudResourceExporter) generateOutputFiles() diag.Diagnostics {
 		}
 	}
 
+	if g.includeImportBlocks {
+		importBlockWriter := NewImportBlockWriter(g.resources, g.resourceTypesMaps, g.exportDirPath, g.exportAsModules)
+		if g.matchesExportFormat(formatHCL, formatJSONHCL) {
+			if diagErr := importBlockWriter.writeHCLImportBlocks(); diagErr != nil {
+				return diagErr
+			}
+		}
+		if g.matchesExportFormat(formatJSON, formatJSONHCL) {
+			if diagErr := importBlockWriter.writeJSONImportBlocks(); diagErr != nil {
+				return diagErr
+			}
+		}
+	}
+
 	var errDiag diag.Diagnostics
 
-	if g.matchesExportFormat(formatHCL, formatJSONHCL) {
-		hclExporter := NewHClExporter(g.resourceTypesMaps, g.dataSourceTypesMaps, g.unresolvedAttrs, g.providerRegistry, g.version, g.exportDirPath, g.splitFilesByResource)
-		errDiag = hclExporter.exportHCLConfig()
-	}
+	if g.exportAsModules {
+		moduleExporter := NewModuleExporter(g.resourceTypesMaps, g.dataSourceTypesMaps, g.unresolvedAttrs, g.providerRegistry, g.version, g.exportDirPath)
+		if g.matchesExportFormat(formatHCL, formatJSONHCL) {
+			errDiag = moduleExporter.exportHCLModules()
+		}
+		if errDiag == nil && g.matchesExportFormat(formatJSON, formatJSONHCL) {
+			errDiag = moduleExporter.exportJSONModules()
+		}
+	} else {
+		if g.matchesExportFormat(formatHCL, formatJSONHCL) {
+			hclExporter := NewHClExporter(g.resourceTypesMaps, g.dataSourceTypesMaps, g.unresolvedAttrs, g.providerRegistry, g.version, g.exportDirPath, g.splitFilesByResource)
+			errDiag = hclExporter.exportHCLConfig()
+		}
 
-	if g.matchesExportFormat(formatJSON, formatJSONHCL) {
-		jsonExporter := NewJsonExporter(g.resourceTypesMaps, g.dataSourceTypesMaps, g.unresolvedAttrs, g.providerRegistry, g.version, g.exportDirPath, g.splitFilesByResource)
-		errDiag = jsonExporter.exportJSONConfig()
+		if g.matchesExportFormat(formatJSON, formatJSONHCL) {
+			jsonExporter := NewJsonExporter(g.resourceTypesMaps, g.dataSourceTypesMaps, g.unresolvedAttrs, g.providerRegistry, g.version, g.exportDirPath, g.splitFilesByResource)
+			errDiag = jsonExporter.exportJSONConfig()
+		}
 	}
 
 	if errDiag != nil {
@@ -534,6 +745,9 @@ func (g *GenesysCloudResourceExporter) generateZipForExporter() diag.Diagnostics
 		// read all the files
 		var files []fileMeta
 		ferr := filepath.Walk(g.exportDirPath, func(path string, info os.FileInfo, ferr error) error {
+			if info.IsDir() && info.Name() == checkpointDirName {
+				return filepath.SkipDir
+			}
 			files = append(files, fileMeta{Path: path, IsDir: info.IsDir()})
 			return nil
 		})
@@ -657,7 +871,7 @@ func (g *GenesysCloudResourceExporter) rebuildExports(filterList []string) (diag
 		return diagErr
 	}
 
-	diagErr = g.retrieveGenesysCloudObjectInstances()
+	diagErr = g.retrieveGenesysCloudObjectInstances(nil)
 	if diagErr != nil {
 		return diagErr
 	}
@@ -906,10 +1120,24 @@ func (g *GenesysCloudResourceExporter) buildSanitizedResourceMaps(exporters map[
 		wg.Add(1)
 		go func(resourceType string, exporter *resourceExporter.ResourceExporter) {
 			defer wg.Done()
+
+			releaseTypeSlot, waitErr := g.throttle.acquireTypeSlot(ctx)
+			if waitErr != nil {
+				return
+			}
+			defer releaseTypeSlot()
+			releaseRead, waitErr := g.throttle.acquireRead(ctx)
+			if waitErr != nil {
+				return
+			}
+
 			log.Printf("Getting all resources for type %s", resourceType)
-			exporter.FilterResource = g.resourceFilter
+			exporter.FilterResource = g.recordFilteredResources(g.resourceFilter)
 
+			start := time.Now()
 			err := exporter.LoadSanitizedResourceMap(ctx, resourceType, filter)
+			releaseRead()
+			g.metrics.recordList(resourceType, time.Since(start))
 
 			// Used in tests
 			if mockError != nil {
@@ -918,6 +1146,7 @@ func (g *GenesysCloudResourceExporter) buildSanitizedResourceMaps(exporters map[
 			if containsPermissionsErrorOnly(err) && logErrors {
 				log.Printf("%v", err[0].Summary)
 				log.Printf("Logging permission error for %s. Resuming export...", resourceType)
+				g.metrics.recordSkippedType(resourceType, skipReasonPermission, err[0].Summary)
 				return
 			}
 			if err != nil {
@@ -950,6 +1179,27 @@ func (g *GenesysCloudResourceExporter) buildSanitizedResourceMaps(exporters map[
 	}
 }
 
+// recordFilteredResources wraps a resource filter to record the resources removed by it in the export metrics
+func (g *GenesysCloudResourceExporter) recordFilteredResources(resourceFilter ExporterResourceFilter) ExporterResourceFilter {
+	if resourceFilter == nil {
+		return nil
+	}
+	return func(resourceIdMetaMap resourceExporter.ResourceIDMetaMap, resourceType string, filter []string) resourceExporter.ResourceIDMetaMap {
+		// Filters may remove resources from the map they are given
+		listed := make(resourceExporter.ResourceIDMetaMap, len(resourceIdMetaMap))
+		for id, meta := range resourceIdMetaMap {
+			listed[id] = meta
+		}
+		result := resourceFilter(resourceIdMetaMap, resourceType, filter)
+		for id, meta := range listed {
+			if _, ok := result[id]; !ok {
+				g.metrics.recordSkippedResource(resourceType, id, meta.BlockLabel, skipReasonFilter)
+			}
+		}
+		return result
+	}
+}
+
 func mergeExporters(m1, m2 map[string]*resourceExporter.ResourceExporter) *map[string]*resourceExporter.ResourceExporter {
 	result := make(map[string]*resourceExporter.ResourceExporter)
 
@@ -1046,7 +1296,26 @@ func (g *GenesysCloudResourceExporter) getResourcesForType(resType string, schem
 				// This calls into the resource's ReadContext method which
 				// will block until it can acquire a pooled client config object.
 				ctyType := res.CoreConfigSchema().ImpliedType()
-				instanceState, err := getResourceState(ctx, res, id, resMeta, meta)
+				instanceState := g.getUnchangedResourceState(resType, id, resMeta)
+
+				var err diag.Diagnostics
+				if instanceState == nil {
+					releaseRead, waitErr := g.throttle.acquireRead(ctx)
+					if waitErr != nil {
+						return fmt.Errorf("Failed to wait for a read of %s instance %s: %v", resType, id, waitErr)
+					}
+					start := time.Now()
+					instanceState, err = getResourceState(ctx, res, id, resMeta, meta)
+					releaseRead()
+					g.metrics.recordRead(resType, time.Since(start))
+				}
+
+				if containsPermissionsErrorOnly(err) && g.logPermissionErrors {
+					log.Printf("Logging permission error for %s instance %s. Resuming export...: %v", resType, id, err)
+					g.metrics.recordSkippedResource(resType, id, resMeta.BlockLabel, skipReasonPermission)
+					removeChan <- id // Mark for removal from the map
+					return nil
+				}
 
 				if err != nil {
 					log.Printf("Error while fetching read context type %s and instance %s : %v", resType, id, err)
@@ -1056,10 +1325,19 @@ func (g *GenesysCloudResourceExporter) getResourcesForType(resType string, schem
 
 				if instanceState == nil {
 					log.Printf("Resource %s no longer exists. Skipping.", resMeta.BlockLabel)
+					g.metrics.recordSkippedResource(resType, id, resMeta.BlockLabel, skipReasonNotFound)
 					removeChan <- id // Mark for removal from the map
 					return nil
 				}
 
+				// Keep track of the version so a later incremental export can tell if the resource changed
+				if resMeta.Version != "" {
+					if instanceState.Meta == nil {
+						instanceState.Meta = make(map[string]interface{})
+					}
+					instanceState.Meta[exportVersionMetaKey] = resMeta.Version
+				}
+
 				// Export the resource as a data resource
 				if exporter.ExportAsDataFunc != nil {
 					sdkConfig := g.meta.(*provider.ProviderMeta).ClientConfig
@@ -1115,6 +1393,7 @@ func (g *GenesysCloudResourceExporter) getResourcesForType(resType string, schem
 					CtyType:       ctyType,
 					BlockType:     blockType,
 					OriginalLabel: resMeta.OriginalLabel,
+					ImportId:      resMeta.IdPrefix + id,
 				}
 
 				return nil
@@ -1164,6 +1443,19 @@ func (g *GenesysCloudResourceExporter) getResourcesForType(resType string, schem
 	}
 }
 
+// getUnchangedResourceState returns the state of the resource from the previous export when running an incremental export
+// and the resource did not change since then. Otherwise, nil is returned and the resource needs to be read.
+func (g *GenesysCloudResourceExporter) getUnchangedResourceState(resType, resID string, resMeta *resourceExporter.ResourceMeta) *terraform.InstanceState {
+	if g.previousExport == nil {
+		return nil
+	}
+	state := g.previousExport.getUnchangedState(resType, resMeta.IdPrefix+resID, resMeta.Version)
+	if state != nil {
+		log.Printf("Resource %s::%s has not changed since the previous export. Skipping read.", resType, resMeta.BlockLabel)
+	}
+	return state
+}
+
 func getResourceState(ctx context.Context, resource *schema.Resource, resID string, resMeta *resourceExporter.ResourceMeta, meta interface{}) (*terraform.InstanceState, diag.Diagnostics) {
 	// If defined, pass the full ID through the import method to generate a readable state
 	instanceState := &terraform.InstanceState{ID: resMeta.IdPrefix + resID}
@@ -1354,13 +1646,19 @@ func (g *GenesysCloudResourceExporter) sanitizeConfigMap(
 			g.resolveValueToDataSource(exporter, configMap, currAttr, val)
 		}
 
-		if attr, ok := attrInUnResolvableAttrs(key, exporter.UnResolvableAttributes); ok {
+		attr, ok := attrInUnResolvableAttrs(key, exporter.UnResolvableAttributes)
+		if !ok && prevAttr == "" {
+			attr, ok = g.getSensitiveAttribute(resourceType, key, val)
+		}
+		if ok {
 			varReference := fmt.Sprintf("%s_%s_%s", resourceType, resourceLabel, key)
 			unresolvableAttrs = append(unresolvableAttrs, unresolvableAttributeInfo{
 				ResourceType:  resourceType,
 				ResourceLabel: resourceLabel,
 				Name:          key,
 				Schema:        attr,
+				Sensitive:     g.sensitiveAsVariables && attr.Sensitive,
+				ExampleValue:  getSensitiveExampleValue(attr, val),
 			})
 			if properties, ok := attr.Elem.(*schema.Resource); ok {
 				propertiesMap := make(map[string]interface{})
@@ -1462,6 +1760,120 @@ func (g *GenesysCloudResourceExporter) resolveValueToDataSource(exporter *resour
 	g.dataSourceTypesMaps[dataSourceType][dataSourceLabel] = dataSourceConfig
 }
 
+// resolveReferenceToDataSource adds a referenced resource that is not part of the export to the export as a data source
+// and returns a reference to the data source. An empty string is returned if the resource cannot be exported as a data source.
+func (g *GenesysCloudResourceExporter) resolveReferenceToDataSource(refType string, refID string) string {
+	if g.referencedDataSources == nil {
+		g.referencedDataSources = make(map[string]*referencedDataSource)
+	}
+
+	key := refType + "." + refID
+	dataSource, ok := g.referencedDataSources[key]
+	if !ok {
+		dataSource = g.buildReferencedDataSource(refType, refID)
+		g.referencedDataSources[key] = dataSource
+	}
+	if dataSource == nil {
+		return ""
+	}
+
+	if g.dataSourceTypesMaps[refType] == nil {
+		g.dataSourceTypesMaps[refType] = make(resourceJSONMaps)
+	}
+	g.dataSourceTypesMaps[refType][dataSource.label] = dataSource.config
+	return fmt.Sprintf("${data.%s.%s.id}", refType, dataSource.label)
+}
+
+// buildReferencedDataSource reads a referenced resource and builds the config of its data source using the
+// DataSourceResolver of the resource's exporter. Nil is returned if a required attribute of the data source cannot be set.
+func (g *GenesysCloudResourceExporter) buildReferencedDataSource(refType string, refID string) *referencedDataSource {
+	res := g.provider.ResourcesMap[refType]
+	dataSourceSchema := g.provider.DataSourcesMap[refType]
+	if res == nil || dataSourceSchema == nil {
+		return nil
+	}
+
+	exporter := resourceExporter.GetResourceExporters()[refType]
+	if exporter == nil {
+		exporter = &resourceExporter.ResourceExporter{}
+	}
+
+	instanceState, err := getResourceState(g.ctx, res, refID, &resourceExporter.ResourceMeta{}, g.meta)
+	if err != nil || instanceState == nil {
+		log.Printf("Unable to read %s %s to export it as a data source: %v", refType, refID, err)
+		return nil
+	}
+
+	config := make(util.JsonMap)
+	for attr, attrSchema := range dataSourceSchema.SchemaMap() {
+		if attrSchema.Type != schema.TypeString || (!attrSchema.Required && !attrSchema.Optional) {
+			continue
+		}
+		if _, val := exporter.DataResolver(instanceState, attr); val != "" {
+			config[attr] = val
+		} else if attrSchema.Required {
+			log.Printf("Unable to export %s %s as a data source. Required attribute %s has no value.", refType, refID, attr)
+			return nil
+		}
+	}
+
+	blockLabel := refID
+	if name, ok := config["name"].(string); ok && name != "" {
+		blockLabel = name
+	}
+	blockLabel = resourceExporter.NewSanitizerProvider().S.SanitizeResourceBlockLabel(blockLabel)
+	if _, exists := g.dataSourceTypesMaps[refType][blockLabel]; exists {
+		algorithm := fnv.New32()
+		algorithm.Write([]byte(refID))
+		blockLabel = blockLabel + "_" + strconv.FormatUint(uint64(algorithm.Sum32()), 10)
+	}
+
+	log.Printf("Exporting referenced %s %s as data source %s", refType, refID, blockLabel)
+	return &referencedDataSource{
+		label:  blockLabel,
+		config: config,
+	}
+}
+
+// getSensitiveAttribute returns the schema of a top-level attribute if it is sensitive and has a value, so the value can be
+// replaced with a variable. Sensitive attributes are only replaced if export_sensitive_as_variables is set.
+func (g *GenesysCloudResourceExporter) getSensitiveAttribute(resourceType string, attribute string, value interface{}) (*schema.Schema, bool) {
+	if !g.sensitiveAsVariables || g.provider == nil {
+		return nil, false
+	}
+	res := g.provider.ResourcesMap[resourceType]
+	if res == nil {
+		return nil, false
+	}
+	attrSchema, ok := res.Schema[attribute]
+	if !ok || !attrSchema.Sensitive {
+		return nil, false
+	}
+	if value == nil || reflect.ValueOf(value).IsZero() {
+		return nil, false
+	}
+	if m, ok := value.(map[string]interface{}); ok && len(m) == 0 {
+		return nil, false
+	}
+	return attrSchema, true
+}
+
+// getSensitiveExampleValue returns the example value of a sensitive attribute. The keys of a map are kept so users know
+// which values to provide, but the values themselves are never written.
+func getSensitiveExampleValue(attrSchema *schema.Schema, value interface{}) interface{} {
+	if !attrSchema.Sensitive {
+		return nil
+	}
+	if m, ok := value.(map[string]interface{}); ok && len(m) > 0 {
+		example := make(map[string]interface{}, len(m))
+		for k := range m {
+			example[k] = ""
+		}
+		return example
+	}
+	return nil
+}
+
 func attrInUnResolvableAttrs(a string, myMap map[string]*schema.Schema) (*schema.Schema, bool) {
 	for k, v := range myMap {
 		if k == a {
@@ -1615,6 +2027,13 @@ func (g *GenesysCloudResourceExporter) resolveReference(refSettings *resourceExp
 			}
 		}
 	}
+	// Dependency resolution exports the referenced resources themselves
+	if g.referencePolicy == referencePolicyDataSource && !g.addDependsOn {
+		if reference := g.resolveReferenceToDataSource(refSettings.RefType, refID); reference != "" {
+			return reference
+		}
+	}
+
 	if g.buildSecondDeps == nil || len(g.buildSecondDeps) == 0 {
 		g.buildSecondDeps = make(map[string][]string)
 	}
@@ -1634,6 +2053,8 @@ func (g *GenesysCloudResourceExporter) resolveReference(refSettings *resourceExp
 		g.buildSecondDeps[refSettings.RefType] = []string{refID}
 	}
 
+	g.metrics.recordUnresolvedReference(refSettings.RefType, refID)
+
 	if exportingState {
 		// Don't remove unmatched IDs when exporting state. This will keep existing config in an org
 		return refID
@@ -3,7 +3,11 @@ package tfexporter
 import (
 	"context"
 	"fmt"
+	"os"
+	"path/filepath"
 	"reflect"
+	"terraform-provider-genesyscloud/genesyscloud/architect_datatable_row"
+	"terraform-provider-genesyscloud/genesyscloud/architect_datatable_rows"
 	"terraform-provider-genesyscloud/genesyscloud/provider"
 	resourceExporter "terraform-provider-genesyscloud/genesyscloud/resource_exporter"
 	"terraform-provider-genesyscloud/genesyscloud/util"
@@ -369,6 +373,7 @@ func TestUnitTfExportBuildDependsOnResources(t *testing.T) {
 	}
 
 	dependentconsumers.InternalProxy = dependencyProxy
+	defer func() { dependentconsumers.InternalProxy = nil }()
 	ctx := context.Background()
 
 	gre := &GenesysCloudResourceExporter{
@@ -505,6 +510,40 @@ func TestUnitTfExportMergeExporters(t *testing.T) {
 	}
 }
 
+func TestUnitApplyReplacementExporters(t *testing.T) {
+	newExporters := func() map[string]*resourceExporter.ResourceExporter {
+		return map[string]*resourceExporter.ResourceExporter{
+			architect_datatable_row.ResourceType:  architect_datatable_row.ArchitectDatatableRowExporter(),
+			architect_datatable_rows.ResourceType: architect_datatable_rows.ArchitectDatatableRowsExporter(),
+			"genesyscloud_architect_datatable":    {},
+		}
+	}
+
+	// Rows are exported one resource per row by default, never by both exporters
+	exporters := newExporters()
+	if diagErr := applyReplacementExporters(exporters, nil); diagErr != nil {
+		t.Fatalf("Unexpected error: %v", diagErr)
+	}
+	assert.Contains(t, exporters, architect_datatable_row.ResourceType)
+	assert.NotContains(t, exporters, architect_datatable_rows.ResourceType)
+	assert.Contains(t, exporters, "genesyscloud_architect_datatable")
+
+	// Requesting the rows exporter swaps it in place of the row exporter
+	exporters = newExporters()
+	if diagErr := applyReplacementExporters(exporters, []string{architect_datatable_rows.ResourceType}); diagErr != nil {
+		t.Fatalf("Unexpected error: %v", diagErr)
+	}
+	assert.NotContains(t, exporters, architect_datatable_row.ResourceType)
+	assert.Contains(t, exporters, architect_datatable_rows.ResourceType)
+	assert.Contains(t, exporters, "genesyscloud_architect_datatable")
+
+	// Only exporters that replace another one can be requested
+	exporters = newExporters()
+	if diagErr := applyReplacementExporters(exporters, []string{architect_datatable_row.ResourceType}); diagErr == nil {
+		t.Errorf("Expected an error for an exporter that does not replace another one")
+	}
+}
+
 func TestUnitResolveValueToDataSource(t *testing.T) {
 	var (
 		originalValueOfScriptId            = "1234"
@@ -568,6 +607,55 @@ func TestUnitResolveValueToDataSource(t *testing.T) {
 	}
 }
 
+func TestUnitResolveReferenceToDataSource(t *testing.T) {
+	var (
+		refType     = "genesyscloud_test_reference"
+		refID       = "5678"
+		refName     = "Referenced Queue"
+		refLabel    = "Referenced_Queue"
+		readCount   = 0
+		refSettings = &resourceExporter.RefAttrSettings{RefType: refType}
+	)
+
+	g := setupGenesysCloudResourceExporter(t)
+	g.referencePolicy = referencePolicyDataSource
+	g.provider = &schema.Provider{
+		ResourcesMap: map[string]*schema.Resource{
+			refType: {
+				Schema: map[string]*schema.Schema{
+					"name":        {Type: schema.TypeString, Required: true},
+					"description": {Type: schema.TypeString, Optional: true},
+				},
+				ReadContext: func(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+					readCount++
+					_ = d.Set("name", refName)
+					_ = d.Set("description", "not in the data source")
+					return nil
+				},
+			},
+		},
+		DataSourcesMap: map[string]*schema.Resource{
+			refType: {
+				Schema: map[string]*schema.Schema{
+					"name": {Type: schema.TypeString, Required: true},
+				},
+			},
+		},
+	}
+
+	expectedReference := fmt.Sprintf("${data.%s.%s.id}", refType, refLabel)
+	assert.Equal(t, expectedReference, g.resolveReference(refSettings, refID, map[string]*resourceExporter.ResourceExporter{}, false))
+	assert.Equal(t, util.JsonMap{"name": refName}, g.dataSourceTypesMaps[refType][refLabel])
+
+	// The referenced resource is only read once
+	assert.Equal(t, expectedReference, g.resolveReference(refSettings, refID, map[string]*resourceExporter.ResourceExporter{}, false))
+	assert.Equal(t, 1, readCount)
+
+	// References are removed as before with the default policy
+	g.referencePolicy = referencePolicyDefault
+	assert.Equal(t, "", g.resolveReference(refSettings, "9999", map[string]*resourceExporter.ResourceExporter{}, false))
+}
+
 func setupGenesysCloudResourceExporter(t *testing.T) *GenesysCloudResourceExporter {
 	exportMap := map[string]interface{}{
 		"export_format":                "json",
@@ -846,6 +934,54 @@ func TestGetResourceStateRemovesComputedAttributes(t *testing.T) {
 	}
 }
 
+func TestUnitGetResourcesForTypeLogsPermissionErrors(t *testing.T) {
+	const mockResourceType = "test_resource"
+	mockProvider := &schema.Provider{
+		ResourcesMap: map[string]*schema.Resource{
+			mockResourceType: {
+				Schema: map[string]*schema.Schema{
+					"name": {Type: schema.TypeString, Optional: true},
+				},
+				ReadContext: func(_ context.Context, d *schema.ResourceData, _ interface{}) diag.Diagnostics {
+					if d.Id() == "forbidden-id" {
+						return diag.Errorf("Failed to read resource %s | error: API Error: 403 - Missing permission", d.Id())
+					}
+					_ = d.Set("name", "allowed")
+					return nil
+				},
+			},
+		},
+	}
+	newExporter := func() *resourceExporter.ResourceExporter {
+		return &resourceExporter.ResourceExporter{
+			SanitizedResourceMap: resourceExporter.ResourceIDMetaMap{
+				"allowed-id":   {BlockLabel: "allowed"},
+				"forbidden-id": {BlockLabel: "forbidden"},
+			},
+		}
+	}
+	providerMeta := &provider.ProviderMeta{ClientConfig: &platformclientv2.Configuration{}}
+
+	// Without log_permission_errors the export fails
+	exporter := &GenesysCloudResourceExporter{meta: providerMeta, ctx: context.Background()}
+	if _, diags := exporter.getResourcesForType(mockResourceType, mockProvider, newExporter(), providerMeta); diags == nil {
+		t.Error("Expected the permission error to fail the export")
+	}
+
+	// With log_permission_errors the instance is skipped and recorded for the manifest
+	exporter = &GenesysCloudResourceExporter{meta: providerMeta, ctx: context.Background(), logPermissionErrors: true, metrics: newExportMetrics()}
+	mockExporter := newExporter()
+	resources, diags := exporter.getResourcesForType(mockResourceType, mockProvider, mockExporter, providerMeta)
+	if diags != nil {
+		t.Fatalf("Unexpected error: %v", diags)
+	}
+	assert.Len(t, resources, 1)
+	assert.Equal(t, "allowed", resources[0].BlockLabel)
+	assert.NotContains(t, mockExporter.SanitizedResourceMap, "forbidden-id")
+	assert.Equal(t, skippedResource{id: "forbidden-id", label: "forbidden", reason: skipReasonPermission},
+		exporter.metrics.getTypeMetrics(mockResourceType).skipped["forbidden-id"])
+}
+
 func TestUnitMatchesFormat(t *testing.T) {
 	tests := []struct {
 		name         string
@@ -921,3 +1057,100 @@ func TestUnitMatchesFormat(t *testing.T) {
 		})
 	}
 }
+
+func TestUnitTfExportSensitiveAsVariables(t *testing.T) {
+	testResourceType := "test_sensitive_resource"
+	testResourceLabel := "test_res_label"
+
+	testResource := &schema.Resource{
+		Schema: map[string]*schema.Schema{
+			"name":     {Type: schema.TypeString, Required: true},
+			"password": {Type: schema.TypeString, Optional: true, Sensitive: true},
+			"token":    {Type: schema.TypeString, Optional: true, Sensitive: true},
+			"fields": {
+				Type:      schema.TypeMap,
+				Optional:  true,
+				Sensitive: true,
+				Elem:      &schema.Schema{Type: schema.TypeString},
+			},
+		},
+	}
+
+	testResourceExporter := GenesysCloudResourceExporter{
+		exportFormat:         "hcl",
+		sensitiveAsVariables: true,
+		provider: &schema.Provider{
+			ResourcesMap: map[string]*schema.Resource{testResourceType: testResource},
+		},
+		exporters: &map[string]*resourceExporter.ResourceExporter{
+			testResourceType: {},
+		},
+		resources: []resourceExporter.ResourceInfo{
+			{
+				BlockLabel: testResourceLabel,
+				Type:       testResourceType,
+				State: &terraform.InstanceState{
+					ID: "test_id",
+					Attributes: map[string]string{
+						"name":            "test",
+						"password":        "secret",
+						"fields.%":        "2",
+						"fields.clientId": "id",
+						"fields.secret":   "secret",
+					},
+				},
+				CtyType: testResource.CoreConfigSchema().ImpliedType(),
+			},
+		},
+	}
+
+	diagErr := testResourceExporter.buildResourceConfigMap()
+	if diagErr != nil {
+		t.Fatalf("failure: %v", diagErr)
+	}
+
+	configMap := testResourceExporter.resourceTypesMaps[testResourceType][testResourceLabel]
+	assert.Equal(t, "test", configMap["name"])
+	assert.Equal(t, "${var.test_sensitive_resource_test_res_label_password}", configMap["password"])
+	assert.Equal(t, "${var.test_sensitive_resource_test_res_label_fields}", configMap["fields"])
+	// Sensitive attributes without a value are not replaced with a variable
+	assert.Nil(t, configMap["token"])
+
+	assert.Len(t, testResourceExporter.unresolvedAttrs, 2)
+	for _, attr := range testResourceExporter.unresolvedAttrs {
+		assert.True(t, attr.Sensitive)
+	}
+
+	dir := t.TempDir()
+	if diagErr := writeUnresolvedAttrsTfVars(testResourceExporter.unresolvedAttrs, dir); diagErr != nil {
+		t.Fatalf("Failed to write tfvars: %v", diagErr)
+	}
+	if _, err := os.Stat(filepath.Join(dir, defaultTfVarsFile)); !os.IsNotExist(err) {
+		t.Errorf("Expected no %s file for sensitive variables", defaultTfVarsFile)
+	}
+	example, err := os.ReadFile(filepath.Join(dir, defaultTfVarsExampleFile))
+	if err != nil {
+		t.Fatalf("Failed to read %s: %v", defaultTfVarsExampleFile, err)
+	}
+	assert.Contains(t, string(example), "test_sensitive_resource_test_res_label_password = \"\"")
+	assert.Contains(t, string(example), "clientId = \"\"")
+	assert.NotContains(t, string(example), "secret\"")
+}
+
+func TestUnitRemoveResolvedAttributes(t *testing.T) {
+	unresolved := []unresolvableAttributeInfo{
+		{ResourceType: "genesyscloud_flow", ResourceLabel: "published", Name: "filepath"},
+		{ResourceType: "genesyscloud_flow", ResourceLabel: "unpublished", Name: "filepath"},
+		{ResourceType: "genesyscloud_script", ResourceLabel: "script", Name: "filepath"},
+	}
+
+	// A custom file writer replaced the variable of the published flow with the path of the exported file
+	remaining := removeResolvedAttributes(unresolved[:1], util.JsonMap{"filepath": "flows/published.yaml"})
+	assert.Empty(t, remaining)
+
+	remaining = removeResolvedAttributes(unresolved[1:2], util.JsonMap{"filepath": "${var.genesyscloud_flow_unpublished_filepath}"})
+	assert.Equal(t, unresolved[1:2], remaining)
+
+	remaining = removeResolvedAttributes(unresolved[2:], util.JsonMap{})
+	assert.Equal(t, unresolved[2:], remaining)
+}
@@ -46,39 +46,7 @@ func (h *HCLExporter) exportHCLConfig() diag.Diagnostics {
 	providerBlock := createHCLProviderBlock(h.providerRegistry, h.version)
 	variablesBlock := createHCLVariablesBlock(h.unresolvedAttrs)
 
-	hclBlocks := make(map[string][][]byte, 0)
-
-	// Data resources
-	for resDataType, dataJSONMap := range h.dataSourceTypesMaps {
-
-		// Output the data resources in a sorted fashion
-		blockLabels := make([]string, 0)
-		for resDataLabel, _ := range dataJSONMap {
-			blockLabels = append(blockLabels, resDataLabel)
-		}
-		sort.Strings(blockLabels)
-		for _, blockLabel := range blockLabels {
-			resDataJson := dataJSONMap[blockLabel]
-			hclBlock := instanceStateToHCLBlock(resDataType, blockLabel, resDataJson, true)
-			hclBlocks[resDataType] = append(hclBlocks[resDataType], hclBlock)
-		}
-	}
-
-	// Resources
-	for resType, resJSONMap := range h.resourceTypesJSONMaps {
-
-		// Output the resources in a sorted fashion
-		blockLabels := make([]string, 0)
-		for resLabel, _ := range resJSONMap {
-			blockLabels = append(blockLabels, resLabel)
-		}
-		sort.Strings(blockLabels)
-		for _, resLabel := range blockLabels {
-			resJson := resJSONMap[resLabel]
-			hclBlock := instanceStateToHCLBlock(resType, resLabel, resJson, false)
-			hclBlocks[resType] = append(hclBlocks[resType], hclBlock)
-		}
-	}
+	hclBlocks := buildHCLBlocks(h.resourceTypesJSONMaps, h.dataSourceTypesMaps)
 
 	if h.splitFilesByResource {
 		// Provider file
@@ -139,28 +107,51 @@ func (h *HCLExporter) exportHCLConfig() diag.Diagnostics {
 
 	// Optional tfvars file creation for unresolved attributes
 	if len(h.unresolvedAttrs) > 0 {
-		tfVars := make(map[string]interface{})
-		keys := make(map[string]string)
-		for _, attr := range h.unresolvedAttrs {
-			key := createUnresolvedAttrKey(attr)
-			if keys[key] != "" {
-				continue
-			}
-			keys[key] = key
+		if diagErr := writeUnresolvedAttrsTfVars(h.unresolvedAttrs, h.dirPath); diagErr != nil {
+			return diagErr
+		}
+	}
+
+	return nil
+}
+
+// buildHCLBlocks converts the resources and data sources to HCL blocks grouped by type. Blocks are sorted by label.
+func buildHCLBlocks(resourceTypesJSONMaps map[string]resourceJSONMaps, dataSourceTypesMaps map[string]resourceJSONMaps) map[string][][]byte {
+	hclBlocks := make(map[string][][]byte, 0)
+
+	// Data resources
+	for resDataType, dataJSONMap := range dataSourceTypesMaps {
 
-			tfVars[key] = determineVarValue(attr.Schema)
+		// Output the data resources in a sorted fashion
+		blockLabels := make([]string, 0)
+		for resDataLabel, _ := range dataJSONMap {
+			blockLabels = append(blockLabels, resDataLabel)
 		}
+		sort.Strings(blockLabels)
+		for _, blockLabel := range blockLabels {
+			resDataJson := dataJSONMap[blockLabel]
+			hclBlock := instanceStateToHCLBlock(resDataType, blockLabel, resDataJson, true)
+			hclBlocks[resDataType] = append(hclBlocks[resDataType], hclBlock)
+		}
+	}
+
+	// Resources
+	for resType, resJSONMap := range resourceTypesJSONMaps {
 
-		tfVarsFilePath := filepath.Join(h.dirPath, defaultTfVarsFile)
-		if tfVarsFilePath == "" {
-			return diag.Errorf("Failed to create tfvars file path %s", tfVarsFilePath)
+		// Output the resources in a sorted fashion
+		blockLabels := make([]string, 0)
+		for resLabel, _ := range resJSONMap {
+			blockLabels = append(blockLabels, resLabel)
 		}
-		if diagErr := writeTfVars(tfVars, tfVarsFilePath); diagErr != nil {
-			return diagErr
+		sort.Strings(blockLabels)
+		for _, resLabel := range blockLabels {
+			resJson := resJSONMap[resLabel]
+			hclBlock := instanceStateToHCLBlock(resType, resLabel, resJson, false)
+			hclBlocks[resType] = append(hclBlocks[resType], hclBlock)
 		}
 	}
 
-	return nil
+	return hclBlocks
 }
 
 // Create the  HCL block for terraform and the genesyscloud provider
@@ -0,0 +1,115 @@
+package tfexporter
+
+import (
+	"log"
+	"path/filepath"
+	"sort"
+	resourceExporter "terraform-provider-genesyscloud/genesyscloud/resource_exporter"
+
+	"github.com/hashicorp/hcl/v2/hclwrite"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	zclconfCty "github.com/zclconf/go-cty/cty"
+)
+
+/*
+This file contains all of the functions used to write the Terraform import blocks of an export. Import blocks (Terraform 1.5+)
+are an alternative to the generated state file: the exported resources are adopted by the backend of the configuration
+the next time 'terraform apply' is run.
+*/
+
+type importBlock struct {
+	moduleName    string
+	resourceType  string
+	resourceLabel string
+	importId      string
+}
+
+// getAddress returns the address of the imported resource, e.g. module.routing.genesyscloud_routing_queue.support
+func (b importBlock) getAddress() string {
+	address := b.resourceType + "." + b.resourceLabel
+	if b.moduleName != "" {
+		address = "module." + b.moduleName + "." + address
+	}
+	return address
+}
+
+type ImportBlockWriter struct {
+	resources         []resourceExporter.ResourceInfo
+	resourceTypesMaps map[string]resourceJSONMaps
+	dirPath           string
+	exportAsModules   bool
+}
+
+func NewImportBlockWriter(resources []resourceExporter.ResourceInfo, resourceTypesMaps map[string]resourceJSONMaps, dirPath string, exportAsModules bool) *ImportBlockWriter {
+	return &ImportBlockWriter{
+		resources:         resources,
+		resourceTypesMaps: resourceTypesMaps,
+		dirPath:           dirPath,
+		exportAsModules:   exportAsModules,
+	}
+}
+
+// getImportBlocks returns an import block for each exported resource sorted by resource type and label. Resources exported
+// as data sources are not imported.
+func (w *ImportBlockWriter) getImportBlocks() []importBlock {
+	blocks := make([]importBlock, 0, len(w.resources))
+	for _, resource := range w.resources {
+		if resource.BlockType == "data" || resource.State == nil {
+			continue
+		}
+		if _, ok := w.resourceTypesMaps[resource.Type][resource.BlockLabel]; !ok {
+			continue
+		}
+		importId := resource.ImportId
+		if importId == "" {
+			importId = resource.State.ID
+		}
+		moduleName := ""
+		if w.exportAsModules {
+			moduleName = getModuleName(resource.Type)
+		}
+		blocks = append(blocks, importBlock{
+			moduleName:    moduleName,
+			resourceType:  resource.Type,
+			resourceLabel: resource.BlockLabel,
+			importId:      importId,
+		})
+	}
+	sort.Slice(blocks, func(i, j int) bool {
+		if blocks[i].resourceType != blocks[j].resourceType {
+			return blocks[i].resourceType < blocks[j].resourceType
+		}
+		return blocks[i].resourceLabel < blocks[j].resourceLabel
+	})
+	return blocks
+}
+
+func (w *ImportBlockWriter) writeHCLImportBlocks() diag.Diagnostics {
+	f := hclwrite.NewEmptyFile()
+	rootBody := f.Body()
+	for i, block := range w.getImportBlocks() {
+		if i > 0 {
+			rootBody.AppendNewline()
+		}
+		importBody := rootBody.AppendNewBlock("import", nil).Body()
+		importBody.SetAttributeTraversal("to", parseTraversal(block.getAddress()))
+		importBody.SetAttributeValue("id", zclconfCty.StringVal(block.importId))
+	}
+
+	path := filepath.Join(w.dirPath, defaultTfHCLImportFile)
+	log.Printf("Writing export import blocks to %s", path)
+	return writeHCLToFile([][]byte{f.Bytes()}, path)
+}
+
+func (w *ImportBlockWriter) writeJSONImportBlocks() diag.Diagnostics {
+	importBlocks := make([]interface{}, 0)
+	for _, block := range w.getImportBlocks() {
+		importBlocks = append(importBlocks, map[string]interface{}{
+			"to": block.getAddress(),
+			"id": block.importId,
+		})
+	}
+
+	path := filepath.Join(w.dirPath, defaultTfJSONImportFile)
+	return writeConfig(map[string]interface{}{"import": importBlocks}, path)
+}
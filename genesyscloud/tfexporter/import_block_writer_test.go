@@ -0,0 +1,94 @@
+package tfexporter
+
+import (
+	"encoding/json"
+	"os"
+	"path/filepath"
+	resourceExporter "terraform-provider-genesyscloud/genesyscloud/resource_exporter"
+	"terraform-provider-genesyscloud/genesyscloud/util"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestUnitImportBlockWriter(t *testing.T) {
+	resources := []resourceExporter.ResourceInfo{
+		{
+			Type:       "genesyscloud_routing_queue",
+			BlockLabel: "queue_b",
+			State:      &terraform.InstanceState{ID: "queue-id-b"},
+			ImportId:   "queue-id-b",
+		},
+		{
+			Type:       "genesyscloud_routing_email_route",
+			BlockLabel: "route",
+			State:      &terraform.InstanceState{ID: "route-id"},
+			ImportId:   "domain-id/route-id",
+		},
+		{
+			Type:       "genesyscloud_routing_queue",
+			BlockLabel: "queue_a",
+			State:      &terraform.InstanceState{ID: "queue-id-a"},
+		},
+		{
+			Type:       "genesyscloud_auth_division",
+			BlockLabel: "home",
+			BlockType:  "data",
+			State:      &terraform.InstanceState{ID: "division-id"},
+		},
+	}
+	resourceTypesMaps := map[string]resourceJSONMaps{
+		"genesyscloud_routing_queue": {
+			"queue_a": util.JsonMap{},
+			"queue_b": util.JsonMap{},
+		},
+		"genesyscloud_routing_email_route": {
+			"route": util.JsonMap{},
+		},
+	}
+
+	dir := t.TempDir()
+	writer := NewImportBlockWriter(resources, resourceTypesMaps, dir, false)
+
+	if diagErr := writer.writeHCLImportBlocks(); diagErr != nil {
+		t.Fatalf("Failed to write HCL import blocks: %v", diagErr)
+	}
+	hclBytes, err := os.ReadFile(filepath.Join(dir, defaultTfHCLImportFile))
+	if err != nil {
+		t.Fatalf("Failed to read %s: %v", defaultTfHCLImportFile, err)
+	}
+	expectedHCL := `import {
+  to = genesyscloud_routing_email_route.route
+  id = "domain-id/route-id"
+}
+
+import {
+  to = genesyscloud_routing_queue.queue_a
+  id = "queue-id-a"
+}
+
+import {
+  to = genesyscloud_routing_queue.queue_b
+  id = "queue-id-b"
+}
+`
+	assert.Equal(t, expectedHCL, string(hclBytes[:len(hclBytes)-1]))
+
+	if diagErr := writer.writeJSONImportBlocks(); diagErr != nil {
+		t.Fatalf("Failed to write JSON import blocks: %v", diagErr)
+	}
+	jsonBytes, err := os.ReadFile(filepath.Join(dir, defaultTfJSONImportFile))
+	if err != nil {
+		t.Fatalf("Failed to read %s: %v", defaultTfJSONImportFile, err)
+	}
+	var importConfig map[string][]map[string]string
+	if err := json.Unmarshal(jsonBytes, &importConfig); err != nil {
+		t.Fatalf("Failed to parse %s: %v", defaultTfJSONImportFile, err)
+	}
+	assert.Equal(t, []map[string]string{
+		{"to": "genesyscloud_routing_email_route.route", "id": "domain-id/route-id"},
+		{"to": "genesyscloud_routing_queue.queue_a", "id": "queue-id-a"},
+		{"to": "genesyscloud_routing_queue.queue_b", "id": "queue-id-b"},
+	}, importConfig["import"])
+}
@@ -130,18 +130,7 @@ func (j *JsonExporter) exportJSONConfig() diag.Diagnostics {
 
 	// Optional tfvars file creation for unresolved attributes
 	if len(j.unresolvedAttrs) > 0 {
-		tfVars := make(map[string]interface{})
-		for _, attr := range j.unresolvedAttrs {
-			key := createUnresolvedAttrKey(attr)
-			tfVars[key] = make(util.JsonMap)
-			tfVars[key] = determineVarValue(attr.Schema)
-		}
-
-		tfVarsFilePath := filepath.Join(j.dirPath, defaultTfVarsFile)
-		if tfVarsFilePath == "" {
-			return diag.Errorf("Failed to create tfvars file path %s", tfVarsFilePath)
-		}
-		if err := writeTfVars(tfVars, tfVarsFilePath); err != nil {
+		if err := writeUnresolvedAttrsTfVars(j.unresolvedAttrs, j.dirPath); err != nil {
 			return err
 		}
 	}
@@ -0,0 +1,517 @@
+package tfexporter
+
+import (
+	"fmt"
+	"log"
+	"os"
+	"path/filepath"
+	"regexp"
+	"sort"
+	"strings"
+	"terraform-provider-genesyscloud/genesyscloud/util"
+
+	"github.com/hashicorp/hcl/v2"
+	"github.com/hashicorp/hcl/v2/hclwrite"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	zclconfCty "github.com/zclconf/go-cty/cty"
+)
+
+/*
+This file contains all of the functions used to export the configuration as a set of Terraform modules. Resources are grouped
+into one module per functional area (routing, telephony, architect, ...) under the 'modules' directory, and the root module
+only calls each of them. References between resources in different modules are replaced by a module output in the module
+of the referenced resource and a variable in the module of the referencing resource.
+*/
+
+const (
+	modulesDirectory = "modules"
+
+	defaultTfHCLMainFile     = "main.tf"
+	defaultTfJSONMainFile    = "main.tf.json"
+	defaultTfHCLVersionsFile = "versions.tf"
+	defaultTfHCLOutputsFile  = "outputs.tf"
+)
+
+// moduleNameAliases maps the first word of a resource type to the module of a functional area when they differ
+var moduleNameAliases = map[string]string{
+	"flow": "architect",
+}
+
+// resourceReferenceRegex matches interpolated references to the attribute of a resource or data source, e.g. ${genesyscloud_routing_queue.support.id}
+var resourceReferenceRegex = regexp.MustCompile(`\$\{(data\.)?(genesyscloud_[a-z0-9_]+)\.([A-Za-z0-9_-]+)\.([a-z0-9_]+)\}`)
+
+// getModuleName returns the module of the functional area of a resource type, e.g. routing for genesyscloud_routing_queue
+func getModuleName(resourceType string) string {
+	name := strings.Split(strings.TrimPrefix(resourceType, "genesyscloud_"), "_")[0]
+	if alias, ok := moduleNameAliases[name]; ok {
+		return alias
+	}
+	return name
+}
+
+type exportModule struct {
+	name                  string
+	resourceTypesJSONMaps map[string]resourceJSONMaps
+	dataSourceTypesMaps   map[string]resourceJSONMaps
+	unresolvedAttrs       []unresolvableAttributeInfo
+	// Variable name to the expression passed in by the root module
+	inputs map[string]string
+	// Output name to the referenced resource attribute
+	outputs map[string]string
+	// Modules of the resources that resources of this module depend on
+	dependsOn map[string]bool
+}
+
+type ModuleExporter struct {
+	resourceTypesJSONMaps map[string]resourceJSONMaps
+	dataSourceTypesMaps   map[string]resourceJSONMaps
+	unresolvedAttrs       []unresolvableAttributeInfo
+	providerRegistry      string
+	version               string
+	dirPath               string
+	modules               map[string]*exportModule
+}
+
+func NewModuleExporter(resourceTypesJSONMaps map[string]resourceJSONMaps, dataSourceTypesMaps map[string]resourceJSONMaps, unresolvedAttrs []unresolvableAttributeInfo, providerRegistry string, version string, dirPath string) *ModuleExporter {
+	moduleExporter := &ModuleExporter{
+		resourceTypesJSONMaps: resourceTypesJSONMaps,
+		dataSourceTypesMaps:   dataSourceTypesMaps,
+		unresolvedAttrs:       unresolvedAttrs,
+		providerRegistry:      providerRegistry,
+		version:               version,
+		dirPath:               dirPath,
+	}
+	moduleExporter.buildModules()
+	return moduleExporter
+}
+
+func (m *ModuleExporter) getModule(resourceType string) *exportModule {
+	name := getModuleName(resourceType)
+	module, ok := m.modules[name]
+	if !ok {
+		module = &exportModule{
+			name:                  name,
+			resourceTypesJSONMaps: make(map[string]resourceJSONMaps),
+			dataSourceTypesMaps:   make(map[string]resourceJSONMaps),
+			inputs:                make(map[string]string),
+			outputs:               make(map[string]string),
+			dependsOn:             make(map[string]bool),
+		}
+		m.modules[name] = module
+	}
+	return module
+}
+
+// buildModules splits the resources into modules and replaces the references between modules with variables
+func (m *ModuleExporter) buildModules() {
+	m.modules = make(map[string]*exportModule)
+
+	for resType, resJSONMap := range m.resourceTypesJSONMaps {
+		m.getModule(resType).resourceTypesJSONMaps[resType] = resJSONMap
+	}
+	for resType, dataJSONMap := range m.dataSourceTypesMaps {
+		m.getModule(resType).dataSourceTypesMaps[resType] = dataJSONMap
+	}
+	for _, attr := range m.unresolvedAttrs {
+		module := m.getModule(attr.ResourceType)
+		module.unresolvedAttrs = append(module.unresolvedAttrs, attr)
+		key := createUnresolvedAttrKey(attr)
+		module.inputs[key] = "var." + key
+	}
+
+	for _, module := range m.modules {
+		for _, resJSONMap := range module.resourceTypesJSONMaps {
+			for _, resJson := range resJSONMap {
+				m.resolveModuleReferences(module, resJson)
+			}
+		}
+		for _, dataJSONMap := range module.dataSourceTypesMaps {
+			for _, dataJson := range dataJSONMap {
+				m.resolveModuleReferences(module, dataJson)
+			}
+		}
+	}
+	m.removeCyclicDependencies()
+}
+
+// resolveModuleReferences replaces the references in a resource config to resources of other modules. depends_on can only
+// refer to resources of the same module, so dependencies on resources of other modules become a depends_on of the module call.
+func (m *ModuleExporter) resolveModuleReferences(module *exportModule, configMap util.JsonMap) {
+	if dependsOn, ok := configMap["depends_on"].([]string); ok {
+		sameModuleDependsOn := make([]string, 0)
+		for _, dependency := range dependsOn {
+			address := strings.TrimSuffix(strings.TrimPrefix(dependency, "$dep$"), "$dep$")
+			targetModuleName := getModuleName(strings.Split(address, ".")[0])
+			if targetModuleName == module.name {
+				sameModuleDependsOn = append(sameModuleDependsOn, dependency)
+			} else if _, ok := m.modules[targetModuleName]; ok {
+				log.Printf("Moving dependency of module %s on %s to the module call", module.name, address)
+				module.dependsOn[targetModuleName] = true
+			}
+		}
+		if len(sameModuleDependsOn) > 0 {
+			configMap["depends_on"] = sameModuleDependsOn
+		} else {
+			delete(configMap, "depends_on")
+		}
+	}
+
+	for key, val := range configMap {
+		configMap[key] = m.resolveValueReferences(module, val)
+	}
+}
+
+// removeCyclicDependencies drops the depends_on of a module call on a module that already depends on it, either through a
+// reference or its own depends_on, because Terraform rejects cycles between modules. The order between the resources is
+// then kept by the references of the other module.
+func (m *ModuleExporter) removeCyclicDependencies() {
+	for _, name := range m.getSortedModuleNames() {
+		module := m.modules[name]
+		for _, target := range sortedKeys(module.getDependsOn()) {
+			delete(module.dependsOn, target)
+			if m.dependsOnModule(target, name, make(map[string]bool)) {
+				log.Printf("Dropping dependency of module %s on module %s because module %s depends on module %s", name, target, target, name)
+				continue
+			}
+			module.dependsOn[target] = true
+		}
+	}
+}
+
+// dependsOnModule reports whether a module depends on another module through its inputs or its depends_on
+func (m *ModuleExporter) dependsOnModule(name, target string, visited map[string]bool) bool {
+	if name == target {
+		return true
+	}
+	if visited[name] {
+		return false
+	}
+	visited[name] = true
+	for _, dependency := range m.modules[name].getModuleDependencies() {
+		if m.dependsOnModule(dependency, target, visited) {
+			return true
+		}
+	}
+	return false
+}
+
+// getDependsOn returns the depends_on of the module call keyed by module name
+func (module *exportModule) getDependsOn() map[string]string {
+	dependsOn := make(map[string]string, len(module.dependsOn))
+	for name := range module.dependsOn {
+		dependsOn[name] = "module." + name
+	}
+	return dependsOn
+}
+
+// getModuleDependencies returns the modules that a module reads outputs of or depends on
+func (module *exportModule) getModuleDependencies() []string {
+	dependencies := make([]string, 0)
+	for _, expression := range module.inputs {
+		if strings.HasPrefix(expression, "module.") {
+			dependencies = append(dependencies, strings.Split(expression, ".")[1])
+		}
+	}
+	for name := range module.dependsOn {
+		dependencies = append(dependencies, name)
+	}
+	return dependencies
+}
+
+func (m *ModuleExporter) resolveValueReferences(module *exportModule, val interface{}) interface{} {
+	switch v := val.(type) {
+	case string:
+		// Attributes exported with jsonencode are stored separately under a placeholder
+		if decoded, ok := attributesDecoded[v]; ok {
+			attributesDecoded[v] = m.resolveStringReferences(module, decoded)
+			return v
+		}
+		return m.resolveStringReferences(module, v)
+	case util.JsonMap:
+		m.resolveModuleReferences(module, v)
+	case map[string]interface{}:
+		m.resolveModuleReferences(module, v)
+	case []interface{}:
+		for i, item := range v {
+			v[i] = m.resolveValueReferences(module, item)
+		}
+	case []string:
+		for i, item := range v {
+			v[i] = m.resolveStringReferences(module, item)
+		}
+	}
+	return val
+}
+
+func (m *ModuleExporter) resolveStringReferences(module *exportModule, value string) string {
+	matches := resourceReferenceRegex.FindAllStringSubmatchIndex(value, -1)
+	if len(matches) == 0 {
+		return value
+	}
+
+	var sb strings.Builder
+	last := 0
+	for _, match := range matches {
+		start, end := match[0], match[1]
+		// $${ is an escaped literal and not a reference
+		if start > 0 && value[start-1] == '$' {
+			continue
+		}
+		isData := match[2] != -1
+		resType, resLabel, attr := value[match[4]:match[5]], value[match[6]:match[7]], value[match[8]:match[9]]
+
+		if !m.resourceExists(resType, resLabel, isData) {
+			continue
+		}
+		targetModule := m.getModule(resType)
+		if targetModule.name == module.name {
+			continue
+		}
+
+		reference := fmt.Sprintf("%s.%s.%s", resType, resLabel, attr)
+		if isData {
+			reference = "data." + reference
+		}
+		name := strings.ReplaceAll(reference, ".", "_")
+		targetModule.outputs[name] = reference
+		module.inputs[name] = fmt.Sprintf("module.%s.%s", targetModule.name, name)
+
+		sb.WriteString(value[last:start])
+		sb.WriteString(fmt.Sprintf("${var.%s}", name))
+		last = end
+	}
+	sb.WriteString(value[last:])
+	return sb.String()
+}
+
+func (m *ModuleExporter) resourceExists(resType, resLabel string, isData bool) bool {
+	typeMaps := m.resourceTypesJSONMaps
+	if isData {
+		typeMaps = m.dataSourceTypesMaps
+	}
+	_, ok := typeMaps[resType][resLabel]
+	return ok
+}
+
+func (m *ModuleExporter) getSortedModuleNames() []string {
+	names := make([]string, 0, len(m.modules))
+	for name := range m.modules {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+	return names
+}
+
+func sortedKeys(values map[string]string) []string {
+	keys := make([]string, 0, len(values))
+	for key := range values {
+		keys = append(keys, key)
+	}
+	sort.Strings(keys)
+	return keys
+}
+
+func getModuleSource(name string) string {
+	return fmt.Sprintf("./%s/%s", modulesDirectory, name)
+}
+
+func (m *ModuleExporter) createModuleDirectory(name string) (string, diag.Diagnostics) {
+	moduleDirPath := filepath.Join(m.dirPath, modulesDirectory, name)
+	if err := os.MkdirAll(moduleDirPath, os.ModePerm); err != nil {
+		return "", diag.Errorf("Failed to create module directory %s: %v", moduleDirPath, err)
+	}
+	return moduleDirPath, nil
+}
+
+// parseTraversal converts a reference such as module.routing.queue_id to an HCL traversal
+func parseTraversal(reference string) hcl.Traversal {
+	parts := strings.Split(reference, ".")
+	traversal := hcl.Traversal{hcl.TraverseRoot{Name: parts[0]}}
+	for _, part := range parts[1:] {
+		traversal = append(traversal, hcl.TraverseAttr{Name: part})
+	}
+	return traversal
+}
+
+func (m *ModuleExporter) exportHCLModules() diag.Diagnostics {
+	for _, name := range m.getSortedModuleNames() {
+		module := m.modules[name]
+		moduleDirPath, diagErr := m.createModuleDirectory(name)
+		if diagErr != nil {
+			return diagErr
+		}
+
+		// Modules must declare the source of the genesyscloud provider as well
+		versionsBlock := createHCLProviderBlock(m.providerRegistry, m.version)
+		if diagErr := writeHCLToFile([][]byte{versionsBlock}, filepath.Join(moduleDirPath, defaultTfHCLVersionsFile)); diagErr != nil {
+			return diagErr
+		}
+
+		hclBlocks := buildHCLBlocks(module.resourceTypesJSONMaps, module.dataSourceTypesMaps)
+		resourceTypes := make([]string, 0, len(hclBlocks))
+		for resType := range hclBlocks {
+			resourceTypes = append(resourceTypes, resType)
+		}
+		sort.Strings(resourceTypes)
+		mainBlocks := make([][]byte, 0)
+		for _, resType := range resourceTypes {
+			mainBlocks = append(mainBlocks, hclBlocks[resType]...)
+		}
+		if diagErr := writeHCLToFile(mainBlocks, filepath.Join(moduleDirPath, defaultTfHCLMainFile)); diagErr != nil {
+			return diagErr
+		}
+
+		variablesBlocks := [][]byte{createHCLVariablesBlock(module.unresolvedAttrs), createHCLModuleInputsBlock(module)}
+		if diagErr := writeHCLToFile(variablesBlocks, filepath.Join(moduleDirPath, defaultTfHCLVariablesFile)); diagErr != nil {
+			return diagErr
+		}
+
+		if diagErr := writeHCLToFile([][]byte{createHCLModuleOutputsBlock(module)}, filepath.Join(moduleDirPath, defaultTfHCLOutputsFile)); diagErr != nil {
+			return diagErr
+		}
+	}
+
+	// Root module
+	providerBlock := createHCLProviderBlock(m.providerRegistry, m.version)
+	if diagErr := writeHCLToFile([][]byte{providerBlock}, filepath.Join(m.dirPath, defaultTfHCLProviderFile)); diagErr != nil {
+		return diagErr
+	}
+	if diagErr := writeHCLToFile([][]byte{m.createHCLModuleCallsBlock()}, filepath.Join(m.dirPath, defaultTfHCLMainFile)); diagErr != nil {
+		return diagErr
+	}
+	if diagErr := writeHCLToFile([][]byte{createHCLVariablesBlock(m.unresolvedAttrs)}, filepath.Join(m.dirPath, defaultTfHCLVariablesFile)); diagErr != nil {
+		return diagErr
+	}
+	return m.writeTfVars()
+}
+
+// Create HCL variable blocks for the references to resources of other modules
+func createHCLModuleInputsBlock(module *exportModule) []byte {
+	f := hclwrite.NewEmptyFile()
+	for _, name := range sortedKeys(module.inputs) {
+		if !strings.HasPrefix(module.inputs[name], "module.") {
+			// Variables of unresolved attributes are already declared
+			continue
+		}
+		variableBody := f.Body().AppendNewBlock("variable", []string{name}).Body()
+		variableBody.SetAttributeTraversal("type", hcl.Traversal{hcl.TraverseRoot{Name: "string"}})
+		variableBody.SetAttributeValue("description", zclconfCty.StringVal(fmt.Sprintf("Reference to %s", strings.TrimPrefix(module.inputs[name], "module."))))
+	}
+	return f.Bytes()
+}
+
+// Create HCL output blocks for the resources referenced by other modules
+func createHCLModuleOutputsBlock(module *exportModule) []byte {
+	f := hclwrite.NewEmptyFile()
+	for _, name := range sortedKeys(module.outputs) {
+		outputBody := f.Body().AppendNewBlock("output", []string{name}).Body()
+		outputBody.SetAttributeTraversal("value", parseTraversal(module.outputs[name]))
+	}
+	return f.Bytes()
+}
+
+// Create the HCL module blocks of the root module
+func (m *ModuleExporter) createHCLModuleCallsBlock() []byte {
+	f := hclwrite.NewEmptyFile()
+	for i, name := range m.getSortedModuleNames() {
+		if i > 0 {
+			f.Body().AppendNewline()
+		}
+		module := m.modules[name]
+		moduleBody := f.Body().AppendNewBlock("module", []string{name}).Body()
+		moduleBody.SetAttributeValue("source", zclconfCty.StringVal(getModuleSource(name)))
+		for _, input := range sortedKeys(module.inputs) {
+			moduleBody.SetAttributeTraversal(input, parseTraversal(module.inputs[input]))
+		}
+		if dependsOn := module.getDependsOn(); len(dependsOn) > 0 {
+			dependencies := make([]hclwrite.Tokens, 0, len(dependsOn))
+			for _, dependency := range sortedKeys(dependsOn) {
+				dependencies = append(dependencies, hclwrite.TokensForTraversal(parseTraversal(dependsOn[dependency])))
+			}
+			moduleBody.SetAttributeRaw("depends_on", hclwrite.TokensForTuple(dependencies))
+		}
+	}
+	return f.Bytes()
+}
+
+func (m *ModuleExporter) exportJSONModules() diag.Diagnostics {
+	providerJsonMap := createProviderJsonMap(m.providerRegistry, m.version)
+
+	for _, name := range m.getSortedModuleNames() {
+		module := m.modules[name]
+		moduleDirPath, diagErr := m.createModuleDirectory(name)
+		if diagErr != nil {
+			return diagErr
+		}
+
+		moduleJSONObject := util.JsonMap{
+			"terraform": providerJsonMap,
+		}
+		if len(module.resourceTypesJSONMaps) > 0 {
+			moduleJSONObject["resource"] = module.resourceTypesJSONMaps
+		}
+		if len(module.dataSourceTypesMaps) > 0 {
+			moduleJSONObject["data"] = module.dataSourceTypesMaps
+		}
+
+		variablesJsonMap := createVariablesJsonMap(module.unresolvedAttrs)
+		for _, input := range sortedKeys(module.inputs) {
+			if strings.HasPrefix(module.inputs[input], "module.") {
+				variablesJsonMap[input] = util.JsonMap{
+					"type":        "string",
+					"description": fmt.Sprintf("Reference to %s", strings.TrimPrefix(module.inputs[input], "module.")),
+				}
+			}
+		}
+		if len(variablesJsonMap) > 0 {
+			moduleJSONObject["variable"] = variablesJsonMap
+		}
+
+		if len(module.outputs) > 0 {
+			outputs := make(map[string]interface{})
+			for output, reference := range module.outputs {
+				outputs[output] = util.JsonMap{"value": fmt.Sprintf("${%s}", reference)}
+			}
+			moduleJSONObject["output"] = outputs
+		}
+
+		if diagErr := writeConfig(moduleJSONObject, filepath.Join(moduleDirPath, defaultTfJSONMainFile)); diagErr != nil {
+			return diagErr
+		}
+	}
+
+	// Root module
+	moduleCalls := make(map[string]interface{})
+	for name, module := range m.modules {
+		moduleCall := util.JsonMap{"source": getModuleSource(name)}
+		for input, expression := range module.inputs {
+			moduleCall[input] = fmt.Sprintf("${%s}", expression)
+		}
+		if dependsOn := module.getDependsOn(); len(dependsOn) > 0 {
+			dependencies := make([]string, 0, len(dependsOn))
+			for _, dependency := range sortedKeys(dependsOn) {
+				dependencies = append(dependencies, dependsOn[dependency])
+			}
+			moduleCall["depends_on"] = dependencies
+		}
+		moduleCalls[name] = moduleCall
+	}
+	rootJSONObject := util.JsonMap{
+		"terraform": providerJsonMap,
+		"module":    moduleCalls,
+	}
+	if variablesJsonMap := createVariablesJsonMap(m.unresolvedAttrs); len(variablesJsonMap) > 0 {
+		rootJSONObject["variable"] = variablesJsonMap
+	}
+	if diagErr := writeConfig(rootJSONObject, filepath.Join(m.dirPath, defaultTfJSONMainFile)); diagErr != nil {
+		return diagErr
+	}
+	return m.writeTfVars()
+}
+
+func (m *ModuleExporter) writeTfVars() diag.Diagnostics {
+	if len(m.unresolvedAttrs) == 0 {
+		return nil
+	}
+	return writeUnresolvedAttrsTfVars(m.unresolvedAttrs, m.dirPath)
+}
@@ -0,0 +1,150 @@
+package tfexporter
+
+import (
+	"os"
+	"path/filepath"
+	"reflect"
+	"strings"
+	"terraform-provider-genesyscloud/genesyscloud/util"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestUnitGetModuleName(t *testing.T) {
+	assert.Equal(t, "routing", getModuleName("genesyscloud_routing_queue"))
+	assert.Equal(t, "telephony", getModuleName("genesyscloud_telephony_providers_edges_site"))
+	assert.Equal(t, "architect", getModuleName("genesyscloud_architect_datatable"))
+	assert.Equal(t, "architect", getModuleName("genesyscloud_flow"))
+	assert.Equal(t, "user", getModuleName("genesyscloud_user"))
+}
+
+func TestUnitModuleExporter(t *testing.T) {
+	resourceTypesMaps := map[string]resourceJSONMaps{
+		"genesyscloud_routing_queue": {
+			"support": util.JsonMap{
+				"name":             "Support",
+				"division_id":      "${data.genesyscloud_auth_division.home.id}",
+				"skill_groups":     []interface{}{"${genesyscloud_routing_skill_group.agents.id}"},
+				"queue_flow_id":    "${genesyscloud_flow.inbound.id}",
+				"escaped_template": "$${genesyscloud_flow.inbound.id}",
+				"depends_on":       []string{"$dep$genesyscloud_flow.inbound$dep$", "$dep$genesyscloud_routing_skill_group.agents$dep$"},
+			},
+		},
+		"genesyscloud_routing_skill_group": {
+			"agents": util.JsonMap{"name": "Agents"},
+		},
+		"genesyscloud_flow": {
+			"inbound": util.JsonMap{"filepath": "flows/inbound.yaml"},
+			// The routing module already depends on the architect module through the flow reference of the queue
+			"outbound": util.JsonMap{
+				"filepath":   "flows/outbound.yaml",
+				"depends_on": []string{"$dep$genesyscloud_routing_queue.support$dep$"},
+			},
+		},
+		"genesyscloud_user": {
+			"agent": util.JsonMap{
+				"email":      "agent@example.com",
+				"depends_on": []string{"$dep$genesyscloud_routing_skill_group.agents$dep$"},
+			},
+		},
+	}
+	dataSourceTypesMaps := map[string]resourceJSONMaps{
+		"genesyscloud_auth_division": {
+			"home": util.JsonMap{"name": "Home"},
+		},
+	}
+
+	dir := t.TempDir()
+	moduleExporter := NewModuleExporter(resourceTypesMaps, dataSourceTypesMaps, nil, "registry.terraform.io", "1.0.0", dir)
+
+	assert.ElementsMatch(t, []string{"architect", "auth", "routing", "user"}, moduleExporter.getSortedModuleNames())
+
+	queue := moduleExporter.modules["routing"].resourceTypesJSONMaps["genesyscloud_routing_queue"]["support"]
+	assert.Equal(t, "${var.genesyscloud_flow_inbound_id}", queue["queue_flow_id"])
+	assert.Equal(t, "${var.data_genesyscloud_auth_division_home_id}", queue["division_id"])
+	assert.Equal(t, []interface{}{"${genesyscloud_routing_skill_group.agents.id}"}, queue["skill_groups"], "Expected reference in the same module to be kept")
+	assert.Equal(t, "$${genesyscloud_flow.inbound.id}", queue["escaped_template"], "Expected escaped literal to be kept")
+	assert.Equal(t, []string{"$dep$genesyscloud_routing_skill_group.agents$dep$"}, queue["depends_on"])
+
+	// Dependencies on resources of other modules are moved to the module call unless they would form a cycle
+	assert.Equal(t, map[string]bool{"architect": true}, moduleExporter.modules["routing"].dependsOn)
+	assert.Equal(t, map[string]bool{"routing": true}, moduleExporter.modules["user"].dependsOn)
+	assert.Empty(t, moduleExporter.modules["architect"].dependsOn, "Expected the dependency of the architect module on the routing module to be dropped")
+	assert.NotContains(t, moduleExporter.modules["architect"].resourceTypesJSONMaps["genesyscloud_flow"]["outbound"], "depends_on")
+	assert.NotContains(t, moduleExporter.modules["user"].resourceTypesJSONMaps["genesyscloud_user"]["agent"], "depends_on")
+
+	assert.Equal(t, map[string]string{
+		"genesyscloud_flow_inbound_id":            "module.architect.genesyscloud_flow_inbound_id",
+		"data_genesyscloud_auth_division_home_id": "module.auth.data_genesyscloud_auth_division_home_id",
+	}, moduleExporter.modules["routing"].inputs)
+	assert.Equal(t, map[string]string{"genesyscloud_flow_inbound_id": "genesyscloud_flow.inbound.id"}, moduleExporter.modules["architect"].outputs)
+
+	if diagErr := moduleExporter.exportHCLModules(); diagErr != nil {
+		t.Fatalf("Failed to export HCL modules: %v", diagErr)
+	}
+
+	rootMain, err := os.ReadFile(filepath.Join(dir, defaultTfHCLMainFile))
+	if err != nil {
+		t.Fatalf("Failed to read root module: %v", err)
+	}
+	assert.True(t, strings.Contains(string(rootMain), `source                                  = "./modules/routing"`), "Expected routing module call in:\n%s", string(rootMain))
+	assert.True(t, strings.Contains(string(rootMain), "genesyscloud_flow_inbound_id            = module.architect.genesyscloud_flow_inbound_id"), "Expected module output to be passed in:\n%s", string(rootMain))
+	assert.True(t, strings.Contains(string(rootMain), "depends_on = [module.routing]"), "Expected user module call to depend on the routing module in:\n%s", string(rootMain))
+
+	outputs, err := os.ReadFile(filepath.Join(dir, modulesDirectory, "architect", defaultTfHCLOutputsFile))
+	if err != nil {
+		t.Fatalf("Failed to read architect outputs: %v", err)
+	}
+	assert.True(t, strings.Contains(string(outputs), "value = genesyscloud_flow.inbound.id"), "Expected flow output in:\n%s", string(outputs))
+
+	for _, file := range []string{defaultTfHCLMainFile, defaultTfHCLVariablesFile, defaultTfHCLVersionsFile} {
+		if _, err := os.Stat(filepath.Join(dir, modulesDirectory, "routing", file)); err != nil {
+			t.Errorf("Expected %s to be written for the routing module: %v", file, err)
+		}
+	}
+
+	if diagErr := moduleExporter.exportJSONModules(); diagErr != nil {
+		t.Fatalf("Failed to export JSON modules: %v", diagErr)
+	}
+	if _, err := os.Stat(filepath.Join(dir, modulesDirectory, "routing", defaultTfJSONMainFile)); err != nil {
+		t.Errorf("Expected %s to be written for the routing module: %v", defaultTfJSONMainFile, err)
+	}
+	rootJSON, err := os.ReadFile(filepath.Join(dir, defaultTfJSONMainFile))
+	if err != nil {
+		t.Fatalf("Failed to read root JSON module: %v", err)
+	}
+	assert.True(t, strings.Contains(string(rootJSON), `"depends_on": [
+        "module.routing"
+      ]`), "Expected user module call to depend on the routing module in:\n%s", string(rootJSON))
+}
+
+func TestUnitTfStateModuleAddresses(t *testing.T) {
+	tfstate := terraform.NewState()
+	writer := &TFStateFileWriter{exportAsModules: true}
+	for _, resourceType := range []string{"genesyscloud_routing_queue", "genesyscloud_flow", "genesyscloud_routing_skill"} {
+		writer.getModuleState(tfstate, resourceType).Resources[resourceType+".example"] = &terraform.ResourceState{Type: resourceType}
+	}
+
+	findModule := func(name string) *terraform.ModuleState {
+		for _, module := range tfstate.Modules {
+			if reflect.DeepEqual(module.Path, []string{"root", name}) {
+				return module
+			}
+		}
+		return nil
+	}
+	routing := findModule("routing")
+	if assert.NotNil(t, routing, "Expected a state for module.routing") {
+		assert.Len(t, routing.Resources, 2)
+	}
+	architect := findModule("architect")
+	if assert.NotNil(t, architect, "Expected a state for module.architect") {
+		assert.Contains(t, architect.Resources, "genesyscloud_flow.example")
+	}
+	assert.Empty(t, tfstate.RootModule().Resources)
+
+	writer.exportAsModules = false
+	assert.Equal(t, tfstate.RootModule(), writer.getModuleState(tfstate, "genesyscloud_flow"))
+}
@@ -5,6 +5,7 @@ import (
 	"fmt"
 	"os"
 	"path/filepath"
+	"terraform-provider-genesyscloud/genesyscloud/provider"
 	"terraform-provider-genesyscloud/genesyscloud/validators"
 
 	resourceExporter "terraform-provider-genesyscloud/genesyscloud/resource_exporter"
@@ -81,6 +82,16 @@ func ResourceTfExport() *schema.Resource {
 				},
 				ForceNew: true,
 			},
+			"replacement_exporters": {
+				Description: "Resource types to export in place of the resource type they replace, so the same objects are not exported twice. E.g. `genesyscloud_architect_datatable_rows` exports the rows of every datatable to one file per datatable instead of exporting a `genesyscloud_architect_datatable_row` for every row. Replacement resource types are not exported unless they are listed here.",
+				Type:        schema.TypeList,
+				Optional:    true,
+				Elem: &schema.Schema{
+					Type:         schema.TypeString,
+					ValidateFunc: validation.StringInSlice(resourceExporter.GetReplacementExporterTypes(), false),
+				},
+				ForceNew: true,
+			},
 			"exclude_filter_resources": {
 				Description: "Exclude resources that match either a resource type or a resource type::regular expression.  See export guide for additional information.",
 				Type:        schema.TypeList,
@@ -99,6 +110,52 @@ func ResourceTfExport() *schema.Resource {
 				Default:     false,
 				ForceNew:    true,
 			},
+			"include_import_blocks": {
+				Description: fmt.Sprintf("Export an '%s' or '%s' file with a Terraform 1.5+ `import` block for every exported resource. Unlike `include_state_file`, this lets existing resources be adopted into any backend or workspace by running `terraform plan` and `terraform apply`. As with `include_state_file`, GUID fields that cannot be resolved to a reference are kept in the config file.", defaultTfHCLImportFile, defaultTfJSONImportFile),
+				Type:        schema.TypeBool,
+				Optional:    true,
+				Default:     false,
+				ForceNew:    true,
+			},
+			"export_as_modules": {
+				Description: fmt.Sprintf("Export the config as a set of Terraform modules, one per functional area (e.g. routing, telephony, outbound, architect), in the '%s' subdirectory. The root module calls each module and passes the references between resources of different modules as module outputs and variables. `split_files_by_resource` is ignored when this is set. With `include_state_file`, the resources are written to the state of their module.", modulesDirectory),
+				Type:        schema.TypeBool,
+				Optional:    true,
+				Default:     false,
+				ForceNew:    true,
+			},
+			"unresolved_reference_policy": {
+				Description:  fmt.Sprintf("How references to resources that are not part of the export (e.g. filtered out or in divisions that are not exported) are handled. `%s` removes the reference, or keeps the GUID when `include_state_file` or `include_import_blocks` is set. `%s` reads the referenced resource and exports it as a data source using the data source of its resource type. References are only exported as data sources when `enable_dependency_resolution` is not set.", referencePolicyDefault, referencePolicyDataSource),
+				Type:         schema.TypeString,
+				Optional:     true,
+				Default:      referencePolicyDefault,
+				ForceNew:     true,
+				ValidateFunc: validation.StringInSlice([]string{referencePolicyDefault, referencePolicyDataSource}, false),
+			},
+			"max_concurrent_resource_types": {
+				Description:  "Maximum number of resource types that are exported at the same time. A value of 0 means unlimited.",
+				Type:         schema.TypeInt,
+				Optional:     true,
+				Default:      0,
+				ForceNew:     true,
+				ValidateFunc: validation.IntAtLeast(0),
+			},
+			"max_concurrent_reads": {
+				Description:  "Maximum number of resources that are read from Genesys Cloud at the same time across all resource types. A value of 0 means unlimited. The number of reads is also limited by the `token_pool_size` of the provider.",
+				Type:         schema.TypeInt,
+				Optional:     true,
+				Default:      0,
+				ForceNew:     true,
+				ValidateFunc: validation.IntAtLeast(0),
+			},
+			"max_reads_per_second": {
+				Description:  "Maximum number of resource reads started per second across all resource types. A value of 0 means unlimited. Reads are paused for the duration of the Retry-After header whenever the API responds with a 429, regardless of this setting.",
+				Type:         schema.TypeInt,
+				Optional:     true,
+				Default:      0,
+				ForceNew:     true,
+				ValidateFunc: validation.IntAtLeast(0),
+			},
 			"export_as_hcl": {
 				Description:   "Export the config as HCL. Deprecated. Please use the export_format attribute instead",
 				Type:          schema.TypeBool,
@@ -162,6 +219,34 @@ func ResourceTfExport() *schema.Resource {
 				Default:     false,
 				ForceNew:    true,
 			},
+			"previous_export_directory": {
+				Description: "Directory of a previous export that was run with `include_state_file` set to true. When set, an incremental export is performed: resources whose version has not changed since the previous export are taken from its 'terraform.tfstate' file rather than being read from Genesys Cloud. New resources are read and deleted resources are dropped, so the output matches a full export. Resource types that do not expose a version are always read.",
+				Type:        schema.TypeString,
+				Optional:    true,
+				ForceNew:    true,
+			},
+			"export_sensitive_as_variables": {
+				Description: fmt.Sprintf("Replace the values of sensitive attributes, e.g. user passwords and integration credential fields, with sensitive Terraform variables. The values are never written to the exported configuration or '%s'. Instead, the variables are listed in '%s' for the user to fill in. The state file still contains the values if `include_state_file` is set.", defaultTfVarsFile, defaultTfVarsExampleFile),
+				Type:        schema.TypeBool,
+				Optional:    true,
+				Default:     false,
+				ForceNew:    true,
+			},
+			"resume_from_checkpoint": {
+				Description:   fmt.Sprintf("Checkpoint the export so that it can be resumed if it fails. When set, every resource type is saved to the '%s' directory in the export directory as soon as all of its resources are retrieved, and the resource types already found there are neither listed nor read again and are merged into the exported configuration. Resource types saved by an export with different filters are retrieved again. Resource types with sensitive values are never saved. The checkpoint is removed once the export finishes successfully.", checkpointDirName),
+				Type:          schema.TypeBool,
+				Optional:      true,
+				Default:       false,
+				ForceNew:      true,
+				ConflictsWith: []string{"drift_report_state_file"},
+			},
+			"drift_report_state_file": {
+				Description:   fmt.Sprintf("Path to an existing Terraform state file. When set, no configuration is exported. Instead every managed resource in the state file is read from Genesys Cloud and the attributes that were added, removed or changed since the state was written are listed in '%s' and '%s' in the export directory. Resources that no longer exist are reported as deleted.", driftReportJSONFile, driftReportMarkdownFile),
+				Type:          schema.TypeString,
+				Optional:      true,
+				ForceNew:      true,
+				ConflictsWith: []string{"previous_export_directory"},
+			},
 			"export_computed": {
 				Description: "Export attributes that are marked as being Computed and Optional. Does not attempt to export attributes that are explicitly marked as read-only by the provider. Defaults to true to match existing functionality. This attribute's default value will likely switch to false in a future release.",
 				Default:     true,
@@ -176,6 +261,11 @@ func ResourceTfExport() *schema.Resource {
 func createTfExport(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	tfExporterState.ActivateExporterState()
 
+	// Resources are listed with the clients of the provider configuration of the export
+	if providerMeta, ok := meta.(*provider.ProviderMeta); ok {
+		ctx = provider.ContextWithProviderMeta(ctx, providerMeta)
+	}
+
 	if _, ok := d.GetOk("include_filter_resources"); ok {
 		gre, _ := NewGenesysCloudResourceExporter(ctx, d, meta, IncludeResources)
 		diagErr := gre.Export()
@@ -4,6 +4,7 @@ import (
 	gcloud "terraform-provider-genesyscloud/genesyscloud"
 	dt "terraform-provider-genesyscloud/genesyscloud/architect_datatable"
 	"terraform-provider-genesyscloud/genesyscloud/architect_datatable_row"
+	"terraform-provider-genesyscloud/genesyscloud/architect_datatable_rows"
 	emergencyGroup "terraform-provider-genesyscloud/genesyscloud/architect_emergencygroup"
 	flow "terraform-provider-genesyscloud/genesyscloud/architect_flow"
 	flowLogLevel "terraform-provider-genesyscloud/genesyscloud/flow_loglevel"
@@ -139,6 +140,7 @@ func (r *registerTestInstance) registerTestResources() {
 	providerResources[grammarLanguage.ResourceType] = grammarLanguage.ResourceArchitectGrammarLanguage()
 	providerResources[dt.ResourceType] = dt.ResourceArchitectDatatable()
 	providerResources[architect_datatable_row.ResourceType] = architect_datatable_row.ResourceArchitectDatatableRow()
+	providerResources[architect_datatable_rows.ResourceType] = architect_datatable_rows.ResourceArchitectDatatableRows()
 	providerResources[emergencyGroup.ResourceType] = emergencyGroup.ResourceArchitectEmergencyGroup()
 	providerResources[flow.ResourceType] = flow.ResourceArchitectFlow()
 	providerResources[flowMilestone.ResourceType] = flowMilestone.ResourceFlowMilestone()
@@ -257,6 +259,7 @@ func (r *registerTestInstance) registerTestExporters() {
 	RegisterExporter("genesyscloud_architect_grammar_language", grammarLanguage.ArchitectGrammarLanguageExporter())
 	RegisterExporter("genesyscloud_architect_datatable", dt.ArchitectDatatableExporter())
 	RegisterExporter("genesyscloud_architect_datatable_row", architect_datatable_row.ArchitectDatatableRowExporter())
+	RegisterExporter("genesyscloud_architect_datatable_rows", architect_datatable_rows.ArchitectDatatableRowsExporter())
 	RegisterExporter("genesyscloud_architect_emergencygroup", emergencyGroup.ArchitectEmergencyGroupExporter())
 	RegisterExporter("genesyscloud_architect_ivr", archIvr.ArchitectIvrExporter())
 	RegisterExporter("genesyscloud_architect_schedulegroups", architectSchedulegroups.ArchitectSchedulegroupsExporter())
@@ -5,6 +5,8 @@ import (
 	"encoding/json"
 	"fmt"
 	"log"
+	"path/filepath"
+	"reflect"
 	"strings"
 	"terraform-provider-genesyscloud/genesyscloud/platform"
 	resourceExporter "terraform-provider-genesyscloud/genesyscloud/resource_exporter"
@@ -24,9 +26,11 @@ type TFStateFileWriter struct {
 	resources        []resourceExporter.ResourceInfo
 	d                *schema.ResourceData
 	providerRegistry string
+	// Resources are written to the state of their module when the export is split into modules
+	exportAsModules bool
 }
 
-func NewTFStateWriter(ctx context.Context, resources []resourceExporter.ResourceInfo, d *schema.ResourceData, providerRegistry string) (*TFStateFileWriter, error) {
+func NewTFStateWriter(ctx context.Context, resources []resourceExporter.ResourceInfo, d *schema.ResourceData, providerRegistry string, exportAsModules bool) (*TFStateFileWriter, error) {
 	if ctx == nil {
 		return nil, fmt.Errorf("context cannot be nil")
 	}
@@ -41,6 +45,7 @@ func NewTFStateWriter(ctx context.Context, resources []resourceExporter.Resource
 		resources:        resources,
 		d:                d,
 		providerRegistry: providerRegistry,
+		exportAsModules:  exportAsModules,
 	}
 
 	return tfwriter, nil
@@ -88,7 +93,7 @@ func (t *TFStateFileWriter) writeTfState() diag.Diagnostics {
 			Primary:  resource.State,
 			Provider: "provider.genesyscloud",
 		}
-		rootModule.Resources[resourceKey] = resourceState
+		t.getModuleState(tfstate, resource.Type).Resources[resourceKey] = resourceState
 	}
 
 	data, err := json.MarshalIndent(tfstate, "", "  ")
@@ -138,6 +143,23 @@ func (t *TFStateFileWriter) writeTfState() diag.Diagnostics {
 	return nil
 }
 
+// getModuleState returns the state of the module a resource type is exported to, e.g. module.routing for
+// genesyscloud_routing_queue, so the addresses in the state match the exported config and import blocks
+func (t *TFStateFileWriter) getModuleState(tfstate *terraform.State, resourceType string) *terraform.ModuleState {
+	if !t.exportAsModules {
+		return tfstate.RootModule()
+	}
+	path := []string{"root", getModuleName(resourceType)}
+	for _, module := range tfstate.Modules {
+		if reflect.DeepEqual(module.Path, path) {
+			return module
+		}
+	}
+	module := &terraform.ModuleState{Path: path}
+	tfstate.AddModuleState(module)
+	return module
+}
+
 func generateTfVarsContent(vars map[string]interface{}) string {
 	tfVarsContent := ""
 	for k, v := range vars {
@@ -169,3 +191,44 @@ func writeTfVars(tfVars map[string]interface{}, path string) diag.Diagnostics {
 	log.Printf("Writing export tfvars file to %s", path)
 	return files.WriteToFile([]byte(tfVarsStr), path)
 }
+
+func writeTfVarsExample(tfVars map[string]interface{}, path string) diag.Diagnostics {
+	tfVarsStr := generateTfVarsContent(tfVars)
+	tfVarsStr = fmt.Sprintf("// This file has been autogenerated. The following sensitive properties were not written to the export."+
+		"\n// Copy this file to a .tfvars file outside of version control and fill in the values before applying the configuration\n\n%s", tfVarsStr)
+
+	log.Printf("Writing export tfvars example file to %s", path)
+	return files.WriteToFile([]byte(tfVarsStr), path)
+}
+
+// writeUnresolvedAttrsTfVars writes a tfvars file with a default value for the variable of each unresolved attribute.
+// Sensitive variables are written to a tfvars example file instead, so their values have to be provided by the user.
+func writeUnresolvedAttrsTfVars(unresolvedAttrs []unresolvableAttributeInfo, dirPath string) diag.Diagnostics {
+	tfVars := make(map[string]interface{})
+	exampleTfVars := make(map[string]interface{})
+	for _, attr := range unresolvedAttrs {
+		key := createUnresolvedAttrKey(attr)
+		if attr.Sensitive {
+			if attr.ExampleValue != nil {
+				exampleTfVars[key] = attr.ExampleValue
+			} else {
+				exampleTfVars[key] = determineVarValue(attr.Schema)
+			}
+			continue
+		}
+		if _, ok := tfVars[key]; ok {
+			continue
+		}
+		tfVars[key] = determineVarValue(attr.Schema)
+	}
+
+	if len(tfVars) > 0 {
+		if diagErr := writeTfVars(tfVars, filepath.Join(dirPath, defaultTfVarsFile)); diagErr != nil {
+			return diagErr
+		}
+	}
+	if len(exampleTfVars) > 0 {
+		return writeTfVarsExample(exampleTfVars, filepath.Join(dirPath, defaultTfVarsExampleFile))
+	}
+	return nil
+}
@@ -0,0 +1,212 @@
+package tfexporter
+
+import (
+	"encoding/json"
+	"fmt"
+	"log"
+	"os"
+	"sort"
+	"strings"
+
+	ctyjson "github.com/hashicorp/go-cty/cty/json"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+)
+
+/*
+This file contains all of the functions used to read an existing Terraform state file.  An incremental export uses the state
+of a previous export to avoid reading resources from Genesys Cloud that have not changed since that export, and a drift report
+compares the resources in a state file with their current configuration in Genesys Cloud.
+*/
+
+// exportVersionMetaKey is the key used to store the version of an exported resource in the meta data of its instance state
+const exportVersionMetaKey = "genesyscloud_export_version"
+
+type tfStateInstance struct {
+	resourceType  string
+	resourceLabel string
+	state         *terraform.InstanceState
+	version       string
+}
+
+// tfStateV4 holds the parts of a version 4 Terraform state file needed to restore the state of managed resources
+type tfStateV4 struct {
+	Version   int                 `json:"version"`
+	Resources []tfStateV4Resource `json:"resources"`
+}
+
+type tfStateV4Resource struct {
+	Mode      string              `json:"mode"`
+	Type      string              `json:"type"`
+	Name      string              `json:"name"`
+	Instances []tfStateV4Instance `json:"instances"`
+}
+
+type tfStateV4Instance struct {
+	SchemaVersion  int               `json:"schema_version"`
+	Attributes     json.RawMessage   `json:"attributes,omitempty"`
+	AttributesFlat map[string]string `json:"attributes_flat,omitempty"`
+	Private        []byte            `json:"private,omitempty"`
+}
+
+type TfStateFileReader struct {
+	tfStateFilePath string
+	instances       map[string]*tfStateInstance
+}
+
+func NewTfStateFileReader(tfStateFilePath string) *TfStateFileReader {
+	return &TfStateFileReader{
+		tfStateFilePath: tfStateFilePath,
+		instances:       make(map[string]*tfStateInstance),
+	}
+}
+
+// readTfStateFile loads the managed resources from a state file. The state file of an export is written in the version 3
+// format and is usually upgraded to version 4 by the Terraform CLI, so both formats are supported.
+func (t *TfStateFileReader) readTfStateFile(schemaProvider *schema.Provider) diag.Diagnostics {
+	data, err := os.ReadFile(t.tfStateFilePath)
+	if err != nil {
+		return diag.Errorf("Failed to read the state file %s: %v", t.tfStateFilePath, err)
+	}
+
+	var stateVersion struct {
+		Version int `json:"version"`
+	}
+	if err := json.Unmarshal(data, &stateVersion); err != nil {
+		return diag.Errorf("Failed to parse the state file %s: %v", t.tfStateFilePath, err)
+	}
+
+	switch stateVersion.Version {
+	case 3:
+		err = t.readStateV3(data)
+	case 4:
+		err = t.readStateV4(data, schemaProvider)
+	default:
+		err = fmt.Errorf("unsupported state file version %d", stateVersion.Version)
+	}
+	if err != nil {
+		return diag.Errorf("Failed to read the state file %s: %v", t.tfStateFilePath, err)
+	}
+
+	log.Printf("Read %d resources from the state file %s", len(t.instances), t.tfStateFilePath)
+	return nil
+}
+
+func (t *TfStateFileReader) readStateV3(data []byte) error {
+	var tfstate terraform.State
+	if err := json.Unmarshal(data, &tfstate); err != nil {
+		return err
+	}
+
+	// Exports split into modules write the resources to the state of their module
+	for _, module := range tfstate.Modules {
+		for resourceKey, resourceState := range module.Resources {
+			// Only managed resources are restored. Data sources are resolved from the resource they replace.
+			if strings.HasPrefix(resourceKey, "data.") || resourceState.Primary == nil {
+				continue
+			}
+			resourceLabel := strings.TrimPrefix(resourceKey, resourceState.Type+".")
+			t.addInstance(resourceState.Type, resourceLabel, resourceState.Primary)
+		}
+	}
+	return nil
+}
+
+func (t *TfStateFileReader) readStateV4(data []byte, schemaProvider *schema.Provider) error {
+	var tfstate tfStateV4
+	if err := json.Unmarshal(data, &tfstate); err != nil {
+		return err
+	}
+
+	for _, resource := range tfstate.Resources {
+		if resource.Mode != "managed" {
+			continue
+		}
+		res := schemaProvider.ResourcesMap[resource.Type]
+		if res == nil {
+			log.Printf("Resource type %s from the state file is not defined. Skipping.", resource.Type)
+			continue
+		}
+
+		for _, instance := range resource.Instances {
+			instanceState, err := instanceStateFromV4(instance, res)
+			if err != nil {
+				log.Printf("Failed to restore the state of %s.%s from the state file. Skipping: %v", resource.Type, resource.Name, err)
+				continue
+			}
+			t.addInstance(resource.Type, resource.Name, instanceState)
+		}
+	}
+	return nil
+}
+
+func instanceStateFromV4(instance tfStateV4Instance, res *schema.Resource) (*terraform.InstanceState, error) {
+	var instanceState *terraform.InstanceState
+	if instance.AttributesFlat != nil {
+		instanceState = &terraform.InstanceState{
+			ID:         instance.AttributesFlat["id"],
+			Attributes: instance.AttributesFlat,
+			Meta:       map[string]interface{}{"schema_version": instance.SchemaVersion},
+		}
+	} else {
+		stateVal, err := ctyjson.Unmarshal(instance.Attributes, res.CoreConfigSchema().ImpliedType())
+		if err != nil {
+			return nil, err
+		}
+		instanceState = terraform.NewInstanceStateShimmedFromValue(stateVal, instance.SchemaVersion)
+	}
+
+	// The Terraform CLI moves the meta data of version 3 states into the private data of version 4 states
+	if len(instance.Private) > 0 {
+		private := make(map[string]interface{})
+		if err := json.Unmarshal(instance.Private, &private); err != nil {
+			return nil, err
+		}
+		for k, v := range private {
+			instanceState.Meta[k] = v
+		}
+	}
+	return instanceState, nil
+}
+
+func (t *TfStateFileReader) addInstance(resourceType, resourceLabel string, instanceState *terraform.InstanceState) {
+	if instanceState.ID == "" {
+		return
+	}
+	version, _ := instanceState.Meta[exportVersionMetaKey].(string)
+	t.instances[resourceType+"."+instanceState.ID] = &tfStateInstance{
+		resourceType:  resourceType,
+		resourceLabel: resourceLabel,
+		state:         instanceState,
+		version:       version,
+	}
+}
+
+// getUnchangedState returns a copy of the state of a resource from the previous export if the version of the resource
+// is known and did not change since that export. Otherwise nil is returned and the resource must be read again.
+func (t *TfStateFileReader) getUnchangedState(resourceType, id, version string) *terraform.InstanceState {
+	if version == "" {
+		return nil
+	}
+	instance, ok := t.instances[resourceType+"."+id]
+	if !ok || instance.version != version {
+		return nil
+	}
+	return instance.state.DeepCopy()
+}
+
+// getInstances returns the managed resources read from the state file sorted by resource type and label
+func (t *TfStateFileReader) getInstances() []*tfStateInstance {
+	instances := make([]*tfStateInstance, 0, len(t.instances))
+	for _, instance := range t.instances {
+		instances = append(instances, instance)
+	}
+	sort.Slice(instances, func(i, j int) bool {
+		if instances[i].resourceType != instances[j].resourceType {
+			return instances[i].resourceType < instances[j].resourceType
+		}
+		return instances[i].resourceLabel < instances[j].resourceLabel
+	})
+	return instances
+}
@@ -0,0 +1,146 @@
+package tfexporter
+
+import (
+	"encoding/json"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestUnitTfStateFileReaderStateV3(t *testing.T) {
+	tfstate := terraform.NewState()
+	rootModule := tfstate.RootModule()
+	rootModule.Resources["genesyscloud_routing_queue.queue_1"] = &terraform.ResourceState{
+		Type: "genesyscloud_routing_queue",
+		Primary: &terraform.InstanceState{
+			ID:         "queue-id-1",
+			Attributes: map[string]string{"id": "queue-id-1", "name": "queue 1"},
+			Meta:       map[string]interface{}{exportVersionMetaKey: "2024-01-01 00:00:00 +0000 UTC"},
+		},
+	}
+	rootModule.Resources["genesyscloud_routing_queue.queue_2"] = &terraform.ResourceState{
+		Type: "genesyscloud_routing_queue",
+		Primary: &terraform.InstanceState{
+			ID:         "queue-id-2",
+			Attributes: map[string]string{"id": "queue-id-2", "name": "queue 2"},
+		},
+	}
+	rootModule.Resources["data.genesyscloud_routing_skill.skill_1"] = &terraform.ResourceState{
+		Type: "genesyscloud_routing_skill",
+		Primary: &terraform.InstanceState{
+			ID:         "skill-id-1",
+			Attributes: map[string]string{"name": "skill 1"},
+			Meta:       map[string]interface{}{exportVersionMetaKey: "1"},
+		},
+	}
+	// Exports split into modules write the resources to the state of their module
+	tfstate.AddModuleState(&terraform.ModuleState{
+		Path: []string{"root", "architect"},
+		Resources: map[string]*terraform.ResourceState{
+			"genesyscloud_flow.flow_1": {
+				Type: "genesyscloud_flow",
+				Primary: &terraform.InstanceState{
+					ID:         "flow-id-1",
+					Attributes: map[string]string{"id": "flow-id-1", "name": "flow 1"},
+					Meta:       map[string]interface{}{exportVersionMetaKey: "2.0"},
+				},
+			},
+		},
+	})
+
+	data, err := json.Marshal(tfstate)
+	if err != nil {
+		t.Fatalf("Failed to marshal state: %v", err)
+	}
+	reader := writeAndReadPreviousExport(t, data, &schema.Provider{})
+
+	state := reader.getUnchangedState("genesyscloud_routing_queue", "queue-id-1", "2024-01-01 00:00:00 +0000 UTC")
+	if assert.NotNil(t, state, "Expected unchanged queue to be restored from the previous export") {
+		assert.Equal(t, "queue 1", state.Attributes["name"])
+	}
+
+	assert.Nil(t, reader.getUnchangedState("genesyscloud_routing_queue", "queue-id-1", "2024-02-01 00:00:00 +0000 UTC"), "Expected modified queue to be read again")
+	assert.Nil(t, reader.getUnchangedState("genesyscloud_routing_queue", "queue-id-2", ""), "Expected queue without a version to be read again")
+	assert.Nil(t, reader.getUnchangedState("genesyscloud_routing_queue", "queue-id-3", "1"), "Expected new queue to be read")
+	assert.Nil(t, reader.getUnchangedState("genesyscloud_routing_skill", "skill-id-1", "1"), "Expected data sources to be read again")
+	assert.NotNil(t, reader.getUnchangedState("genesyscloud_flow", "flow-id-1", "2.0"), "Expected unchanged flow to be restored from the state of its module")
+
+	instances := reader.getInstances()
+	if assert.Len(t, instances, 3) {
+		assert.Equal(t, "flow_1", instances[0].resourceLabel)
+		assert.Equal(t, "queue_1", instances[1].resourceLabel)
+		assert.Equal(t, "queue_2", instances[2].resourceLabel)
+	}
+}
+
+func TestUnitTfStateFileReaderStateV4(t *testing.T) {
+	private, _ := json.Marshal(map[string]interface{}{exportVersionMetaKey: "7"})
+	tfstate := tfStateV4{
+		Version: 4,
+		Resources: []tfStateV4Resource{
+			{
+				Mode: "managed",
+				Type: "example_resource",
+				Name: "flat",
+				Instances: []tfStateV4Instance{
+					{
+						AttributesFlat: map[string]string{"id": "flat-id", "name": "flat"},
+						Private:        private,
+					},
+				},
+			},
+			{
+				Mode: "managed",
+				Type: "example_resource",
+				Name: "json",
+				Instances: []tfStateV4Instance{
+					{
+						Attributes: json.RawMessage(`{"id": "json-id", "name": "json"}`),
+						Private:    private,
+					},
+				},
+			},
+		},
+	}
+
+	data, err := json.Marshal(tfstate)
+	if err != nil {
+		t.Fatalf("Failed to marshal state: %v", err)
+	}
+
+	schemaProvider := &schema.Provider{
+		ResourcesMap: map[string]*schema.Resource{
+			"example_resource": {
+				Schema: map[string]*schema.Schema{
+					"name": {Type: schema.TypeString, Optional: true},
+				},
+			},
+		},
+	}
+
+	reader := writeAndReadPreviousExport(t, data, schemaProvider)
+
+	for _, id := range []string{"flat-id", "json-id"} {
+		state := reader.getUnchangedState("example_resource", id, "7")
+		if assert.NotNil(t, state, "Expected %s to be restored from the previous export", id) {
+			assert.Equal(t, id, state.ID)
+		}
+	}
+}
+
+func writeAndReadPreviousExport(t *testing.T, data []byte, schemaProvider *schema.Provider) *TfStateFileReader {
+	stateFilePath := filepath.Join(t.TempDir(), defaultTfStateFile)
+	if err := os.WriteFile(stateFilePath, data, os.ModePerm); err != nil {
+		t.Fatalf("Failed to write state file: %v", err)
+	}
+
+	reader := NewTfStateFileReader(stateFilePath)
+	if diagErr := reader.readTfStateFile(schemaProvider); diagErr != nil {
+		t.Fatalf("Failed to read previous export: %v", diagErr)
+	}
+	return reader
+}
@@ -6,6 +6,7 @@ import (
 	"log"
 	"net/http"
 	rc "terraform-provider-genesyscloud/genesyscloud/resource_cache"
+	"terraform-provider-genesyscloud/genesyscloud/util/lists"
 	"time"
 
 	"github.com/mypurecloud/platform-client-sdk-go/v152/platformclientv2"
@@ -20,9 +21,11 @@ to isolate and test individual functions more easily. For testing purposes, we c
 simulate these smaller parts, known as stubs, to ensure that each function behaves correctly in different scenarios.
 */
 
-// internalProxy holds a proxy instance that can be used throughout the package
+// internalProxy holds a proxy instance that unit tests can set to replace the proxy of the package
 var internalProxy *userProxy
 
+var userCache = rc.NewResourceCache[platformclientv2.User]() // Create Cache for User resource
+
 // Type definitions for each func on our proxy so we can easily mock them out later
 type createUserFunc func(ctx context.Context, p *userProxy, createUser *platformclientv2.Createuser) (*platformclientv2.User, *platformclientv2.APIResponse, error)
 type GetAllUserFunc func(ctx context.Context, p *userProxy) (*[]platformclientv2.User, *platformclientv2.APIResponse, error)
@@ -74,7 +77,6 @@ func newUserProxy(clientConfig *platformclientv2.Configuration) *userProxy {
 	userApi := platformclientv2.NewUsersApiWithConfig(clientConfig)      // NewUsersApiWithConfig creates an Genesyc Cloud API instance using the provided configuration
 	routingApi := platformclientv2.NewRoutingApiWithConfig(clientConfig) // NewRoutingApiWithConfig creates an Genesyc Cloud API instance using the provided configuration
 	voicemailApi := platformclientv2.NewVoicemailApiWithConfig(clientConfig)
-	userCache := rc.NewResourceCache[platformclientv2.User]() // Create Cache for User resource
 	return &userProxy{
 		clientConfig:                      clientConfig,
 		userApi:                           userApi,
@@ -97,17 +99,16 @@ func newUserProxy(clientConfig *platformclientv2.Configuration) *userProxy {
 }
 
 /*
-The function getUserProxy serves a dual purpose: first, it functions as a singleton for
-the internalProxy, meaning it ensures that only one instance of the internalProxy exists. Second,
-it enables us to proxy our tests by allowing us to directly set the internalProxy package variable.
-This ensures consistency and control in managing the internalProxy across our codebase, while also
-facilitating efficient testing by providing a straightforward way to substitute the proxy for testing purposes.
+The function getUserProxy serves a dual purpose: first, it returns a new proxy for the client config of every
+call, so provider configurations for several orgs never share a proxy. Second, it enables us to proxy our tests by
+allowing us to directly set the internalProxy package variable, which facilitates efficient testing by providing a
+straightforward way to substitute the proxy for testing purposes.
 */
 func GetUserProxy(clientConfig *platformclientv2.Configuration) *userProxy {
-	if internalProxy == nil {
-		internalProxy = newUserProxy(clientConfig)
+	if internalProxy != nil {
+		return internalProxy
 	}
-	return internalProxy
+	return newUserProxy(clientConfig)
 }
 
 // createUser creates a Genesys Cloud User
@@ -125,10 +126,18 @@ func (p *userProxy) getUserIdByName(ctx context.Context, name string) (id string
 	return p.getUserIdByNameAttr(ctx, p, name)
 }
 
-// getUserById returns a single Genesys Cloud User by Id
+// getUserById returns a single Genesys Cloud User by Id. Cached users only hold the expands of cachedUserExpands, so reads
+// that need any other expand always call the API.
 func (p *userProxy) getUserById(ctx context.Context, id string, expand []string, state string) (user *platformclientv2.User, response *platformclientv2.APIResponse, err error) {
-	if user := rc.GetCacheItem(p.userCache, id); user != nil { // Get the user from the cache, if not there in the cache then call p.getUserByIdAttr()
-		return user, nil, nil
+	// Only active and inactive users are listed into the cache, so reads of users in any other state always call the API
+	if state == "" && isCachedUserExpand(expand) {
+		rc.HydrateCache(ctx, p.userCache, func(ctx context.Context) error {
+			_, _, err := p.GetAllUser(ctx)
+			return err
+		})
+		if user := rc.GetCacheItem(ctx, p.userCache, id); user != nil { // Get the user from the cache, if not there in the cache then call p.getUserByIdAttr()
+			return user, nil, nil
+		}
 	}
 	return p.getUserByIdAttr(ctx, p, id, expand, state)
 }
@@ -212,6 +221,26 @@ func updateUserFn(ctx context.Context, p *userProxy, id string, updateUser *plat
 }
 
 // getAllUserFn is the implementation for retrieving all user in Genesys Cloud
+// cachedUserExpands are the expands of the users listed by GetAllUserFn, and so of the users in the cache
+var cachedUserExpands = []string{
+	"skills",
+	"languages",
+	"locations",
+	"profileSkills",
+	"certifications",
+	"employerInfo",
+}
+
+// isCachedUserExpand reports whether a cached user holds every expand a read asks for
+func isCachedUserExpand(expand []string) bool {
+	for _, e := range expand {
+		if !lists.ItemInSlice(e, cachedUserExpands) {
+			return false
+		}
+	}
+	return true
+}
+
 func GetAllUserFn(ctx context.Context, p *userProxy) (*[]platformclientv2.User, *platformclientv2.APIResponse, error) {
 
 	//Newly created resources often aren't returned unless there's a delay
@@ -221,23 +250,14 @@ func GetAllUserFn(ctx context.Context, p *userProxy) (*[]platformclientv2.User,
 	getUsersByStatus := func(userStatus string) (*[]platformclientv2.User, *platformclientv2.APIResponse, error) {
 		users := []platformclientv2.User{}
 		const pageSize = 100
-		expandedAttributes := []string{
-			// Expands
-			"skills",
-			"languages",
-			"locations",
-			"profileSkills",
-			"certifications",
-			"employerInfo",
-		}
-		usersList, apiResponse, err := p.userApi.GetUsers(pageSize, 1, nil, nil, "", expandedAttributes, "", userStatus)
+		usersList, apiResponse, err := p.userApi.GetUsers(pageSize, 1, nil, nil, "", cachedUserExpands, "", userStatus)
 		if err != nil {
 			return nil, apiResponse, err
 		}
 		users = append(users, *usersList.Entities...)
 
 		for pageNum := 2; pageNum <= *usersList.PageCount; pageNum++ {
-			usersList, apiResponse, err := p.userApi.GetUsers(pageSize, pageNum, nil, nil, "", expandedAttributes, "", userStatus)
+			usersList, apiResponse, err := p.userApi.GetUsers(pageSize, pageNum, nil, nil, "", cachedUserExpands, "", userStatus)
 
 			//DEVTOOLING-862 - This is a blocker for the BCP team as before this if check was put in the code would fail when it hit 10K of inactive users.
 			//The BCP team (Cesar Branco has asked to write a warning to the log) and just return what we currently have.
@@ -34,10 +34,10 @@ func newUserRolesProxy(clientConfig *platformclientv2.Configuration) *userRolesP
 }
 
 func getUserRolesProxy(clientConfig *platformclientv2.Configuration) *userRolesProxy {
-	if internalProxy == nil {
-		internalProxy = newUserRolesProxy(clientConfig)
+	if internalProxy != nil {
+		return internalProxy
 	}
-	return internalProxy
+	return newUserRolesProxy(clientConfig)
 }
 
 func (p *userRolesProxy) getUserRolesById(ctx context.Context, roleId string) (*[]platformclientv2.Authzgrant, *platformclientv2.APIResponse, error) {
@@ -0,0 +1,306 @@
+package httprecorder
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"io"
+	"log"
+	"net"
+	"net/http"
+	"os"
+	"path/filepath"
+	"regexp"
+	"strings"
+	"sync"
+)
+
+/*
+The HTTP recorder records the API interactions of the Go SDK to cassette files and replays them, so acceptance tests can
+run without a Genesys Cloud org. While a recorder mode is set, the base path of every SDK client points to a local server.
+In record mode the server forwards requests to the API and stores the responses. In replay mode it answers requests with
+the stored responses, matched by method and path in the order they were recorded.
+
+Secrets are never written to cassettes: token requests, request headers and request bodies are not stored, and tokens,
+secrets and passwords in response bodies are replaced. In replay mode every token request is granted, so any credentials
+can be used.
+*/
+
+type Mode string
+
+const (
+	ModeRecord Mode = "record"
+	ModeReplay Mode = "replay"
+
+	// ModeEnvVar selects the mode of acceptance tests that use a cassette. Tests run against the org if it is not set.
+	ModeEnvVar = "GENESYSCLOUD_HTTP_RECORDER_MODE"
+
+	authPath = "/oauth/token"
+)
+
+// Interaction is a recorded request and its response
+type Interaction struct {
+	Method      string `json:"method"`
+	Path        string `json:"path"`
+	Query       string `json:"query,omitempty"`
+	StatusCode  int    `json:"status_code"`
+	ContentType string `json:"content_type,omitempty"`
+	Body        string `json:"body,omitempty"`
+}
+
+type cassette struct {
+	Interactions []Interaction `json:"interactions"`
+}
+
+type recorder struct {
+	mutex        sync.Mutex
+	listenerURL  string
+	mode         Mode
+	cassettePath string
+	upstream     string
+	interactions []Interaction
+	used         []bool
+}
+
+// The server is started once and kept for the lifetime of the process, because SDK clients keep the base path they
+// were created with
+var (
+	activeRecorder = &recorder{}
+	startOnce      sync.Once
+	startErr       error
+)
+
+var apiHostRegex = regexp.MustCompile(`(?i)//api\.`)
+
+var replayedTokenInteraction = Interaction{
+	StatusCode:  http.StatusOK,
+	ContentType: "application/json",
+	Body:        `{"access_token":"` + scrubbedValue + `","token_type":"bearer","expires_in":86400}`,
+}
+
+// GetMode returns the mode set in the environment, or an empty mode if tests run against the org
+func GetMode() Mode {
+	return Mode(strings.ToLower(os.Getenv(ModeEnvVar)))
+}
+
+// LoadCassette starts recording to or replaying from a cassette file. Recorded cassettes are written by SaveCassette.
+func LoadCassette(mode Mode, cassettePath string) error {
+	if mode != ModeRecord && mode != ModeReplay {
+		return fmt.Errorf("unknown HTTP recorder mode %q", mode)
+	}
+	if err := start(); err != nil {
+		return err
+	}
+
+	var loaded cassette
+	if mode == ModeReplay {
+		data, err := os.ReadFile(cassettePath)
+		if err != nil {
+			return fmt.Errorf("failed to read cassette %s: %v", cassettePath, err)
+		}
+		if err := json.Unmarshal(data, &loaded); err != nil {
+			return fmt.Errorf("failed to parse cassette %s: %v", cassettePath, err)
+		}
+	}
+
+	activeRecorder.mutex.Lock()
+	defer activeRecorder.mutex.Unlock()
+	activeRecorder.mode = mode
+	activeRecorder.cassettePath = cassettePath
+	activeRecorder.interactions = loaded.Interactions
+	activeRecorder.used = make([]bool, len(loaded.Interactions))
+	log.Printf("HTTP recorder is in %s mode with cassette %s", mode, cassettePath)
+	return nil
+}
+
+// SaveCassette writes the interactions of record mode to the cassette file and unloads the cassette
+func SaveCassette() error {
+	activeRecorder.mutex.Lock()
+	defer activeRecorder.mutex.Unlock()
+	defer func() {
+		activeRecorder.mode = ""
+		activeRecorder.interactions = nil
+		activeRecorder.used = nil
+	}()
+	if activeRecorder.mode != ModeRecord {
+		return nil
+	}
+
+	data, err := json.MarshalIndent(cassette{Interactions: activeRecorder.interactions}, "", "  ")
+	if err != nil {
+		return fmt.Errorf("failed to marshal cassette %s: %v", activeRecorder.cassettePath, err)
+	}
+	if err := os.MkdirAll(filepath.Dir(activeRecorder.cassettePath), os.ModePerm); err != nil {
+		return fmt.Errorf("failed to create cassette directory: %v", err)
+	}
+	if err := os.WriteFile(activeRecorder.cassettePath, data, 0644); err != nil {
+		return fmt.Errorf("failed to write cassette %s: %v", activeRecorder.cassettePath, err)
+	}
+	log.Printf("Recorded %d API interactions to %s", len(activeRecorder.interactions), activeRecorder.cassettePath)
+	return nil
+}
+
+// Target returns the base path an SDK client has to use. While a recorder mode is set in the environment, it is the URL of
+// the local server, which forwards requests to the given base path unless they are replayed.
+func Target(basePath string) string {
+	if GetMode() == "" {
+		return basePath
+	}
+	if err := start(); err != nil {
+		log.Printf("%v", err)
+		return basePath
+	}
+	activeRecorder.mutex.Lock()
+	defer activeRecorder.mutex.Unlock()
+	if basePath != activeRecorder.listenerURL {
+		activeRecorder.upstream = basePath
+	}
+	return activeRecorder.listenerURL
+}
+
+func start() error {
+	startOnce.Do(func() {
+		listener, err := net.Listen("tcp", "127.0.0.1:0")
+		if err != nil {
+			startErr = fmt.Errorf("failed to start the HTTP recorder: %v", err)
+			return
+		}
+		activeRecorder.listenerURL = "http://" + listener.Addr().String()
+		go func() {
+			_ = http.Serve(listener, activeRecorder)
+		}()
+	})
+	return startErr
+}
+
+func (r *recorder) ServeHTTP(w http.ResponseWriter, request *http.Request) {
+	r.mutex.Lock()
+	mode := r.mode
+	upstream := r.upstream
+	r.mutex.Unlock()
+
+	// Clients are also created outside of tests with a cassette, e.g. in TestMain, so the mode of the environment is
+	// used while no cassette is loaded
+	if mode == "" {
+		mode = GetMode()
+	}
+	if mode == ModeReplay {
+		r.replay(w, request)
+		return
+	}
+	r.forward(w, request, upstream, mode == ModeRecord && r.isLoaded())
+}
+
+func (r *recorder) isLoaded() bool {
+	r.mutex.Lock()
+	defer r.mutex.Unlock()
+	return r.mode != ""
+}
+
+// replay answers a request with the first unused interaction of the same method and path. Interactions with the same
+// query are preferred, because queries may contain generated names that differ between runs. Token requests are not
+// recorded, so they are always granted.
+func (r *recorder) replay(w http.ResponseWriter, request *http.Request) {
+	if request.URL.Path == authPath {
+		writeInteraction(w, replayedTokenInteraction)
+		return
+	}
+
+	r.mutex.Lock()
+	match := -1
+	for i, interaction := range r.interactions {
+		if r.used[i] || interaction.Method != request.Method || interaction.Path != request.URL.Path {
+			continue
+		}
+		if interaction.Query == request.URL.RawQuery {
+			match = i
+			break
+		}
+		if match == -1 {
+			match = i
+		}
+	}
+	var interaction Interaction
+	if match != -1 {
+		r.used[match] = true
+		interaction = r.interactions[match]
+	}
+	r.mutex.Unlock()
+
+	if match == -1 {
+		// 501 is not retried by the Go SDK, so unrecorded requests fail right away
+		log.Printf("HTTP recorder has no interaction for %s %s", request.Method, request.URL.String())
+		http.Error(w, fmt.Sprintf("no recorded interaction for %s %s", request.Method, request.URL.Path), http.StatusNotImplemented)
+		return
+	}
+	writeInteraction(w, interaction)
+}
+
+// forward sends a request to the API and, if record is set, stores its response
+func (r *recorder) forward(w http.ResponseWriter, request *http.Request, upstream string, record bool) {
+	if upstream == "" {
+		http.Error(w, "the HTTP recorder has no API to forward requests to", http.StatusNotImplemented)
+		return
+	}
+	target := upstream
+	if request.URL.Path == authPath {
+		target = apiHostRegex.ReplaceAllString(upstream, "//login.")
+	}
+
+	body, err := io.ReadAll(request.Body)
+	if err != nil {
+		http.Error(w, err.Error(), http.StatusBadGateway)
+		return
+	}
+	forwarded, err := http.NewRequestWithContext(request.Context(), request.Method, target+request.URL.RequestURI(), bytes.NewReader(body))
+	if err != nil {
+		http.Error(w, err.Error(), http.StatusBadGateway)
+		return
+	}
+	forwarded.Header = request.Header.Clone()
+	// The transport of the SDK asks for compressed responses on its own. Without the header, the transport of the
+	// recorder asks for them instead and decompresses them, so responses are scrubbed and returned as plain bodies.
+	forwarded.Header.Del("Accept-Encoding")
+
+	response, err := http.DefaultClient.Do(forwarded)
+	if err != nil {
+		http.Error(w, err.Error(), http.StatusBadGateway)
+		return
+	}
+	defer response.Body.Close()
+	responseBody, err := io.ReadAll(response.Body)
+	if err != nil {
+		http.Error(w, err.Error(), http.StatusBadGateway)
+		return
+	}
+
+	// Rate limited requests are retried by the Go SDK, so replaying them would only slow tests down
+	if record && response.StatusCode != http.StatusTooManyRequests && request.URL.Path != authPath {
+		r.mutex.Lock()
+		r.interactions = append(r.interactions, Interaction{
+			Method:      request.Method,
+			Path:        request.URL.Path,
+			Query:       request.URL.RawQuery,
+			StatusCode:  response.StatusCode,
+			ContentType: response.Header.Get("Content-Type"),
+			Body:        string(scrubBody(responseBody)),
+		})
+		r.mutex.Unlock()
+	}
+
+	for _, header := range []string{"Content-Type", "Retry-After"} {
+		if value := response.Header.Get(header); value != "" {
+			w.Header().Set(header, value)
+		}
+	}
+	w.WriteHeader(response.StatusCode)
+	_, _ = w.Write(responseBody)
+}
+
+func writeInteraction(w http.ResponseWriter, interaction Interaction) {
+	if interaction.ContentType != "" {
+		w.Header().Set("Content-Type", interaction.ContentType)
+	}
+	w.WriteHeader(interaction.StatusCode)
+	_, _ = w.Write([]byte(interaction.Body))
+}
@@ -0,0 +1,159 @@
+package httprecorder
+
+import (
+	"bytes"
+	"compress/gzip"
+	"encoding/json"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+)
+
+func TestUnitRecordAndReplay(t *testing.T) {
+	api := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Content-Type", "application/json")
+		switch r.URL.Path {
+		case authPath:
+			_, _ = w.Write([]byte(`{"access_token":"live-token","expires_in":86400}`))
+		case "/api/v2/routing/queues":
+			_, _ = w.Write([]byte(`{"entities":[{"id":"queue-1","name":"` + r.URL.Query().Get("name") + `"}]}`))
+		default:
+			w.WriteHeader(http.StatusNotFound)
+		}
+	}))
+	defer api.Close()
+	cassettePath := filepath.Join(t.TempDir(), "cassette.json")
+
+	t.Setenv(ModeEnvVar, string(ModeRecord))
+	if err := LoadCassette(ModeRecord, cassettePath); err != nil {
+		t.Fatalf("failed to load cassette: %v", err)
+	}
+	target := Target(api.URL)
+	if target == api.URL {
+		t.Fatalf("expected the base path to point to the recorder")
+	}
+	if body := get(t, target+authPath, http.StatusOK); !strings.Contains(body, "live-token") {
+		t.Errorf("expected the token of the API in record mode, got %s", body)
+	}
+	get(t, target+"/api/v2/routing/queues?name=first", http.StatusOK)
+	get(t, target+"/api/v2/routing/queues?name=second", http.StatusOK)
+	if err := SaveCassette(); err != nil {
+		t.Fatalf("failed to save cassette: %v", err)
+	}
+
+	data, err := os.ReadFile(cassettePath)
+	if err != nil {
+		t.Fatalf("failed to read cassette: %v", err)
+	}
+	var saved cassette
+	if err := json.Unmarshal(data, &saved); err != nil {
+		t.Fatalf("failed to parse cassette: %v", err)
+	}
+	if len(saved.Interactions) != 2 || strings.Contains(string(data), "live-token") {
+		t.Fatalf("expected only the queue interactions to be recorded, got %s", data)
+	}
+
+	t.Setenv(ModeEnvVar, string(ModeReplay))
+	if err := LoadCassette(ModeReplay, cassettePath); err != nil {
+		t.Fatalf("failed to load cassette: %v", err)
+	}
+	defer SaveCassette()
+	api.Close()
+
+	if body := get(t, target+authPath, http.StatusOK); !strings.Contains(body, scrubbedValue) {
+		t.Errorf("expected a replayed token, got %s", body)
+	}
+	if body := get(t, target+"/api/v2/routing/queues?name=second", http.StatusOK); !strings.Contains(body, `"second"`) {
+		t.Errorf("expected the interaction with the same query to be replayed, got %s", body)
+	}
+	if body := get(t, target+"/api/v2/routing/queues?name=other", http.StatusOK); !strings.Contains(body, `"first"`) {
+		t.Errorf("expected the remaining interaction of the path to be replayed, got %s", body)
+	}
+	get(t, target+"/api/v2/routing/queues?name=first", http.StatusNotImplemented)
+}
+
+func TestUnitRecordCompressedResponse(t *testing.T) {
+	api := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		body := []byte(`{"id":"client-1","secret":"s3cret"}`)
+		w.Header().Set("Content-Type", "application/json")
+		if strings.Contains(r.Header.Get("Accept-Encoding"), "gzip") {
+			var compressed bytes.Buffer
+			writer := gzip.NewWriter(&compressed)
+			_, _ = writer.Write(body)
+			_ = writer.Close()
+			w.Header().Set("Content-Encoding", "gzip")
+			body = compressed.Bytes()
+		}
+		_, _ = w.Write(body)
+	}))
+	defer api.Close()
+	cassettePath := filepath.Join(t.TempDir(), "cassette.json")
+
+	t.Setenv(ModeEnvVar, string(ModeRecord))
+	if err := LoadCassette(ModeRecord, cassettePath); err != nil {
+		t.Fatalf("failed to load cassette: %v", err)
+	}
+	target := Target(api.URL)
+
+	// The transport of the SDK sets Accept-Encoding itself and decompresses the response
+	request, err := http.NewRequest(http.MethodGet, target+"/api/v2/oauth/clients/client-1", nil)
+	if err != nil {
+		t.Fatalf("failed to create request: %v", err)
+	}
+	request.Header.Set("Accept-Encoding", "gzip")
+	response, err := http.DefaultClient.Do(request)
+	if err != nil {
+		t.Fatalf("request failed: %v", err)
+	}
+	body, _ := io.ReadAll(response.Body)
+	response.Body.Close()
+	if response.Header.Get("Content-Encoding") != "" || !strings.Contains(string(body), "s3cret") {
+		t.Errorf("expected the plain response of the API, got %q with Content-Encoding %q", body, response.Header.Get("Content-Encoding"))
+	}
+	if err := SaveCassette(); err != nil {
+		t.Fatalf("failed to save cassette: %v", err)
+	}
+
+	data, err := os.ReadFile(cassettePath)
+	if err != nil {
+		t.Fatalf("failed to read cassette: %v", err)
+	}
+	if strings.Contains(string(data), "s3cret") || !strings.Contains(string(data), "client-1") {
+		t.Errorf("expected the recorded response to be decompressed and scrubbed, got %s", data)
+	}
+}
+
+func TestUnitScrubBody(t *testing.T) {
+	body := scrubBody([]byte(`{"name":"client","clientSecret":"s3cret","credentials":[{"password":"p4ss","count":1}],"token":{"id":"1"}}`))
+	for _, secret := range []string{"s3cret", "p4ss"} {
+		if strings.Contains(string(body), secret) {
+			t.Errorf("expected %s to be scrubbed from %s", secret, body)
+		}
+	}
+	for _, kept := range []string{`"name":"client"`, `"count":1`, `"id":"1"`} {
+		if !strings.Contains(string(body), kept) {
+			t.Errorf("expected %s to be kept in %s", kept, body)
+		}
+	}
+
+	if scrubbed := scrubBody([]byte("not json")); string(scrubbed) != "not json" {
+		t.Errorf("expected a body that is not JSON to be kept, got %s", scrubbed)
+	}
+}
+
+func get(t *testing.T, url string, expectedStatus int) string {
+	response, err := http.Get(url)
+	if err != nil {
+		t.Fatalf("request to %s failed: %v", url, err)
+	}
+	defer response.Body.Close()
+	body, _ := io.ReadAll(response.Body)
+	if response.StatusCode != expectedStatus {
+		t.Fatalf("expected status %d for %s, got %d: %s", expectedStatus, url, response.StatusCode, body)
+	}
+	return string(body)
+}
@@ -0,0 +1,56 @@
+package httprecorder
+
+import (
+	"encoding/json"
+	"strings"
+)
+
+const scrubbedValue = "REDACTED"
+
+// Keys of JSON values that are replaced before a response is written to a cassette
+var secretKeys = []string{"token", "secret", "password", "credential"}
+
+// scrubBody replaces the secrets in a JSON response body. Bodies that are not JSON are kept as they are.
+func scrubBody(body []byte) []byte {
+	var value interface{}
+	if err := json.Unmarshal(body, &value); err != nil {
+		return body
+	}
+	scrubbed, err := json.Marshal(scrubValue(value))
+	if err != nil {
+		return body
+	}
+	return scrubbed
+}
+
+func scrubValue(value interface{}) interface{} {
+	switch typed := value.(type) {
+	case map[string]interface{}:
+		for key, nested := range typed {
+			if _, isString := nested.(string); isString && isSecretKey(key) {
+				typed[key] = scrubbedValue
+				continue
+			}
+			typed[key] = scrubValue(nested)
+		}
+		return typed
+	case []interface{}:
+		for i, nested := range typed {
+			typed[i] = scrubValue(nested)
+		}
+		return typed
+	default:
+		return value
+	}
+}
+
+// isSecretKey returns true for keys such as access_token, clientSecret or password
+func isSecretKey(key string) bool {
+	lowerKey := strings.ToLower(key)
+	for _, secretKey := range secretKeys {
+		if strings.Contains(lowerKey, secretKey) {
+			return true
+		}
+	}
+	return false
+}
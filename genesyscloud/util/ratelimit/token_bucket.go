@@ -0,0 +1,125 @@
+package ratelimit
+
+import (
+	"context"
+	"net/http"
+	"strconv"
+	"strings"
+	"sync"
+	"time"
+)
+
+// TokenBucket limits how often an operation can be started. Tokens are added at a fixed rate up to the capacity of the
+// bucket and every operation takes one token. The bucket can be paused, e.g. when the API responds with a Retry-After header.
+type TokenBucket struct {
+	mutex       sync.Mutex
+	rate        float64
+	capacity    float64
+	tokens      float64
+	lastRefill  time.Time
+	pausedUntil time.Time
+}
+
+// NewTokenBucket creates a bucket that allows ratePerSecond operations per second with bursts of up to burst operations.
+// A bucket with a rate of 0 does not limit operations but can still be paused.
+func NewTokenBucket(ratePerSecond float64, burst int) *TokenBucket {
+	if burst < 1 {
+		burst = 1
+	}
+	return &TokenBucket{
+		rate:       ratePerSecond,
+		capacity:   float64(burst),
+		tokens:     float64(burst),
+		lastRefill: time.Now(),
+	}
+}
+
+// Wait blocks until a token is available or the context is done
+func (b *TokenBucket) Wait(ctx context.Context) error {
+	if b == nil {
+		return nil
+	}
+	for {
+		delay := b.reserve()
+		if delay <= 0 {
+			return nil
+		}
+
+		timer := time.NewTimer(delay)
+		select {
+		case <-ctx.Done():
+			timer.Stop()
+			return ctx.Err()
+		case <-timer.C:
+		}
+	}
+}
+
+// reserve takes a token if one is available. Otherwise it returns how long to wait before trying again.
+func (b *TokenBucket) reserve() time.Duration {
+	b.mutex.Lock()
+	defer b.mutex.Unlock()
+
+	now := time.Now()
+	if now.Before(b.pausedUntil) {
+		return b.pausedUntil.Sub(now)
+	}
+	if b.rate <= 0 {
+		return 0
+	}
+
+	b.tokens += now.Sub(b.lastRefill).Seconds() * b.rate
+	if b.tokens > b.capacity {
+		b.tokens = b.capacity
+	}
+	b.lastRefill = now
+
+	if b.tokens >= 1 {
+		b.tokens--
+		return 0
+	}
+	return time.Duration((1 - b.tokens) / b.rate * float64(time.Second))
+}
+
+// PauseFor stops handing out tokens for the given duration. Pauses do not shorten a pause that is already in effect.
+func (b *TokenBucket) PauseFor(duration time.Duration) {
+	if b == nil || duration <= 0 {
+		return
+	}
+	b.mutex.Lock()
+	defer b.mutex.Unlock()
+
+	pausedUntil := time.Now().Add(duration)
+	if pausedUntil.After(b.pausedUntil) {
+		b.pausedUntil = pausedUntil
+	}
+	b.tokens = 0
+}
+
+// ObserveResponse pauses the bucket for the duration of the Retry-After header of a 429 response
+func (b *TokenBucket) ObserveResponse(response *http.Response) {
+	if b == nil || response == nil || response.StatusCode != http.StatusTooManyRequests {
+		return
+	}
+	if retryAfter, ok := ParseRetryAfter(response.Header.Get("Retry-After")); ok {
+		b.PauseFor(retryAfter)
+	}
+}
+
+// ParseRetryAfter parses the value of a Retry-After header, which is either a number of seconds or an HTTP date
+func ParseRetryAfter(value string) (time.Duration, bool) {
+	value = strings.TrimSpace(value)
+	if value == "" {
+		return 0, false
+	}
+	if seconds, err := strconv.Atoi(value); err == nil {
+		if seconds < 0 {
+			return 0, false
+		}
+		return time.Duration(seconds) * time.Second, true
+	}
+	if date, err := http.ParseTime(value); err == nil {
+		return time.Until(date), true
+	}
+	return 0, false
+}
@@ -0,0 +1,78 @@
+package ratelimit
+
+import (
+	"context"
+	"net/http"
+	"testing"
+	"time"
+)
+
+func TestTokenBucketWait(t *testing.T) {
+	bucket := NewTokenBucket(20, 2)
+
+	start := time.Now()
+	for i := 0; i < 4; i++ {
+		if err := bucket.Wait(context.Background()); err != nil {
+			t.Fatalf("Unexpected error waiting for token: %v", err)
+		}
+	}
+	// The first two tokens are available immediately and the next two take 50ms each
+	if elapsed := time.Since(start); elapsed < 80*time.Millisecond {
+		t.Errorf("Expected waiting for 4 tokens to take at least 80ms, took %v", elapsed)
+	}
+}
+
+func TestTokenBucketUnlimited(t *testing.T) {
+	bucket := NewTokenBucket(0, 0)
+
+	start := time.Now()
+	for i := 0; i < 1000; i++ {
+		_ = bucket.Wait(context.Background())
+	}
+	if elapsed := time.Since(start); elapsed > time.Second {
+		t.Errorf("Expected an unlimited bucket not to wait, took %v", elapsed)
+	}
+
+	var nilBucket *TokenBucket
+	if err := nilBucket.Wait(context.Background()); err != nil {
+		t.Errorf("Expected a nil bucket not to wait, got %v", err)
+	}
+}
+
+func TestTokenBucketObserveResponse(t *testing.T) {
+	bucket := NewTokenBucket(0, 0)
+	bucket.ObserveResponse(&http.Response{
+		StatusCode: http.StatusTooManyRequests,
+		Header:     http.Header{"Retry-After": []string{"1"}},
+	})
+
+	ctx, cancel := context.WithTimeout(context.Background(), 100*time.Millisecond)
+	defer cancel()
+	if err := bucket.Wait(ctx); err == nil {
+		t.Errorf("Expected the bucket to be paused after a 429 response")
+	}
+
+	bucket = NewTokenBucket(0, 0)
+	bucket.ObserveResponse(&http.Response{
+		StatusCode: http.StatusOK,
+		Header:     http.Header{"Retry-After": []string{"1"}},
+	})
+	if err := bucket.Wait(ctx); err != nil {
+		t.Errorf("Expected the bucket not to be paused after a 200 response, got %v", err)
+	}
+}
+
+func TestParseRetryAfter(t *testing.T) {
+	if d, ok := ParseRetryAfter("3"); !ok || d != 3*time.Second {
+		t.Errorf("Expected 3s, got %v %v", d, ok)
+	}
+	date := time.Now().Add(10 * time.Second).UTC().Format(http.TimeFormat)
+	if d, ok := ParseRetryAfter(date); !ok || d <= 0 || d > 10*time.Second {
+		t.Errorf("Expected up to 10s for %s, got %v %v", date, d, ok)
+	}
+	for _, value := range []string{"", "-1", "soon"} {
+		if _, ok := ParseRetryAfter(value); ok {
+			t.Errorf("Expected %q not to be parsed", value)
+		}
+	}
+}
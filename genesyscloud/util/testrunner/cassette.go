@@ -0,0 +1,90 @@
+package testrunner
+
+import (
+	"fmt"
+	"os"
+	"path/filepath"
+	"strings"
+	"sync"
+	"testing"
+
+	"terraform-provider-genesyscloud/genesyscloud/util/httprecorder"
+
+	"github.com/google/uuid"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+)
+
+// Credentials used in replay mode if none are set, since the recorder grants every token request
+var replayCredentialEnvVars = map[string]string{
+	"GENESYSCLOUD_OAUTHCLIENT_ID":     "replay-client-id",
+	"GENESYSCLOUD_OAUTHCLIENT_SECRET": "replay-client-secret",
+}
+
+// Number of UUIDs generated by each test, so every UUID of a test is different but the same on every run
+var (
+	uuidCounters      = make(map[string]int)
+	uuidCountersMutex sync.Mutex
+)
+
+func GetTestCassettePath(elem ...string) string {
+	if !isRunningTests() {
+		return ""
+	}
+	basePath := filepath.Join(RootDir, "test", "cassettes")
+	subPath := filepath.Join(elem...)
+	return NormalizePath(filepath.Join(basePath, subPath))
+}
+
+// UseCassette records the API interactions of a test to its cassette, or replays them, depending on the mode set in
+// GENESYSCLOUD_HTTP_RECORDER_MODE. The test runs against the org if no mode is set.
+func UseCassette(t *testing.T) {
+	mode := httprecorder.GetMode()
+	if mode == "" {
+		return
+	}
+
+	if mode == httprecorder.ModeReplay {
+		for envVar, value := range replayCredentialEnvVars {
+			if os.Getenv(envVar) == "" {
+				t.Setenv(envVar, value)
+			}
+		}
+	}
+
+	cassettePath := GetTestCassettePath(strings.ReplaceAll(t.Name(), "/", "_") + ".json")
+	if err := httprecorder.LoadCassette(mode, cassettePath); err != nil {
+		t.Fatalf("%v", err)
+	}
+	t.Cleanup(func() {
+		if err := httprecorder.SaveCassette(); err != nil {
+			t.Errorf("%v", err)
+		}
+	})
+}
+
+// Test runs an acceptance test with its cassette. It can be used in place of resource.Test.
+func Test(t *testing.T, testCase resource.TestCase) {
+	UseCassette(t)
+	resource.Test(t, testCase)
+}
+
+// NewUUID returns a UUID for the names of objects created by a test, in place of uuid.NewString. While a cassette is
+// recorded or replayed, the UUIDs are derived from the name of the test, so a replayed test sends the same names as the
+// recorded one.
+func NewUUID(t *testing.T) string {
+	if httprecorder.GetMode() == "" {
+		return uuid.NewString()
+	}
+	uuidCountersMutex.Lock()
+	defer uuidCountersMutex.Unlock()
+	if _, ok := uuidCounters[t.Name()]; !ok {
+		// Start over when the test is run again, e.g. with -count
+		t.Cleanup(func() {
+			uuidCountersMutex.Lock()
+			defer uuidCountersMutex.Unlock()
+			delete(uuidCounters, t.Name())
+		})
+	}
+	uuidCounters[t.Name()]++
+	return uuid.NewSHA1(uuid.NameSpaceURL, []byte(fmt.Sprintf("%s/%d", t.Name(), uuidCounters[t.Name()]))).String()
+}
@@ -5,6 +5,9 @@ import (
 	"path/filepath"
 	"testing"
 
+	"terraform-provider-genesyscloud/genesyscloud/util/httprecorder"
+
+	"github.com/google/uuid"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	"github.com/stretchr/testify/assert"
 )
@@ -218,3 +221,19 @@ func TestGenerateTestDiff(t *testing.T) {
 		})
 	}
 }
+
+func TestNewUUID(t *testing.T) {
+	t.Setenv(httprecorder.ModeEnvVar, "")
+	assert.NotEqual(t, NewUUID(t), NewUUID(t), "Expected random UUIDs without a cassette")
+
+	t.Setenv(httprecorder.ModeEnvVar, string(httprecorder.ModeReplay))
+	var generated []string
+	t.Run("replay", func(t *testing.T) {
+		generated = []string{NewUUID(t), NewUUID(t)}
+	})
+	assert.Equal(t, []string{
+		uuid.NewSHA1(uuid.NameSpaceURL, []byte("TestNewUUID/replay/1")).String(),
+		uuid.NewSHA1(uuid.NameSpaceURL, []byte("TestNewUUID/replay/2")).String(),
+	}, generated, "Expected the UUIDs to be derived from the test name")
+	assert.NotContains(t, uuidCounters, "TestNewUUID/replay", "Expected the UUIDs to start over when the test is run again")
+}
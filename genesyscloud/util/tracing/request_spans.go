@@ -0,0 +1,96 @@
+package tracing
+
+import (
+	"context"
+	"fmt"
+	"sync"
+
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/trace"
+)
+
+/*
+The Go SDK does not pass the context of an operation to its HTTP requests. While an operation uses an SDK client, the
+context of the operation is bound to the client, so the spans of the requests and their retries become children of the
+span of the operation.
+*/
+
+type clientBinding struct {
+	mutex    sync.Mutex
+	ctx      context.Context
+	requests map[string]trace.Span
+}
+
+// Bindings by SDK client
+var clientBindings sync.Map
+
+// BindClient binds the context of an operation to an SDK client. The returned function unbinds it and ends the spans of
+// requests that never received a response.
+func BindClient(client any, ctx context.Context) func() {
+	if !IsEnabled() {
+		return func() {}
+	}
+	binding := &clientBinding{ctx: ctx, requests: make(map[string]trace.Span)}
+	clientBindings.Store(client, binding)
+
+	return func() {
+		clientBindings.CompareAndDelete(client, binding)
+		binding.mutex.Lock()
+		defer binding.mutex.Unlock()
+		for _, span := range binding.requests {
+			EndSpan(span, fmt.Errorf("no response received"))
+		}
+		binding.requests = nil
+	}
+}
+
+// StartRequestSpan starts the span of an HTTP request of an SDK client. Retries of a request are started as spans of their own.
+func StartRequestSpan(client any, requestId string, method string, path string, retryCount int) {
+	if !IsEnabled() {
+		return
+	}
+	binding := getClientBinding(client)
+	_, span := StartSpan(binding.ctx, fmt.Sprintf("HTTP %s", method),
+		attribute.String("http.request.method", method),
+		attribute.String("url.path", path),
+		attribute.Int("http.request.resend_count", retryCount),
+	)
+
+	binding.mutex.Lock()
+	defer binding.mutex.Unlock()
+	if binding.requests == nil {
+		// The client was unbound while the request was sent
+		span.End()
+		return
+	}
+	binding.requests[requestId] = span
+}
+
+// EndRequestSpan ends the span of an HTTP request of an SDK client once its response is received
+func EndRequestSpan(client any, requestId string, statusCode int) {
+	if !IsEnabled() {
+		return
+	}
+	binding := getClientBinding(client)
+	binding.mutex.Lock()
+	span, ok := binding.requests[requestId]
+	delete(binding.requests, requestId)
+	binding.mutex.Unlock()
+	if !ok {
+		return
+	}
+
+	span.SetAttributes(attribute.Int("http.response.status_code", statusCode))
+	var err error
+	if statusCode >= 400 {
+		err = fmt.Errorf("HTTP status %d", statusCode)
+	}
+	EndSpan(span, err)
+}
+
+// getClientBinding returns the binding of a client. Clients that are not used by an operation, e.g. the default client of
+// the provider, are bound to the run.
+func getClientBinding(client any) *clientBinding {
+	binding, _ := clientBindings.LoadOrStore(client, &clientBinding{ctx: context.Background(), requests: make(map[string]trace.Span)})
+	return binding.(*clientBinding)
+}
@@ -0,0 +1,42 @@
+package tracing
+
+import (
+	"context"
+	"testing"
+
+	sdktrace "go.opentelemetry.io/otel/sdk/trace"
+	"go.opentelemetry.io/otel/sdk/trace/tracetest"
+)
+
+func TestUnitRequestSpansAreChildrenOfOperation(t *testing.T) {
+	exporter := tracetest.NewInMemoryExporter()
+	tracerProvider = sdktrace.NewTracerProvider(sdktrace.WithSyncer(exporter))
+	defer func() { tracerProvider = nil }()
+
+	client := &struct{ name string }{name: "client"}
+	ctx, operationSpan := StartSpan(context.Background(), "Create routing_queue")
+	unbind := BindClient(client, ctx)
+
+	StartRequestSpan(client, "request-1", "POST", "/api/v2/routing/queues", 0)
+	EndRequestSpan(client, "request-1", 429)
+	StartRequestSpan(client, "request-2", "POST", "/api/v2/routing/queues", 1)
+	EndRequestSpan(client, "request-2", 200)
+	StartRequestSpan(client, "request-3", "GET", "/api/v2/routing/queues/id", 0)
+
+	// Requests without a response are ended when the client is unbound
+	unbind()
+	operationSpan.End()
+
+	spans := exporter.GetSpans()
+	if len(spans) != 4 {
+		t.Fatalf("Expected 4 spans, got %d", len(spans))
+	}
+	for _, span := range spans[:3] {
+		if span.Parent.SpanID() != operationSpan.SpanContext().SpanID() {
+			t.Errorf("Expected span %s to be a child of the operation", span.Name)
+		}
+	}
+	if spans[0].Status.Code.String() != "Error" || spans[1].Status.Code.String() != "Unset" {
+		t.Errorf("Expected the rate limited request to fail and its retry to succeed, got %v and %v", spans[0].Status, spans[1].Status)
+	}
+}
@@ -0,0 +1,114 @@
+package tracing
+
+import (
+	"context"
+	"fmt"
+	"log"
+	"sync"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"go.opentelemetry.io/otel"
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/codes"
+	"go.opentelemetry.io/otel/exporters/otlp/otlptrace/otlptracehttp"
+	"go.opentelemetry.io/otel/sdk/resource"
+	sdktrace "go.opentelemetry.io/otel/sdk/trace"
+	semconv "go.opentelemetry.io/otel/semconv/v1.26.0"
+	"go.opentelemetry.io/otel/trace"
+)
+
+/*
+The tracing package exports spans of provider operations to an OpenTelemetry collector with OTLP over HTTP. Tracing is
+disabled unless an endpoint is configured, in which case every function of this package is a no-op.
+
+Terraform does not pass a trace to the provider, so every operation of a provider process is added to the trace of the
+run that is started when the provider is configured. Spans are exported in batches, so the spans of the last half second
+of a run may be lost when Terraform stops the provider.
+*/
+
+const (
+	tracerName         = "terraform-provider-genesyscloud"
+	exportBatchTimeout = 500 * time.Millisecond
+)
+
+var (
+	tracerProvider *sdktrace.TracerProvider
+	runSpanContext trace.SpanContext
+	configureOnce  sync.Once
+	configureErr   error
+)
+
+// Configure starts exporting spans to the OTLP endpoint, e.g. http://localhost:4318. Only the first call of a provider
+// process configures tracing.
+func Configure(ctx context.Context, endpoint string, version string) error {
+	if endpoint == "" {
+		return nil
+	}
+	configureOnce.Do(func() {
+		exporter, err := otlptracehttp.New(ctx, otlptracehttp.WithEndpointURL(endpoint))
+		if err != nil {
+			configureErr = fmt.Errorf("failed to create the OTLP exporter for %s: %v", endpoint, err)
+			return
+		}
+		tracerProvider = sdktrace.NewTracerProvider(
+			sdktrace.WithBatcher(exporter, sdktrace.WithBatchTimeout(exportBatchTimeout)),
+			sdktrace.WithResource(resource.NewSchemaless(
+				semconv.ServiceName(tracerName),
+				semconv.ServiceVersion(version),
+			)),
+		)
+		otel.SetTracerProvider(tracerProvider)
+
+		// The run span only groups the operations of the provider process, so it is ended right away
+		_, runSpan := tracerProvider.Tracer(tracerName).Start(ctx, "terraform run")
+		runSpanContext = runSpan.SpanContext()
+		runSpan.End()
+		log.Printf("Exporting traces to %s with trace ID %s", endpoint, runSpanContext.TraceID())
+	})
+	return configureErr
+}
+
+// IsEnabled returns true if spans are exported
+func IsEnabled() bool {
+	return tracerProvider != nil
+}
+
+// StartSpan starts a span that is a child of the span of the context, or of the run if the context has none. The span
+// must be ended by the caller.
+func StartSpan(ctx context.Context, name string, attributes ...attribute.KeyValue) (context.Context, trace.Span) {
+	if !IsEnabled() {
+		return ctx, trace.SpanFromContext(ctx)
+	}
+	if !trace.SpanContextFromContext(ctx).IsValid() {
+		ctx = trace.ContextWithSpanContext(ctx, runSpanContext)
+	}
+	return tracerProvider.Tracer(tracerName).Start(ctx, name, trace.WithAttributes(attributes...))
+}
+
+// EndSpan records the error of an operation, if any, and ends its span
+func EndSpan(span trace.Span, err error) {
+	if err != nil {
+		span.RecordError(err)
+		span.SetStatus(codes.Error, err.Error())
+	}
+	span.End()
+}
+
+// DiagnosticsError returns the diagnostics of an operation as an error for its span, or nil if they have no error
+func DiagnosticsError(diagErr diag.Diagnostics) error {
+	if !diagErr.HasError() {
+		return nil
+	}
+	return fmt.Errorf("%v", diagErr)
+}
+
+// Flush exports all spans that have ended
+func Flush(ctx context.Context) {
+	if !IsEnabled() {
+		return
+	}
+	if err := tracerProvider.ForceFlush(ctx); err != nil {
+		log.Printf("Failed to export traces: %v", err)
+	}
+}
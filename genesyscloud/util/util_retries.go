@@ -46,6 +46,10 @@ func WithRetriesForReadCustomTimeout(ctx context.Context, timeout time.Duration,
 		errStringLower := strings.ToLower(fmt.Sprintf("%v", err))
 		if strings.Contains(errStringLower, "timeout while waiting for state to become") ||
 			strings.Contains(errStringLower, "context deadline exceeded") {
+			// Report the mismatches that kept the consistency checker retrying until the timeout
+			if d.Id() != "" {
+				consistency_checker.ReportUnresolvedConsistencyCheck(d.Id())
+			}
 			ctx, cancel := context.WithTimeout(context.Background(), timeout)
 			defer cancel()
 			return WithRetriesForRead(ctx, d, method)